@@ -0,0 +1,238 @@
+package uniseg
+
+// IsGraphemeBoundary returns true if the given byte offset into the byte slice
+// "b" is a grapheme cluster boundary according to the rules of [Unicode
+// Standard Annex #29, Grapheme Cluster Boundaries]. The beginning and the end of
+// the byte slice are always boundaries. Offsets which are out of range or which
+// point into the middle of a UTF-8 sequence are never boundaries.
+//
+// Only the text surrounding the offset is examined, as far as needed to
+// determine the boundary unambiguously. This makes this function suitable for
+// random access into large texts, e.g. for mapping a mouse click to a cursor
+// position.
+//
+// [Unicode Standard Annex #29, Grapheme Cluster Boundaries]: http://unicode.org/reports/tr29/#Grapheme_Cluster_Boundaries
+func IsGraphemeBoundary(b []byte, offset int) bool {
+	return isBoundary(b, offset, lastGraphemeSafePoint[[]byte], graphemeSegment[[]byte])
+}
+
+// IsGraphemeBoundaryInString is like [IsGraphemeBoundary] but its input is a
+// string.
+func IsGraphemeBoundaryInString(str string, offset int) bool {
+	return isBoundary(str, offset, lastGraphemeSafePoint[string], graphemeSegment[string])
+}
+
+// PreviousGraphemeBoundary returns the byte offset of the last grapheme cluster
+// boundary in the byte slice "b" which lies before the given byte offset (see
+// [IsGraphemeBoundary] for details). It returns -1 if there is no such
+// boundary, i.e. if the offset is 0 or less.
+func PreviousGraphemeBoundary(b []byte, offset int) int {
+	return previousBoundary(b, offset, lastGraphemeSafePoint[[]byte], graphemeSegment[[]byte])
+}
+
+// PreviousGraphemeBoundaryInString is like [PreviousGraphemeBoundary] but its
+// input is a string.
+func PreviousGraphemeBoundaryInString(str string, offset int) int {
+	return previousBoundary(str, offset, lastGraphemeSafePoint[string], graphemeSegment[string])
+}
+
+// NextGraphemeBoundary returns the byte offset of the first grapheme cluster
+// boundary in the byte slice "b" which lies after the given byte offset (see
+// [IsGraphemeBoundary] for details). It returns -1 if there is no such
+// boundary, i.e. if the offset is len(b) or more.
+func NextGraphemeBoundary(b []byte, offset int) int {
+	return nextBoundary(b, offset, lastGraphemeSafePoint[[]byte], graphemeSegment[[]byte])
+}
+
+// NextGraphemeBoundaryInString is like [NextGraphemeBoundary] but its input is
+// a string.
+func NextGraphemeBoundaryInString(str string, offset int) int {
+	return nextBoundary(str, offset, lastGraphemeSafePoint[string], graphemeSegment[string])
+}
+
+// IsWordBoundary returns true if the given byte offset into the byte slice "b"
+// is a word boundary according to the rules of [Unicode Standard Annex #29,
+// Word Boundaries]. The beginning and the end of the byte slice are always
+// boundaries. Offsets which are out of range or which point into the middle of
+// a UTF-8 sequence are never boundaries.
+//
+// Only the text surrounding the offset is examined, as far as needed to
+// determine the boundary unambiguously. This makes this function suitable for
+// random access into large texts, e.g. for selecting a word with a double
+// click.
+//
+// [Unicode Standard Annex #29, Word Boundaries]: http://unicode.org/reports/tr29/#Word_Boundaries
+func IsWordBoundary(b []byte, offset int) bool {
+	return isBoundary(b, offset, lastWordSafePoint[[]byte], wordSegment[[]byte])
+}
+
+// IsWordBoundaryInString is like [IsWordBoundary] but its input is a string.
+func IsWordBoundaryInString(str string, offset int) bool {
+	return isBoundary(str, offset, lastWordSafePoint[string], wordSegment[string])
+}
+
+// PreviousWordBoundary returns the byte offset of the last word boundary in the
+// byte slice "b" which lies before the given byte offset (see [IsWordBoundary]
+// for details). It returns -1 if there is no such boundary, i.e. if the offset
+// is 0 or less.
+func PreviousWordBoundary(b []byte, offset int) int {
+	return previousBoundary(b, offset, lastWordSafePoint[[]byte], wordSegment[[]byte])
+}
+
+// PreviousWordBoundaryInString is like [PreviousWordBoundary] but its input is
+// a string.
+func PreviousWordBoundaryInString(str string, offset int) int {
+	return previousBoundary(str, offset, lastWordSafePoint[string], wordSegment[string])
+}
+
+// NextWordBoundary returns the byte offset of the first word boundary in the
+// byte slice "b" which lies after the given byte offset (see [IsWordBoundary]
+// for details). It returns -1 if there is no such boundary, i.e. if the offset
+// is len(b) or more.
+func NextWordBoundary(b []byte, offset int) int {
+	return nextBoundary(b, offset, lastWordSafePoint[[]byte], wordSegment[[]byte])
+}
+
+// NextWordBoundaryInString is like [NextWordBoundary] but its input is a
+// string.
+func NextWordBoundaryInString(str string, offset int) int {
+	return nextBoundary(str, offset, lastWordSafePoint[string], wordSegment[string])
+}
+
+// IsSentenceBoundary returns true if the given byte offset into the byte slice
+// "b" is a sentence boundary according to the rules of [Unicode Standard Annex
+// #29, Sentence Boundaries]. The beginning and the end of the byte slice are
+// always boundaries. Offsets which are out of range or which point into the
+// middle of a UTF-8 sequence are never boundaries.
+//
+// Only the paragraph containing the offset is examined. This makes this
+// function suitable for random access into large texts, e.g. for selecting a
+// sentence with a triple click.
+//
+// [Unicode Standard Annex #29, Sentence Boundaries]: http://unicode.org/reports/tr29/#Sentence_Boundaries
+func IsSentenceBoundary(b []byte, offset int) bool {
+	return isBoundary(b, offset, lastSentenceSafePoint[[]byte], sentenceSegment[[]byte])
+}
+
+// IsSentenceBoundaryInString is like [IsSentenceBoundary] but its input is a
+// string.
+func IsSentenceBoundaryInString(str string, offset int) bool {
+	return isBoundary(str, offset, lastSentenceSafePoint[string], sentenceSegment[string])
+}
+
+// PreviousSentenceBoundary returns the byte offset of the last sentence
+// boundary in the byte slice "b" which lies before the given byte offset (see
+// [IsSentenceBoundary] for details). It returns -1 if there is no such
+// boundary, i.e. if the offset is 0 or less.
+func PreviousSentenceBoundary(b []byte, offset int) int {
+	return previousBoundary(b, offset, lastSentenceSafePoint[[]byte], sentenceSegment[[]byte])
+}
+
+// PreviousSentenceBoundaryInString is like [PreviousSentenceBoundary] but its
+// input is a string.
+func PreviousSentenceBoundaryInString(str string, offset int) int {
+	return previousBoundary(str, offset, lastSentenceSafePoint[string], sentenceSegment[string])
+}
+
+// NextSentenceBoundary returns the byte offset of the first sentence boundary
+// in the byte slice "b" which lies after the given byte offset (see
+// [IsSentenceBoundary] for details). It returns -1 if there is no such
+// boundary, i.e. if the offset is len(b) or more.
+func NextSentenceBoundary(b []byte, offset int) int {
+	return nextBoundary(b, offset, lastSentenceSafePoint[[]byte], sentenceSegment[[]byte])
+}
+
+// NextSentenceBoundaryInString is like [NextSentenceBoundary] but its input is
+// a string.
+func NextSentenceBoundaryInString(str string, offset int) int {
+	return nextBoundary(str, offset, lastSentenceSafePoint[string], sentenceSegment[string])
+}
+
+// safePointFunc returns the position of the last boundary in the given text
+// from which parsing may start with an initial state of -1, see e.g.
+// [lastGraphemeSafePoint].
+type safePointFunc[T text] func(t T) int
+
+// segmentFunc returns the length of the first segment found in the given text,
+// as well as the new parser state, see e.g. [FirstWord].
+type segmentFunc[T text] func(t T, state int) (length, newState int)
+
+// graphemeSegment is a [segmentFunc] for grapheme clusters.
+func graphemeSegment[T text](t T, state int) (length, newState int) {
+	cluster, _, _, newState := firstGraphemeCluster(t, state, nil)
+	return len(cluster), newState
+}
+
+// wordSegment is a [segmentFunc] for words.
+func wordSegment[T text](t T, state int) (length, newState int) {
+	word, _, newState := firstWord(t, state)
+	return len(word), newState
+}
+
+// sentenceSegment is a [segmentFunc] for sentences.
+func sentenceSegment[T text](t T, state int) (length, newState int) {
+	sentence, _, newState := firstSentence(t, state)
+	return len(sentence), newState
+}
+
+// surroundingBoundaries returns the last boundary at or before the given
+// offset into the text and the first boundary after it. The offset must be in
+// the range [0, len(t)).
+func surroundingBoundaries[T text](t T, offset int, safePoint safePointFunc[T], segment segmentFunc[T]) (before, after int) {
+	// Find the beginning of the rune at the offset.
+	start := offset
+	for start > 0 && !textRuneStart(t, start) {
+		start--
+	}
+
+	// Parse forward from a safe point before the rune.
+	prefix, _ := splitText(t, start)
+	pos := safePoint(prefix)
+	_, rest := splitText(t, pos)
+	state := -1
+	for {
+		var length int
+		length, state = segment(rest, state)
+		if pos+length > offset {
+			return pos, pos + length
+		}
+		pos += length
+		_, rest = splitText(rest, length)
+	}
+}
+
+// isBoundary implements the Is...Boundary functions.
+func isBoundary[T text](t T, offset int, safePoint safePointFunc[T], segment segmentFunc[T]) bool {
+	if offset < 0 || offset > len(t) {
+		return false
+	}
+	if offset == 0 || offset == len(t) {
+		return true
+	}
+	before, _ := surroundingBoundaries(t, offset, safePoint, segment)
+	return before == offset
+}
+
+// previousBoundary implements the Previous...Boundary functions.
+func previousBoundary[T text](t T, offset int, safePoint safePointFunc[T], segment segmentFunc[T]) int {
+	if offset <= 0 {
+		return -1
+	}
+	if offset > len(t) {
+		return len(t)
+	}
+	before, _ := surroundingBoundaries(t, offset-1, safePoint, segment)
+	return before
+}
+
+// nextBoundary implements the Next...Boundary functions.
+func nextBoundary[T text](t T, offset int, safePoint safePointFunc[T], segment segmentFunc[T]) int {
+	if offset >= len(t) {
+		return -1
+	}
+	if offset < 0 {
+		return 0
+	}
+	_, after := surroundingBoundaries(t, offset, safePoint, segment)
+	return after
+}
@@ -0,0 +1,112 @@
+package uniseg
+
+import (
+	"testing"
+	"unicode/utf16"
+)
+
+// testBoundaries checks the Is..., Previous..., and Next...Boundary functions
+// for all offsets of all given test cases.
+func testBoundaries(t *testing.T, name string, testCases []testCase, is func([]byte, int) bool, isStr func(string, int) bool, previous, next func([]byte, int) int, previousStr, nextStr func(string, int) int) {
+	for testNum, testCase := range testCases {
+		// Determine the expected boundaries.
+		boundaries := make(map[int]bool)
+		var pos int
+		boundaries[pos] = true
+		for _, segment := range testCase.expected {
+			pos += len(string(segment))
+			boundaries[pos] = true
+		}
+
+		b := []byte(testCase.original)
+		str := testCase.original
+		for offset := -1; offset <= len(str)+1; offset++ {
+			if actual := is(b, offset); actual != boundaries[offset] {
+				t.Errorf(`%s test case %d %q failed: Offset %d is boundary: %t, expected %t (bytes)`, name, testNum, str, offset, actual, boundaries[offset])
+			}
+			if actual := isStr(str, offset); actual != boundaries[offset] {
+				t.Errorf(`%s test case %d %q failed: Offset %d is boundary: %t, expected %t (string)`, name, testNum, str, offset, actual, boundaries[offset])
+			}
+
+			expectedPrevious := -1
+			for p := offset - 1; p >= 0; p-- {
+				if boundaries[p] {
+					expectedPrevious = p
+					break
+				}
+			}
+			if actual := previous(b, offset); actual != expectedPrevious {
+				t.Errorf(`%s test case %d %q failed: Previous boundary of %d is %d, expected %d (bytes)`, name, testNum, str, offset, actual, expectedPrevious)
+			}
+			if actual := previousStr(str, offset); actual != expectedPrevious {
+				t.Errorf(`%s test case %d %q failed: Previous boundary of %d is %d, expected %d (string)`, name, testNum, str, offset, actual, expectedPrevious)
+			}
+
+			expectedNext := -1
+			for n := offset + 1; n <= len(str); n++ {
+				if boundaries[n] {
+					expectedNext = n
+					break
+				}
+			}
+			if actual := next(b, offset); actual != expectedNext {
+				t.Errorf(`%s test case %d %q failed: Next boundary of %d is %d, expected %d (bytes)`, name, testNum, str, offset, actual, expectedNext)
+			}
+			if actual := nextStr(str, offset); actual != expectedNext {
+				t.Errorf(`%s test case %d %q failed: Next boundary of %d is %d, expected %d (string)`, name, testNum, str, offset, actual, expectedNext)
+			}
+		}
+	}
+}
+
+// Test random access to grapheme cluster boundaries.
+func TestGraphemeBoundaries(t *testing.T) {
+	testBoundaries(t, "Grapheme", append(testCases, graphemeBreakTestCases...),
+		IsGraphemeBoundary, IsGraphemeBoundaryInString,
+		PreviousGraphemeBoundary, NextGraphemeBoundary,
+		PreviousGraphemeBoundaryInString, NextGraphemeBoundaryInString)
+}
+
+// Test random access to word boundaries.
+func TestWordBoundaries(t *testing.T) {
+	testBoundaries(t, "Word", wordBreakTestCases,
+		IsWordBoundary, IsWordBoundaryInString,
+		PreviousWordBoundary, NextWordBoundary,
+		PreviousWordBoundaryInString, NextWordBoundaryInString)
+}
+
+// Test random access to sentence boundaries.
+func TestSentenceBoundaries(t *testing.T) {
+	testBoundaries(t, "Sentence", sentenceBreakTestCases,
+		IsSentenceBoundary, IsSentenceBoundaryInString,
+		PreviousSentenceBoundary, NextSentenceBoundary,
+		PreviousSentenceBoundaryInString, NextSentenceBoundaryInString)
+}
+
+// Test random access to boundaries in UTF-16 and rune slices, which are not
+// exposed publicly but share the implementation.
+func TestBoundariesCodeUnits(t *testing.T) {
+	for testNum, testCase := range graphemeBreakTestCases {
+		u := utf16.Encode([]rune(testCase.original))
+		runes := []rune(testCase.original)
+		boundaries16 := map[int]bool{0: true}
+		boundariesRunes := map[int]bool{0: true}
+		var pos16, posRunes int
+		for _, segment := range testCase.expected {
+			pos16 += len(utf16.Encode([]rune(segment)))
+			posRunes += len([]rune(segment))
+			boundaries16[pos16] = true
+			boundariesRunes[posRunes] = true
+		}
+		for offset := 0; offset <= len(u); offset++ {
+			if actual := isBoundary(u, offset, lastGraphemeSafePoint[[]uint16], graphemeSegment[[]uint16]); actual != boundaries16[offset] {
+				t.Errorf(`Test case %d %q failed: UTF-16 offset %d is boundary: %t, expected %t`, testNum, testCase.original, offset, actual, boundaries16[offset])
+			}
+		}
+		for offset := 0; offset <= len(runes); offset++ {
+			if actual := isBoundary(runes, offset, lastGraphemeSafePoint[[]rune], graphemeSegment[[]rune]); actual != boundariesRunes[offset] {
+				t.Errorf(`Test case %d %q failed: Rune offset %d is boundary: %t, expected %t`, testNum, testCase.original, offset, actual, boundariesRunes[offset])
+			}
+		}
+	}
+}
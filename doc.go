@@ -27,7 +27,12 @@ word boundaries, sentence boundaries, line breaks, and monospace character
 widths. The specialized functions [FirstGraphemeCluster],
 [FirstGraphemeClusterInString], [FirstWord], [FirstWordInString],
 [FirstSentence], and [FirstSentenceInString] can be used if only one type of
-information is needed.
+information is needed. Text encoded in UTF-16, as received from JavaScript or
+the Windows API, can be processed with [StepUTF16] and the other UTF-16
+variants without converting it to UTF-8 first. The same applies to rune slices
+and [StepRunes], [FirstGraphemeClusterRunes], [FirstWordRunes], etc. With Go
+1.23 or newer, iterators such as [GraphemesSeq] or [WordsSeq] can be used in
+"for ... range" loops.
 
 # Grapheme Clusters
 
@@ -47,7 +52,7 @@ Word boundaries are used in a number of different contexts. The most familiar
 ones are selection (double-click mouse selection), cursor movement ("move to
 next word" control-arrow keys), and the dialog option "Whole Word Search" for
 search and replace. This package provides methods for determining word
-boundaries.
+boundaries. The [Words] class offers a convenient way to iterate over them.
 
 # Sentence Boundaries
 
@@ -98,12 +103,45 @@ Indicators (flags), all code points except the first one have a width of 0. For
 grapheme clusters starting with an Extended Pictographic, any additional code
 point will force a total width of 2, except if the Variation Selector-15
 (U+FE0E) is included, in which case the total width is always 1. Grapheme
-clusters ending with Variation Selector-16 (U+FE0F) have a width of 2.
+clusters ending with Variation Selector-16 (U+FE0F) have a width of 2. In
+accordance with [UTS #51], emoji modifier sequences (e.g. U+261D U+1F3FB) and
+keycap sequences (e.g. U+0031 U+FE0F U+20E3) have a width of 2, too. Emoji
+modifiers which do not follow an emoji (and are therefore displayed as
+swatches) have a width of 2.
+
+The rules above describe the default policy. Use a [WidthConfig] to change the
+width of East Asian Ambiguous characters, emojis, control characters, and tabs
+on a per-call basis, without modifying the global [EastAsianAmbiguousWidth]
+variable. A [WidthConfig] also selects a [WidthProfile], which determines how
+the widths of a cluster's code points are combined. This is useful to match
+terminals which simply add up per-code-point widths instead of treating
+grapheme clusters as units. Finally, [WidthConfig.EscapeSequences] causes ANSI
+(ECMA-48) escape sequences, for example color codes, to be treated as
+zero-width units such that already styled text can be measured, truncated,
+and wrapped.
 
 Note that whether these widths appear correct depends on your application's
 render engine, to which extent it conforms to the Unicode Standard, and its
 choice of font.
 
+# Unicode Versions
+
+All segmentation rules and properties follow Unicode version 17.0.0. Each new
+Unicode version may move some boundaries. If results must remain stable, e.g.
+because they are stored in a search index, build with the "uniseg_unicode15"
+build tag:
+
+	go build -tags uniseg_unicode15
+
+This pins all properties and rules to Unicode version 15.0.0, regardless of
+future updates of this package.
+
+The Unicode properties on which the rules of this package are based can be
+looked up with [GraphemeBreakOf], [WordBreakOf], [SentenceBreakOf],
+[LineBreakOf], [EastAsianWidthOf], [IsExtendedPictographic], and
+[IsEmojiPresentation]. They reflect the same Unicode versions.
+
 [wcswidth()]: https://man7.org/linux/man-pages/man3/wcswidth.3.html
+[UTS #51]: https://unicode.org/reports/tr51/
 */
 package uniseg
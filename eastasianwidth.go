@@ -1,13 +1,12 @@
+//go:build !uniseg_unicode15
+
 // Code generated via go generate from gen_properties.go. DO NOT EDIT.
 
 package uniseg
 
 // eastAsianWidth are taken from
-// https://www.unicode.org/Public/15.0.0/ucd/EastAsianWidth.txt
-// and
-// https://unicode.org/Public/15.0.0/ucd/emoji/emoji-data.txt
-// ("Extended_Pictographic" only)
-// on September 5, 2023. See https://www.unicode.org/license.html for the Unicode
+// https://www.unicode.org/Public/17.0.0/ucd/EastAsianWidth.txt
+// on October 18, 2026. See https://www.unicode.org/license.html for the Unicode
 // license agreement.
 var eastAsianWidth = [][3]int{
 	{0x0000, 0x001F, prN},     // Cc    [32] <control-0000>..<control-001F>
@@ -151,8 +150,8 @@ var eastAsianWidth = [][3]int{
 	{0x0252, 0x0260, prN},     // Ll    [15] LATIN SMALL LETTER TURNED ALPHA..LATIN SMALL LETTER G WITH HOOK
 	{0x0261, 0x0261, prA},     // Ll         LATIN SMALL LETTER SCRIPT G
 	{0x0262, 0x0293, prN},     // Ll    [50] LATIN LETTER SMALL CAPITAL G..LATIN SMALL LETTER EZH WITH CURL
-	{0x0294, 0x0294, prN},     // Lo         LATIN LETTER GLOTTAL STOP
-	{0x0295, 0x02AF, prN},     // Ll    [27] LATIN LETTER PHARYNGEAL VOICED FRICATIVE..LATIN SMALL LETTER TURNED H WITH FISHHOOK AND TAIL
+	{0x0294, 0x0295, prN},     // Lo     [2] LATIN LETTER GLOTTAL STOP..LATIN LETTER PHARYNGEAL VOICED FRICATIVE
+	{0x0296, 0x02AF, prN},     // Ll    [26] LATIN LETTER INVERTED GLOTTAL STOP..LATIN SMALL LETTER TURNED H WITH FISHHOOK AND TAIL
 	{0x02B0, 0x02C1, prN},     // Lm    [18] MODIFIER LETTER SMALL H..MODIFIER LETTER REVERSED GLOTTAL STOP
 	{0x02C2, 0x02C3, prN},     // Sk     [2] MODIFIER LETTER LEFT ARROWHEAD..MODIFIER LETTER RIGHT ARROWHEAD
 	{0x02C4, 0x02C4, prA},     // Sk         MODIFIER LETTER UP ARROWHEAD
@@ -303,9 +302,9 @@ var eastAsianWidth = [][3]int{
 	{0x0860, 0x086A, prN},     // Lo    [11] SYRIAC LETTER MALAYALAM NGA..SYRIAC LETTER MALAYALAM SSA
 	{0x0870, 0x0887, prN},     // Lo    [24] ARABIC LETTER ALEF WITH ATTACHED FATHA..ARABIC BASELINE ROUND DOT
 	{0x0888, 0x0888, prN},     // Sk         ARABIC RAISED ROUND DOT
-	{0x0889, 0x088E, prN},     // Lo     [6] ARABIC LETTER NOON WITH INVERTED SMALL V..ARABIC VERTICAL TAIL
+	{0x0889, 0x088F, prN},     // Lo     [7] ARABIC LETTER NOON WITH INVERTED SMALL V..ARABIC LETTER NOON WITH RING ABOVE
 	{0x0890, 0x0891, prN},     // Cf     [2] ARABIC POUND MARK ABOVE..ARABIC PIASTRE MARK ABOVE
-	{0x0898, 0x089F, prN},     // Mn     [8] ARABIC SMALL HIGH WORD AL-JUZ..ARABIC HALF MADDA OVER MADDA
+	{0x0897, 0x089F, prN},     // Mn     [9] ARABIC PEPET..ARABIC HALF MADDA OVER MADDA
 	{0x08A0, 0x08C8, prN},     // Lo    [41] ARABIC LETTER BEH WITH SMALL V BELOW..ARABIC LETTER GRAF
 	{0x08C9, 0x08C9, prN},     // Lm         ARABIC SMALL FARSI YEH
 	{0x08CA, 0x08E1, prN},     // Mn    [24] ARABIC SMALL HIGH FARSI YEH..ARABIC SMALL HIGH SIGN SAFHA
@@ -473,7 +472,7 @@ var eastAsianWidth = [][3]int{
 	{0x0C4A, 0x0C4D, prN},     // Mn     [4] TELUGU VOWEL SIGN O..TELUGU SIGN VIRAMA
 	{0x0C55, 0x0C56, prN},     // Mn     [2] TELUGU LENGTH MARK..TELUGU AI LENGTH MARK
 	{0x0C58, 0x0C5A, prN},     // Lo     [3] TELUGU LETTER TSA..TELUGU LETTER RRRA
-	{0x0C5D, 0x0C5D, prN},     // Lo         TELUGU LETTER NAKAARA POLLU
+	{0x0C5C, 0x0C5D, prN},     // Lo     [2] TELUGU ARCHAIC SHRII..TELUGU LETTER NAKAARA POLLU
 	{0x0C60, 0x0C61, prN},     // Lo     [2] TELUGU LETTER VOCALIC RR..TELUGU LETTER VOCALIC LL
 	{0x0C62, 0x0C63, prN},     // Mn     [2] TELUGU VOWEL SIGN VOCALIC L..TELUGU VOWEL SIGN VOCALIC LL
 	{0x0C66, 0x0C6F, prN},     // Nd    [10] TELUGU DIGIT ZERO..TELUGU DIGIT NINE
@@ -499,7 +498,7 @@ var eastAsianWidth = [][3]int{
 	{0x0CCA, 0x0CCB, prN},     // Mc     [2] KANNADA VOWEL SIGN O..KANNADA VOWEL SIGN OO
 	{0x0CCC, 0x0CCD, prN},     // Mn     [2] KANNADA VOWEL SIGN AU..KANNADA SIGN VIRAMA
 	{0x0CD5, 0x0CD6, prN},     // Mc     [2] KANNADA LENGTH MARK..KANNADA AI LENGTH MARK
-	{0x0CDD, 0x0CDE, prN},     // Lo     [2] KANNADA LETTER NAKAARA POLLU..KANNADA LETTER FA
+	{0x0CDC, 0x0CDE, prN},     // Lo     [3] KANNADA ARCHAIC SHRII..KANNADA LETTER FA
 	{0x0CE0, 0x0CE1, prN},     // Lo     [2] KANNADA LETTER VOCALIC RR..KANNADA LETTER VOCALIC LL
 	{0x0CE2, 0x0CE3, prN},     // Mn     [2] KANNADA VOWEL SIGN VOCALIC L..KANNADA VOWEL SIGN VOCALIC LL
 	{0x0CE6, 0x0CEF, prN},     // Nd    [10] KANNADA DIGIT ZERO..KANNADA DIGIT NINE
@@ -777,7 +776,8 @@ var eastAsianWidth = [][3]int{
 	{0x1AA8, 0x1AAD, prN},     // Po     [6] TAI THAM SIGN KAAN..TAI THAM SIGN CAANG
 	{0x1AB0, 0x1ABD, prN},     // Mn    [14] COMBINING DOUBLED CIRCUMFLEX ACCENT..COMBINING PARENTHESES BELOW
 	{0x1ABE, 0x1ABE, prN},     // Me         COMBINING PARENTHESES OVERLAY
-	{0x1ABF, 0x1ACE, prN},     // Mn    [16] COMBINING LATIN SMALL LETTER W BELOW..COMBINING LATIN SMALL LETTER INSULAR T
+	{0x1ABF, 0x1ADD, prN},     // Mn    [31] COMBINING LATIN SMALL LETTER W BELOW..COMBINING DOT-AND-RING BELOW
+	{0x1AE0, 0x1AEB, prN},     // Mn    [12] COMBINING LEFT TACK ABOVE..COMBINING DOUBLE RIGHTWARDS ARROW ABOVE
 	{0x1B00, 0x1B03, prN},     // Mn     [4] BALINESE SIGN ULU RICEM..BALINESE SIGN SURANG
 	{0x1B04, 0x1B04, prN},     // Mc         BALINESE SIGN BISAH
 	{0x1B05, 0x1B33, prN},     // Lo    [47] BALINESE LETTER AKARA..BALINESE LETTER HA
@@ -790,12 +790,13 @@ var eastAsianWidth = [][3]int{
 	{0x1B42, 0x1B42, prN},     // Mn         BALINESE VOWEL SIGN PEPET
 	{0x1B43, 0x1B44, prN},     // Mc     [2] BALINESE VOWEL SIGN PEPET TEDUNG..BALINESE ADEG ADEG
 	{0x1B45, 0x1B4C, prN},     // Lo     [8] BALINESE LETTER KAF SASAK..BALINESE LETTER ARCHAIC JNYA
+	{0x1B4E, 0x1B4F, prN},     // Po     [2] BALINESE INVERTED CARIK SIKI..BALINESE INVERTED CARIK PAREREN
 	{0x1B50, 0x1B59, prN},     // Nd    [10] BALINESE DIGIT ZERO..BALINESE DIGIT NINE
 	{0x1B5A, 0x1B60, prN},     // Po     [7] BALINESE PANTI..BALINESE PAMENENG
 	{0x1B61, 0x1B6A, prN},     // So    [10] BALINESE MUSICAL SYMBOL DONG..BALINESE MUSICAL SYMBOL DANG GEDE
 	{0x1B6B, 0x1B73, prN},     // Mn     [9] BALINESE MUSICAL SYMBOL COMBINING TEGEH..BALINESE MUSICAL SYMBOL COMBINING GONG
 	{0x1B74, 0x1B7C, prN},     // So     [9] BALINESE MUSICAL SYMBOL RIGHT-HAND OPEN DUG..BALINESE MUSICAL SYMBOL LEFT-HAND OPEN PING
-	{0x1B7D, 0x1B7E, prN},     // Po     [2] BALINESE PANTI LANTANG..BALINESE PAMADA LANTANG
+	{0x1B7D, 0x1B7F, prN},     // Po     [3] BALINESE PANTI LANTANG..BALINESE PANTI BAWAK
 	{0x1B80, 0x1B81, prN},     // Mn     [2] SUNDANESE SIGN PANYECEK..SUNDANESE SIGN PANGLAYAR
 	{0x1B82, 0x1B82, prN},     // Mc         SUNDANESE SIGN PANGWISAD
 	{0x1B83, 0x1BA0, prN},     // Lo    [30] SUNDANESE LETTER A..SUNDANESE LETTER HA
@@ -830,7 +831,7 @@ var eastAsianWidth = [][3]int{
 	{0x1C5A, 0x1C77, prN},     // Lo    [30] OL CHIKI LETTER LA..OL CHIKI LETTER OH
 	{0x1C78, 0x1C7D, prN},     // Lm     [6] OL CHIKI MU TTUDDAG..OL CHIKI AHAD
 	{0x1C7E, 0x1C7F, prN},     // Po     [2] OL CHIKI PUNCTUATION MUCAAD..OL CHIKI PUNCTUATION DOUBLE MUCAAD
-	{0x1C80, 0x1C88, prN},     // Ll     [9] CYRILLIC SMALL LETTER ROUNDED VE..CYRILLIC SMALL LETTER UNBLENDED UK
+	{0x1C80, 0x1C8A, prN},     // L&    [11] CYRILLIC SMALL LETTER ROUNDED VE..CYRILLIC SMALL LETTER TJE
 	{0x1C90, 0x1CBA, prN},     // Lu    [43] GEORGIAN MTAVRULI CAPITAL LETTER AN..GEORGIAN MTAVRULI CAPITAL LETTER AIN
 	{0x1CBD, 0x1CBF, prN},     // Lu     [3] GEORGIAN MTAVRULI CAPITAL LETTER AEN..GEORGIAN MTAVRULI CAPITAL LETTER LABIAL SIGN
 	{0x1CC0, 0x1CC7, prN},     // Po     [8] SUNDANESE PUNCTUATION BINDU SURYA..SUNDANESE PUNCTUATION BINDU BA SATANGA
@@ -946,7 +947,7 @@ var eastAsianWidth = [][3]int{
 	{0x20A9, 0x20A9, prH},     // Sc         WON SIGN
 	{0x20AA, 0x20AB, prN},     // Sc     [2] NEW SHEQEL SIGN..DONG SIGN
 	{0x20AC, 0x20AC, prA},     // Sc         EURO SIGN
-	{0x20AD, 0x20C0, prN},     // Sc    [20] KIP SIGN..SOM SIGN
+	{0x20AD, 0x20C1, prN},     // Sc    [21] KIP SIGN..SAUDI RIYAL SIGN
 	{0x20D0, 0x20DC, prN},     // Mn    [13] COMBINING LEFT HARPOON ABOVE..COMBINING FOUR DOTS ABOVE
 	{0x20DD, 0x20E0, prN},     // Me     [4] COMBINING ENCLOSING CIRCLE..COMBINING ENCLOSING CIRCLE BACKSLASH
 	{0x20E1, 0x20E1, prN},     // Mn         COMBINING LEFT RIGHT ARROW ABOVE
@@ -1113,7 +1114,7 @@ var eastAsianWidth = [][3]int{
 	{0x23F1, 0x23F2, prN},     // So     [2] STOPWATCH..TIMER CLOCK
 	{0x23F3, 0x23F3, prW},     // So         HOURGLASS WITH FLOWING SAND
 	{0x23F4, 0x23FF, prN},     // So    [12] BLACK MEDIUM LEFT-POINTING TRIANGLE..OBSERVER EYE SYMBOL
-	{0x2400, 0x2426, prN},     // So    [39] SYMBOL FOR NULL..SYMBOL FOR SUBSTITUTE FORM TWO
+	{0x2400, 0x2429, prN},     // So    [42] SYMBOL FOR NULL..SYMBOL FOR DELETE MEDIUM SHADE FORM
 	{0x2440, 0x244A, prN},     // So    [11] OCR HOOK..OCR DOUBLE BACKSLASH
 	{0x2460, 0x249B, prA},     // No    [60] CIRCLED DIGIT ONE..NUMBER TWENTY FULL STOP
 	{0x249C, 0x24E9, prA},     // So    [78] PARENTHESIZED LATIN SMALL LETTER A..CIRCLED LATIN SMALL LETTER Z
@@ -1166,7 +1167,9 @@ var eastAsianWidth = [][3]int{
 	{0x261C, 0x261C, prA},     // So         WHITE LEFT POINTING INDEX
 	{0x261D, 0x261D, prN},     // So         WHITE UP POINTING INDEX
 	{0x261E, 0x261E, prA},     // So         WHITE RIGHT POINTING INDEX
-	{0x261F, 0x263F, prN},     // So    [33] WHITE DOWN POINTING INDEX..MERCURY
+	{0x261F, 0x262F, prN},     // So    [17] WHITE DOWN POINTING INDEX..YIN YANG
+	{0x2630, 0x2637, prW},     // So     [8] TRIGRAM FOR HEAVEN..TRIGRAM FOR EARTH
+	{0x2638, 0x263F, prN},     // So     [8] WHEEL OF DHARMA..MERCURY
 	{0x2640, 0x2640, prA},     // So         FEMALE SIGN
 	{0x2641, 0x2641, prN},     // So         EARTH
 	{0x2642, 0x2642, prA},     // So         MALE SIGN
@@ -1184,7 +1187,9 @@ var eastAsianWidth = [][3]int{
 	{0x266F, 0x266F, prA},     // Sm         MUSIC SHARP SIGN
 	{0x2670, 0x267E, prN},     // So    [15] WEST SYRIAC CROSS..PERMANENT PAPER SIGN
 	{0x267F, 0x267F, prW},     // So         WHEELCHAIR SYMBOL
-	{0x2680, 0x2692, prN},     // So    [19] DIE FACE-1..HAMMER AND PICK
+	{0x2680, 0x2689, prN},     // So    [10] DIE FACE-1..BLACK CIRCLE WITH TWO WHITE DOTS
+	{0x268A, 0x268F, prW},     // So     [6] MONOGRAM FOR YANG..DIGRAM FOR GREATER YIN
+	{0x2690, 0x2692, prN},     // So     [3] WHITE FLAG..HAMMER AND PICK
 	{0x2693, 0x2693, prW},     // So         ANCHOR
 	{0x2694, 0x269D, prN},     // So    [10] CROSSED SWORDS..OUTLINED WHITE STAR
 	{0x269E, 0x269F, prA},     // So     [2] THREE LINES CONVERGING RIGHT..THREE LINES CONVERGING LEFT
@@ -1317,8 +1322,7 @@ var eastAsianWidth = [][3]int{
 	{0x2B55, 0x2B55, prW},     // So         HEAVY LARGE CIRCLE
 	{0x2B56, 0x2B59, prA},     // So     [4] HEAVY OVAL WITH OVAL INSIDE..HEAVY CIRCLED SALTIRE
 	{0x2B5A, 0x2B73, prN},     // So    [26] SLANTED NORTH ARROW WITH HOOKED HEAD..DOWNWARDS TRIANGLE-HEADED ARROW TO BAR
-	{0x2B76, 0x2B95, prN},     // So    [32] NORTH WEST TRIANGLE-HEADED ARROW TO BAR..RIGHTWARDS BLACK ARROW
-	{0x2B97, 0x2BFF, prN},     // So   [105] SYMBOL FOR TYPE A ELECTRONICS..HELLSCHREIBER PAUSE SYMBOL
+	{0x2B76, 0x2BFF, prN},     // So   [138] NORTH WEST TRIANGLE-HEADED ARROW TO BAR..HELLSCHREIBER PAUSE SYMBOL
 	{0x2C00, 0x2C5F, prN},     // L&    [96] GLAGOLITIC CAPITAL LETTER AZU..GLAGOLITIC SMALL LETTER CAUDATE CHRIVI
 	{0x2C60, 0x2C7B, prN},     // L&    [28] LATIN CAPITAL LETTER L WITH DOUBLE BAR..LATIN LETTER SMALL CAPITAL TURNED E
 	{0x2C7C, 0x2C7D, prN},     // Lm     [2] LATIN SUBSCRIPT SMALL LETTER J..MODIFIER LETTER CAPITAL V
@@ -1400,7 +1404,7 @@ var eastAsianWidth = [][3]int{
 	{0x2E80, 0x2E99, prW},     // So    [26] CJK RADICAL REPEAT..CJK RADICAL RAP
 	{0x2E9B, 0x2EF3, prW},     // So    [89] CJK RADICAL CHOKE..CJK RADICAL C-SIMPLIFIED TURTLE
 	{0x2F00, 0x2FD5, prW},     // So   [214] KANGXI RADICAL ONE..KANGXI RADICAL FLUTE
-	{0x2FF0, 0x2FFB, prW},     // So    [12] IDEOGRAPHIC DESCRIPTION CHARACTER LEFT TO RIGHT..IDEOGRAPHIC DESCRIPTION CHARACTER OVERLAID
+	{0x2FF0, 0x2FFF, prW},     // So    [16] IDEOGRAPHIC DESCRIPTION CHARACTER LEFT TO RIGHT..IDEOGRAPHIC DESCRIPTION CHARACTER ROTATION
 	{0x3000, 0x3000, prF},     // Zs         IDEOGRAPHIC SPACE
 	{0x3001, 0x3003, prW},     // Po     [3] IDEOGRAPHIC COMMA..DITTO MARK
 	{0x3004, 0x3004, prW},     // So         JAPANESE INDUSTRIAL STANDARD SYMBOL
@@ -1458,7 +1462,8 @@ var eastAsianWidth = [][3]int{
 	{0x3192, 0x3195, prW},     // No     [4] IDEOGRAPHIC ANNOTATION ONE MARK..IDEOGRAPHIC ANNOTATION FOUR MARK
 	{0x3196, 0x319F, prW},     // So    [10] IDEOGRAPHIC ANNOTATION TOP MARK..IDEOGRAPHIC ANNOTATION MAN MARK
 	{0x31A0, 0x31BF, prW},     // Lo    [32] BOPOMOFO LETTER BU..BOPOMOFO LETTER AH
-	{0x31C0, 0x31E3, prW},     // So    [36] CJK STROKE T..CJK STROKE Q
+	{0x31C0, 0x31E5, prW},     // So    [38] CJK STROKE T..CJK STROKE SZP
+	{0x31EF, 0x31EF, prW},     // So         IDEOGRAPHIC DESCRIPTION CHARACTER SUBTRACTION
 	{0x31F0, 0x31FF, prW},     // Lo    [16] KATAKANA LETTER SMALL KU..KATAKANA LETTER SMALL RO
 	{0x3200, 0x321E, prW},     // So    [31] PARENTHESIZED HANGUL KIYEOK..PARENTHESIZED KOREAN CHARACTER O HU
 	{0x3220, 0x3229, prW},     // No    [10] PARENTHESIZED IDEOGRAPH ONE..PARENTHESIZED IDEOGRAPH TEN
@@ -1473,7 +1478,7 @@ var eastAsianWidth = [][3]int{
 	{0x32C0, 0x32FF, prW},     // So    [64] IDEOGRAPHIC TELEGRAPH SYMBOL FOR JANUARY..SQUARE ERA NAME REIWA
 	{0x3300, 0x33FF, prW},     // So   [256] SQUARE APAATO..SQUARE GAL
 	{0x3400, 0x4DBF, prW},     // Lo  [6592] CJK UNIFIED IDEOGRAPH-3400..CJK UNIFIED IDEOGRAPH-4DBF
-	{0x4DC0, 0x4DFF, prN},     // So    [64] HEXAGRAM FOR THE CREATIVE HEAVEN..HEXAGRAM FOR BEFORE COMPLETION
+	{0x4DC0, 0x4DFF, prW},     // So    [64] HEXAGRAM FOR THE CREATIVE HEAVEN..HEXAGRAM FOR BEFORE COMPLETION
 	{0x4E00, 0x9FFF, prW},     // Lo [20992] CJK UNIFIED IDEOGRAPH-4E00..CJK UNIFIED IDEOGRAPH-9FFF
 	{0xA000, 0xA014, prW},     // Lo    [21] YI SYLLABLE IT..YI SYLLABLE E
 	{0xA015, 0xA015, prW},     // Lm         YI SYLLABLE WU
@@ -1513,11 +1518,8 @@ var eastAsianWidth = [][3]int{
 	{0xA789, 0xA78A, prN},     // Sk     [2] MODIFIER LETTER COLON..MODIFIER LETTER SHORT EQUALS SIGN
 	{0xA78B, 0xA78E, prN},     // L&     [4] LATIN CAPITAL LETTER SALTILLO..LATIN SMALL LETTER L WITH RETROFLEX HOOK AND BELT
 	{0xA78F, 0xA78F, prN},     // Lo         LATIN LETTER SINOLOGICAL DOT
-	{0xA790, 0xA7CA, prN},     // L&    [59] LATIN CAPITAL LETTER N WITH DESCENDER..LATIN SMALL LETTER S WITH SHORT STROKE OVERLAY
-	{0xA7D0, 0xA7D1, prN},     // L&     [2] LATIN CAPITAL LETTER CLOSED INSULAR G..LATIN SMALL LETTER CLOSED INSULAR G
-	{0xA7D3, 0xA7D3, prN},     // Ll         LATIN SMALL LETTER DOUBLE THORN
-	{0xA7D5, 0xA7D9, prN},     // L&     [5] LATIN SMALL LETTER DOUBLE WYNN..LATIN SMALL LETTER SIGMOID S
-	{0xA7F2, 0xA7F4, prN},     // Lm     [3] MODIFIER LETTER CAPITAL C..MODIFIER LETTER CAPITAL Q
+	{0xA790, 0xA7DC, prN},     // L&    [77] LATIN CAPITAL LETTER N WITH DESCENDER..LATIN CAPITAL LETTER LAMBDA WITH STROKE
+	{0xA7F1, 0xA7F4, prN},     // Lm     [4] MODIFIER LETTER CAPITAL S..MODIFIER LETTER CAPITAL Q
 	{0xA7F5, 0xA7F6, prN},     // L&     [2] LATIN CAPITAL LETTER REVERSED HALF H..LATIN SMALL LETTER REVERSED HALF H
 	{0xA7F7, 0xA7F7, prN},     // Lo         LATIN EPIGRAPHIC LETTER SIDEWAYS I
 	{0xA7F8, 0xA7F9, prN},     // Lm     [2] MODIFIER LETTER CAPITAL H WITH STROKE..MODIFIER LETTER SMALL LIGATURE OE
@@ -1674,13 +1676,15 @@ var eastAsianWidth = [][3]int{
 	{0xFB46, 0xFB4F, prN},     // Lo    [10] HEBREW LETTER TSADI WITH DAGESH..HEBREW LIGATURE ALEF LAMED
 	{0xFB50, 0xFBB1, prN},     // Lo    [98] ARABIC LETTER ALEF WASLA ISOLATED FORM..ARABIC LETTER YEH BARREE WITH HAMZA ABOVE FINAL FORM
 	{0xFBB2, 0xFBC2, prN},     // Sk    [17] ARABIC SYMBOL DOT ABOVE..ARABIC SYMBOL WASLA ABOVE
+	{0xFBC3, 0xFBD2, prN},     // So    [16] ARABIC LIGATURE JALLA WA-ALAA..ARABIC LIGATURE ALAYHI AR-RAHMAH
 	{0xFBD3, 0xFD3D, prN},     // Lo   [363] ARABIC LETTER NG ISOLATED FORM..ARABIC LIGATURE ALEF WITH FATHATAN ISOLATED FORM
 	{0xFD3E, 0xFD3E, prN},     // Pe         ORNATE LEFT PARENTHESIS
 	{0xFD3F, 0xFD3F, prN},     // Ps         ORNATE RIGHT PARENTHESIS
 	{0xFD40, 0xFD4F, prN},     // So    [16] ARABIC LIGATURE RAHIMAHU ALLAAH..ARABIC LIGATURE RAHIMAHUM ALLAAH
 	{0xFD50, 0xFD8F, prN},     // Lo    [64] ARABIC LIGATURE TEH WITH JEEM WITH MEEM INITIAL FORM..ARABIC LIGATURE MEEM WITH KHAH WITH MEEM INITIAL FORM
+	{0xFD90, 0xFD91, prN},     // So     [2] ARABIC LIGATURE RAHMATU ALLAAHI ALAYH..ARABIC LIGATURE RAHMATU ALLAAHI ALAYHAA
 	{0xFD92, 0xFDC7, prN},     // Lo    [54] ARABIC LIGATURE MEEM WITH JEEM WITH KHAH INITIAL FORM..ARABIC LIGATURE NOON WITH JEEM WITH YEH FINAL FORM
-	{0xFDCF, 0xFDCF, prN},     // So         ARABIC LIGATURE SALAAMUHU ALAYNAA
+	{0xFDC8, 0xFDCF, prN},     // So     [8] ARABIC LIGATURE RAHIMAHU ALLAAH TAAALAA..ARABIC LIGATURE SALAAMUHU ALAYNAA
 	{0xFDF0, 0xFDFB, prN},     // Lo    [12] ARABIC LIGATURE SALLA USED AS KORANIC STOP SIGN ISOLATED FORM..ARABIC LIGATURE JALLAJALALOUHOU
 	{0xFDFC, 0xFDFC, prN},     // Sc         RIAL SIGN
 	{0xFDFD, 0xFDFF, prN},     // So     [3] ARABIC LIGATURE BISMILLAH AR-RAHMAN AR-RAHEEM..ARABIC LIGATURE AZZA WA JALL
@@ -1840,6 +1844,7 @@ var eastAsianWidth = [][3]int{
 	{0x105A3, 0x105B1, prN},   // Ll    [15] VITHKUQI SMALL LETTER HA..VITHKUQI SMALL LETTER RE
 	{0x105B3, 0x105B9, prN},   // Ll     [7] VITHKUQI SMALL LETTER SE..VITHKUQI SMALL LETTER XE
 	{0x105BB, 0x105BC, prN},   // Ll     [2] VITHKUQI SMALL LETTER Y..VITHKUQI SMALL LETTER ZE
+	{0x105C0, 0x105F3, prN},   // Lo    [52] TODHRI LETTER A..TODHRI LETTER OO
 	{0x10600, 0x10736, prN},   // Lo   [311] LINEAR A SIGN AB001..LINEAR A SIGN A664
 	{0x10740, 0x10755, prN},   // Lo    [22] LINEAR A SIGN A701 A..LINEAR A SIGN A732 JE
 	{0x10760, 0x10767, prN},   // Lo     [8] LINEAR A SIGN A800..LINEAR A SIGN A807
@@ -1868,6 +1873,7 @@ var eastAsianWidth = [][3]int{
 	{0x1091F, 0x1091F, prN},   // Po         PHOENICIAN WORD SEPARATOR
 	{0x10920, 0x10939, prN},   // Lo    [26] LYDIAN LETTER A..LYDIAN LETTER C
 	{0x1093F, 0x1093F, prN},   // Po         LYDIAN TRIANGULAR MARK
+	{0x10940, 0x10959, prN},   // Lo    [26] SIDETIC LETTER N01..SIDETIC LETTER N26
 	{0x10980, 0x1099F, prN},   // Lo    [32] MEROITIC HIEROGLYPHIC LETTER A..MEROITIC HIEROGLYPHIC SYMBOL VIDJ-2
 	{0x109A0, 0x109B7, prN},   // Lo    [24] MEROITIC CURSIVE LETTER A..MEROITIC CURSIVE LETTER DA
 	{0x109BC, 0x109BD, prN},   // No     [2] MEROITIC CURSIVE FRACTION ELEVEN TWELFTHS..MEROITIC CURSIVE FRACTION ONE HALF
@@ -1912,12 +1918,27 @@ var eastAsianWidth = [][3]int{
 	{0x10D00, 0x10D23, prN},   // Lo    [36] HANIFI ROHINGYA LETTER A..HANIFI ROHINGYA MARK NA KHONNA
 	{0x10D24, 0x10D27, prN},   // Mn     [4] HANIFI ROHINGYA SIGN HARBAHAY..HANIFI ROHINGYA SIGN TASSI
 	{0x10D30, 0x10D39, prN},   // Nd    [10] HANIFI ROHINGYA DIGIT ZERO..HANIFI ROHINGYA DIGIT NINE
+	{0x10D40, 0x10D49, prN},   // Nd    [10] GARAY DIGIT ZERO..GARAY DIGIT NINE
+	{0x10D4A, 0x10D4D, prN},   // Lo     [4] GARAY VOWEL SIGN A..GARAY VOWEL SIGN EE
+	{0x10D4E, 0x10D4E, prN},   // Lm         GARAY VOWEL LENGTH MARK
+	{0x10D4F, 0x10D4F, prN},   // Lo         GARAY SUKUN
+	{0x10D50, 0x10D65, prN},   // Lu    [22] GARAY CAPITAL LETTER A..GARAY CAPITAL LETTER OLD NA
+	{0x10D69, 0x10D6D, prN},   // Mn     [5] GARAY VOWEL SIGN E..GARAY CONSONANT NASALIZATION MARK
+	{0x10D6E, 0x10D6E, prN},   // Pd         GARAY HYPHEN
+	{0x10D6F, 0x10D6F, prN},   // Lm         GARAY REDUPLICATION MARK
+	{0x10D70, 0x10D85, prN},   // Ll    [22] GARAY SMALL LETTER A..GARAY SMALL LETTER OLD NA
+	{0x10D8E, 0x10D8F, prN},   // Sm     [2] GARAY PLUS SIGN..GARAY MINUS SIGN
 	{0x10E60, 0x10E7E, prN},   // No    [31] RUMI DIGIT ONE..RUMI FRACTION TWO THIRDS
 	{0x10E80, 0x10EA9, prN},   // Lo    [42] YEZIDI LETTER ELIF..YEZIDI LETTER ET
 	{0x10EAB, 0x10EAC, prN},   // Mn     [2] YEZIDI COMBINING HAMZA MARK..YEZIDI COMBINING MADDA MARK
 	{0x10EAD, 0x10EAD, prN},   // Pd         YEZIDI HYPHENATION MARK
 	{0x10EB0, 0x10EB1, prN},   // Lo     [2] YEZIDI LETTER LAM WITH DOT ABOVE..YEZIDI LETTER YOT WITH CIRCUMFLEX ABOVE
-	{0x10EFD, 0x10EFF, prN},   // Mn     [3] ARABIC SMALL LOW WORD SAKTA..ARABIC SMALL LOW WORD MADDA
+	{0x10EC2, 0x10EC4, prN},   // Lo     [3] ARABIC LETTER DAL WITH TWO DOTS VERTICALLY BELOW..ARABIC LETTER KAF WITH TWO DOTS VERTICALLY BELOW
+	{0x10EC5, 0x10EC5, prN},   // Lm         ARABIC SMALL YEH BARREE WITH TWO DOTS BELOW
+	{0x10EC6, 0x10EC7, prN},   // Lo     [2] ARABIC LETTER THIN NOON..ARABIC LETTER YEH WITH FOUR DOTS BELOW
+	{0x10ED0, 0x10ED0, prN},   // Po         ARABIC BIBLICAL END OF VERSE
+	{0x10ED1, 0x10ED8, prN},   // So     [8] ARABIC LIGATURE ALAYHAA AS-SALAATU WAS-SALAAM..ARABIC LIGATURE NAWWARA ALLAAHU MARQADAH
+	{0x10EFA, 0x10EFF, prN},   // Mn     [6] ARABIC DOUBLE VERTICAL BAR BELOW..ARABIC SMALL LOW WORD MADDA
 	{0x10F00, 0x10F1C, prN},   // Lo    [29] OLD SOGDIAN LETTER ALEPH..OLD SOGDIAN LETTER FINAL TAW WITH VERTICAL TAIL
 	{0x10F1D, 0x10F26, prN},   // No    [10] OLD SOGDIAN NUMBER ONE..OLD SOGDIAN FRACTION ONE HALF
 	{0x10F27, 0x10F27, prN},   // Lo         OLD SOGDIAN LIGATURE AYIN-DALETH
@@ -2034,6 +2055,26 @@ var eastAsianWidth = [][3]int{
 	{0x11362, 0x11363, prN},   // Mc     [2] GRANTHA VOWEL SIGN VOCALIC L..GRANTHA VOWEL SIGN VOCALIC LL
 	{0x11366, 0x1136C, prN},   // Mn     [7] COMBINING GRANTHA DIGIT ZERO..COMBINING GRANTHA DIGIT SIX
 	{0x11370, 0x11374, prN},   // Mn     [5] COMBINING GRANTHA LETTER A..COMBINING GRANTHA LETTER PA
+	{0x11380, 0x11389, prN},   // Lo    [10] TULU-TIGALARI LETTER A..TULU-TIGALARI LETTER VOCALIC LL
+	{0x1138B, 0x1138B, prN},   // Lo         TULU-TIGALARI LETTER EE
+	{0x1138E, 0x1138E, prN},   // Lo         TULU-TIGALARI LETTER AI
+	{0x11390, 0x113B5, prN},   // Lo    [38] TULU-TIGALARI LETTER OO..TULU-TIGALARI LETTER LLLA
+	{0x113B7, 0x113B7, prN},   // Lo         TULU-TIGALARI SIGN AVAGRAHA
+	{0x113B8, 0x113BA, prN},   // Mc     [3] TULU-TIGALARI VOWEL SIGN AA..TULU-TIGALARI VOWEL SIGN II
+	{0x113BB, 0x113C0, prN},   // Mn     [6] TULU-TIGALARI VOWEL SIGN U..TULU-TIGALARI VOWEL SIGN VOCALIC LL
+	{0x113C2, 0x113C2, prN},   // Mc         TULU-TIGALARI VOWEL SIGN EE
+	{0x113C5, 0x113C5, prN},   // Mc         TULU-TIGALARI VOWEL SIGN AI
+	{0x113C7, 0x113CA, prN},   // Mc     [4] TULU-TIGALARI VOWEL SIGN OO..TULU-TIGALARI SIGN CANDRA ANUNASIKA
+	{0x113CC, 0x113CD, prN},   // Mc     [2] TULU-TIGALARI SIGN ANUSVARA..TULU-TIGALARI SIGN VISARGA
+	{0x113CE, 0x113CE, prN},   // Mn         TULU-TIGALARI SIGN VIRAMA
+	{0x113CF, 0x113CF, prN},   // Mc         TULU-TIGALARI SIGN LOOPED VIRAMA
+	{0x113D0, 0x113D0, prN},   // Mn         TULU-TIGALARI CONJOINER
+	{0x113D1, 0x113D1, prN},   // Lo         TULU-TIGALARI REPHA
+	{0x113D2, 0x113D2, prN},   // Mn         TULU-TIGALARI GEMINATION MARK
+	{0x113D3, 0x113D3, prN},   // Lo         TULU-TIGALARI SIGN PLUTA
+	{0x113D4, 0x113D5, prN},   // Po     [2] TULU-TIGALARI DANDA..TULU-TIGALARI DOUBLE DANDA
+	{0x113D7, 0x113D8, prN},   // Po     [2] TULU-TIGALARI SIGN OM PUSHPIKA..TULU-TIGALARI SIGN SHRII PUSHPIKA
+	{0x113E1, 0x113E2, prN},   // Mn     [2] TULU-TIGALARI VEDIC TONE SVARITA..TULU-TIGALARI VEDIC TONE ANUDATTA
 	{0x11400, 0x11434, prN},   // Lo    [53] NEWA LETTER A..NEWA LETTER HA
 	{0x11435, 0x11437, prN},   // Mc     [3] NEWA VOWEL SIGN AA..NEWA VOWEL SIGN II
 	{0x11438, 0x1143F, prN},   // Mn     [8] NEWA VOWEL SIGN U..NEWA VOWEL SIGN AI
@@ -2093,8 +2134,11 @@ var eastAsianWidth = [][3]int{
 	{0x116B8, 0x116B8, prN},   // Lo         TAKRI LETTER ARCHAIC KHA
 	{0x116B9, 0x116B9, prN},   // Po         TAKRI ABBREVIATION SIGN
 	{0x116C0, 0x116C9, prN},   // Nd    [10] TAKRI DIGIT ZERO..TAKRI DIGIT NINE
+	{0x116D0, 0x116E3, prN},   // Nd    [20] MYANMAR PAO DIGIT ZERO..MYANMAR EASTERN PWO KAREN DIGIT NINE
 	{0x11700, 0x1171A, prN},   // Lo    [27] AHOM LETTER KA..AHOM LETTER ALTERNATE BA
-	{0x1171D, 0x1171F, prN},   // Mn     [3] AHOM CONSONANT SIGN MEDIAL LA..AHOM CONSONANT SIGN MEDIAL LIGATING RA
+	{0x1171D, 0x1171D, prN},   // Mn         AHOM CONSONANT SIGN MEDIAL LA
+	{0x1171E, 0x1171E, prN},   // Mc         AHOM CONSONANT SIGN MEDIAL RA
+	{0x1171F, 0x1171F, prN},   // Mn         AHOM CONSONANT SIGN MEDIAL LIGATING RA
 	{0x11720, 0x11721, prN},   // Mc     [2] AHOM VOWEL SIGN A..AHOM VOWEL SIGN AA
 	{0x11722, 0x11725, prN},   // Mn     [4] AHOM VOWEL SIGN I..AHOM VOWEL SIGN UU
 	{0x11726, 0x11726, prN},   // Mc         AHOM VOWEL SIGN E
@@ -2165,6 +2209,15 @@ var eastAsianWidth = [][3]int{
 	{0x11AB0, 0x11ABF, prN},   // Lo    [16] CANADIAN SYLLABICS NATTILIK HI..CANADIAN SYLLABICS SPA
 	{0x11AC0, 0x11AF8, prN},   // Lo    [57] PAU CIN HAU LETTER PA..PAU CIN HAU GLOTTAL STOP FINAL
 	{0x11B00, 0x11B09, prN},   // Po    [10] DEVANAGARI HEAD MARK..DEVANAGARI SIGN MINDU
+	{0x11B60, 0x11B60, prN},   // Mn         SHARADA VOWEL SIGN OE
+	{0x11B61, 0x11B61, prN},   // Mc         SHARADA VOWEL SIGN OOE
+	{0x11B62, 0x11B64, prN},   // Mn     [3] SHARADA VOWEL SIGN UE..SHARADA VOWEL SIGN SHORT E
+	{0x11B65, 0x11B65, prN},   // Mc         SHARADA VOWEL SIGN SHORT O
+	{0x11B66, 0x11B66, prN},   // Mn         SHARADA VOWEL SIGN CANDRA E
+	{0x11B67, 0x11B67, prN},   // Mc         SHARADA VOWEL SIGN CANDRA O
+	{0x11BC0, 0x11BE0, prN},   // Lo    [33] SUNUWAR LETTER DEVI..SUNUWAR LETTER KLOKO
+	{0x11BE1, 0x11BE1, prN},   // Po         SUNUWAR SIGN PVO
+	{0x11BF0, 0x11BF9, prN},   // Nd    [10] SUNUWAR DIGIT ZERO..SUNUWAR DIGIT NINE
 	{0x11C00, 0x11C08, prN},   // Lo     [9] BHAIKSUKI LETTER A..BHAIKSUKI LETTER VOCALIC L
 	{0x11C0A, 0x11C2E, prN},   // Lo    [37] BHAIKSUKI LETTER E..BHAIKSUKI LETTER HA
 	{0x11C2F, 0x11C2F, prN},   // Mc         BHAIKSUKI VOWEL SIGN AA
@@ -2206,6 +2259,10 @@ var eastAsianWidth = [][3]int{
 	{0x11D97, 0x11D97, prN},   // Mn         GUNJALA GONDI VIRAMA
 	{0x11D98, 0x11D98, prN},   // Lo         GUNJALA GONDI OM
 	{0x11DA0, 0x11DA9, prN},   // Nd    [10] GUNJALA GONDI DIGIT ZERO..GUNJALA GONDI DIGIT NINE
+	{0x11DB0, 0x11DD8, prN},   // Lo    [41] TOLONG SIKI LETTER I..TOLONG SIKI LETTER RRH
+	{0x11DD9, 0x11DD9, prN},   // Lm         TOLONG SIKI SIGN SELA
+	{0x11DDA, 0x11DDB, prN},   // Lo     [2] TOLONG SIKI SIGN HECAKA..TOLONG SIKI UNGGA
+	{0x11DE0, 0x11DE9, prN},   // Nd    [10] TOLONG SIKI DIGIT ZERO..TOLONG SIKI DIGIT NINE
 	{0x11EE0, 0x11EF2, prN},   // Lo    [19] MAKASAR LETTER KA..MAKASAR ANGKA
 	{0x11EF3, 0x11EF4, prN},   // Mn     [2] MAKASAR VOWEL SIGN I..MAKASAR VOWEL SIGN U
 	{0x11EF5, 0x11EF6, prN},   // Mc     [2] MAKASAR VOWEL SIGN E..MAKASAR VOWEL SIGN O
@@ -2223,6 +2280,7 @@ var eastAsianWidth = [][3]int{
 	{0x11F42, 0x11F42, prN},   // Mn         KAWI CONJOINER
 	{0x11F43, 0x11F4F, prN},   // Po    [13] KAWI DANDA..KAWI PUNCTUATION CLOSING SPIRAL
 	{0x11F50, 0x11F59, prN},   // Nd    [10] KAWI DIGIT ZERO..KAWI DIGIT NINE
+	{0x11F5A, 0x11F5A, prN},   // Mn         KAWI SIGN NUKTA
 	{0x11FB0, 0x11FB0, prN},   // Lo         LISU LETTER YHA
 	{0x11FC0, 0x11FD4, prN},   // No    [21] TAMIL FRACTION ONE THREE-HUNDRED-AND-TWENTIETH..TAMIL FRACTION DOWNSCALING FACTOR KIIZH
 	{0x11FD5, 0x11FDC, prN},   // So     [8] TAMIL SIGN NEL..TAMIL SIGN MUKKURUNI
@@ -2240,7 +2298,13 @@ var eastAsianWidth = [][3]int{
 	{0x13440, 0x13440, prN},   // Mn         EGYPTIAN HIEROGLYPH MIRROR HORIZONTALLY
 	{0x13441, 0x13446, prN},   // Lo     [6] EGYPTIAN HIEROGLYPH FULL BLANK..EGYPTIAN HIEROGLYPH WIDE LOST SIGN
 	{0x13447, 0x13455, prN},   // Mn    [15] EGYPTIAN HIEROGLYPH MODIFIER DAMAGED AT TOP START..EGYPTIAN HIEROGLYPH MODIFIER DAMAGED
+	{0x13460, 0x143FA, prN},   // Lo  [3995] EGYPTIAN HIEROGLYPH-13460..EGYPTIAN HIEROGLYPH-143FA
 	{0x14400, 0x14646, prN},   // Lo   [583] ANATOLIAN HIEROGLYPH A001..ANATOLIAN HIEROGLYPH A530
+	{0x16100, 0x1611D, prN},   // Lo    [30] GURUNG KHEMA LETTER A..GURUNG KHEMA LETTER SA
+	{0x1611E, 0x16129, prN},   // Mn    [12] GURUNG KHEMA VOWEL SIGN AA..GURUNG KHEMA VOWEL LENGTH MARK
+	{0x1612A, 0x1612C, prN},   // Mc     [3] GURUNG KHEMA CONSONANT SIGN MEDIAL YA..GURUNG KHEMA CONSONANT SIGN MEDIAL HA
+	{0x1612D, 0x1612F, prN},   // Mn     [3] GURUNG KHEMA SIGN ANUSVARA..GURUNG KHEMA SIGN THOLHOMA
+	{0x16130, 0x16139, prN},   // Nd    [10] GURUNG KHEMA DIGIT ZERO..GURUNG KHEMA DIGIT NINE
 	{0x16800, 0x16A38, prN},   // Lo   [569] BAMUM LETTER PHASE-A NGKUE MFON..BAMUM LETTER PHASE-F VUEQ
 	{0x16A40, 0x16A5E, prN},   // Lo    [31] MRO LETTER TA..MRO LETTER TEK
 	{0x16A60, 0x16A69, prN},   // Nd    [10] MRO DIGIT ZERO..MRO DIGIT NINE
@@ -2261,9 +2325,16 @@ var eastAsianWidth = [][3]int{
 	{0x16B5B, 0x16B61, prN},   // No     [7] PAHAWH HMONG NUMBER TENS..PAHAWH HMONG NUMBER TRILLIONS
 	{0x16B63, 0x16B77, prN},   // Lo    [21] PAHAWH HMONG SIGN VOS LUB..PAHAWH HMONG SIGN CIM NRES TOS
 	{0x16B7D, 0x16B8F, prN},   // Lo    [19] PAHAWH HMONG CLAN SIGN TSHEEJ..PAHAWH HMONG CLAN SIGN VWJ
+	{0x16D40, 0x16D42, prN},   // Lm     [3] KIRAT RAI SIGN ANUSVARA..KIRAT RAI SIGN VISARGA
+	{0x16D43, 0x16D6A, prN},   // Lo    [40] KIRAT RAI LETTER A..KIRAT RAI VOWEL SIGN AU
+	{0x16D6B, 0x16D6C, prN},   // Lm     [2] KIRAT RAI SIGN VIRAMA..KIRAT RAI SIGN SAAT
+	{0x16D6D, 0x16D6F, prN},   // Po     [3] KIRAT RAI SIGN YUPI..KIRAT RAI DOUBLE DANDA
+	{0x16D70, 0x16D79, prN},   // Nd    [10] KIRAT RAI DIGIT ZERO..KIRAT RAI DIGIT NINE
 	{0x16E40, 0x16E7F, prN},   // L&    [64] MEDEFAIDRIN CAPITAL LETTER M..MEDEFAIDRIN SMALL LETTER Y
 	{0x16E80, 0x16E96, prN},   // No    [23] MEDEFAIDRIN DIGIT ZERO..MEDEFAIDRIN DIGIT THREE ALTERNATE FORM
 	{0x16E97, 0x16E9A, prN},   // Po     [4] MEDEFAIDRIN COMMA..MEDEFAIDRIN EXCLAMATION OH
+	{0x16EA0, 0x16EB8, prN},   // Lu    [25] BERIA ERFE CAPITAL LETTER ARKAB..BERIA ERFE CAPITAL LETTER AY
+	{0x16EBB, 0x16ED3, prN},   // Ll    [25] BERIA ERFE SMALL LETTER ARKAB..BERIA ERFE SMALL LETTER AY
 	{0x16F00, 0x16F4A, prN},   // Lo    [75] MIAO LETTER PA..MIAO LETTER RTE
 	{0x16F4F, 0x16F4F, prN},   // Mn         MIAO SIGN CONSONANT MODIFIER BAR
 	{0x16F50, 0x16F50, prN},   // Lo         MIAO LETTER NASALIZATION
@@ -2275,10 +2346,14 @@ var eastAsianWidth = [][3]int{
 	{0x16FE3, 0x16FE3, prW},   // Lm         OLD CHINESE ITERATION MARK
 	{0x16FE4, 0x16FE4, prW},   // Mn         KHITAN SMALL SCRIPT FILLER
 	{0x16FF0, 0x16FF1, prW},   // Mc     [2] VIETNAMESE ALTERNATE READING MARK CA..VIETNAMESE ALTERNATE READING MARK NHAY
-	{0x17000, 0x187F7, prW},   // Lo  [6136] TANGUT IDEOGRAPH-17000..TANGUT IDEOGRAPH-187F7
+	{0x16FF2, 0x16FF3, prW},   // Lm     [2] CHINESE SMALL SIMPLIFIED ER..CHINESE SMALL TRADITIONAL ER
+	{0x16FF4, 0x16FF6, prW},   // Nl     [3] YANGQIN SIGN SLOW ONE BEAT..YANGQIN SIGN SLOW TWO BEATS
+	{0x17000, 0x187FF, prW},   // Lo  [6144] TANGUT IDEOGRAPH-17000..TANGUT IDEOGRAPH-187FF
 	{0x18800, 0x18AFF, prW},   // Lo   [768] TANGUT COMPONENT-001..TANGUT COMPONENT-768
 	{0x18B00, 0x18CD5, prW},   // Lo   [470] KHITAN SMALL SCRIPT CHARACTER-18B00..KHITAN SMALL SCRIPT CHARACTER-18CD5
-	{0x18D00, 0x18D08, prW},   // Lo     [9] TANGUT IDEOGRAPH-18D00..TANGUT IDEOGRAPH-18D08
+	{0x18CFF, 0x18CFF, prW},   // Lo         KHITAN SMALL SCRIPT CHARACTER-18CFF
+	{0x18D00, 0x18D1E, prW},   // Lo    [31] TANGUT IDEOGRAPH-18D00..TANGUT IDEOGRAPH-18D1E
+	{0x18D80, 0x18DF2, prW},   // Lo   [115] TANGUT COMPONENT-769..TANGUT COMPONENT-883
 	{0x1AFF0, 0x1AFF3, prW},   // Lm     [4] KATAKANA LETTER MINNAN TONE-2..KATAKANA LETTER MINNAN TONE-5
 	{0x1AFF5, 0x1AFFB, prW},   // Lm     [7] KATAKANA LETTER MINNAN TONE-7..KATAKANA LETTER MINNAN NASALIZED TONE-5
 	{0x1AFFD, 0x1AFFE, prW},   // Lm     [2] KATAKANA LETTER MINNAN NASALIZED TONE-7..KATAKANA LETTER MINNAN NASALIZED TONE-8
@@ -2297,6 +2372,14 @@ var eastAsianWidth = [][3]int{
 	{0x1BC9D, 0x1BC9E, prN},   // Mn     [2] DUPLOYAN THICK LETTER SELECTOR..DUPLOYAN DOUBLE MARK
 	{0x1BC9F, 0x1BC9F, prN},   // Po         DUPLOYAN PUNCTUATION CHINOOK FULL STOP
 	{0x1BCA0, 0x1BCA3, prN},   // Cf     [4] SHORTHAND FORMAT LETTER OVERLAP..SHORTHAND FORMAT UP STEP
+	{0x1CC00, 0x1CCEF, prN},   // So   [240] UP-POINTING GO-KART..OUTLINED LATIN CAPITAL LETTER Z
+	{0x1CCF0, 0x1CCF9, prN},   // Nd    [10] OUTLINED DIGIT ZERO..OUTLINED DIGIT NINE
+	{0x1CCFA, 0x1CCFC, prN},   // So     [3] SNAKE SYMBOL..NOSE SYMBOL
+	{0x1CD00, 0x1CEB3, prN},   // So   [436] BLOCK OCTANT-3..BLACK RIGHT TRIANGLE CARET
+	{0x1CEBA, 0x1CEBF, prN},   // So     [6] FRAGILE SYMBOL..STRAWBERRY SYMBOL
+	{0x1CEC0, 0x1CED0, prN},   // So    [17] HEBE..LEUKOTHEA
+	{0x1CEE0, 0x1CEEF, prN},   // So    [16] GEOMANTIC FIGURE POPULUS..GEOMANTIC FIGURE VIA
+	{0x1CEF0, 0x1CEF0, prN},   // Sm         MEDIUM SMALL WHITE CIRCLE WITH HORIZONTAL BAR
 	{0x1CF00, 0x1CF2D, prN},   // Mn    [46] ZNAMENNY COMBINING MARK GORAZDO NIZKO S KRYZHEM ON LEFT..ZNAMENNY COMBINING MARK KRYZH ON LEFT
 	{0x1CF30, 0x1CF46, prN},   // Mn    [23] ZNAMENNY COMBINING TONAL RANGE MARK MRACHNO..ZNAMENNY PRIZNAK MODIFIER ROG
 	{0x1CF50, 0x1CFC3, prN},   // So   [116] ZNAMENNY NEUME KRYUK..ZNAMENNY NEUME PAUK
@@ -2319,8 +2402,9 @@ var eastAsianWidth = [][3]int{
 	{0x1D245, 0x1D245, prN},   // So         GREEK MUSICAL LEIMMA
 	{0x1D2C0, 0x1D2D3, prN},   // No    [20] KAKTOVIK NUMERAL ZERO..KAKTOVIK NUMERAL NINETEEN
 	{0x1D2E0, 0x1D2F3, prN},   // No    [20] MAYAN NUMERAL ZERO..MAYAN NUMERAL NINETEEN
-	{0x1D300, 0x1D356, prN},   // So    [87] MONOGRAM FOR EARTH..TETRAGRAM FOR FOSTERING
-	{0x1D360, 0x1D378, prN},   // No    [25] COUNTING ROD UNIT DIGIT ONE..TALLY MARK FIVE
+	{0x1D300, 0x1D356, prW},   // So    [87] MONOGRAM FOR EARTH..TETRAGRAM FOR FOSTERING
+	{0x1D360, 0x1D376, prW},   // No    [23] COUNTING ROD UNIT DIGIT ONE..IDEOGRAPHIC TALLY MARK FIVE
+	{0x1D377, 0x1D378, prN},   // No     [2] TALLY MARK ONE..TALLY MARK FIVE
 	{0x1D400, 0x1D454, prN},   // L&    [85] MATHEMATICAL BOLD CAPITAL A..MATHEMATICAL ITALIC SMALL G
 	{0x1D456, 0x1D49C, prN},   // L&    [71] MATHEMATICAL ITALIC SMALL I..MATHEMATICAL SCRIPT CAPITAL A
 	{0x1D49E, 0x1D49F, prN},   // Lu     [2] MATHEMATICAL SCRIPT CAPITAL C..MATHEMATICAL SCRIPT CAPITAL D
@@ -2401,6 +2485,22 @@ var eastAsianWidth = [][3]int{
 	{0x1E4EB, 0x1E4EB, prN},   // Lm         NAG MUNDARI SIGN OJOD
 	{0x1E4EC, 0x1E4EF, prN},   // Mn     [4] NAG MUNDARI SIGN MUHOR..NAG MUNDARI SIGN SUTUH
 	{0x1E4F0, 0x1E4F9, prN},   // Nd    [10] NAG MUNDARI DIGIT ZERO..NAG MUNDARI DIGIT NINE
+	{0x1E5D0, 0x1E5ED, prN},   // Lo    [30] OL ONAL LETTER O..OL ONAL LETTER EG
+	{0x1E5EE, 0x1E5EF, prN},   // Mn     [2] OL ONAL SIGN MU..OL ONAL SIGN IKIR
+	{0x1E5F0, 0x1E5F0, prN},   // Lo         OL ONAL SIGN HODDOND
+	{0x1E5F1, 0x1E5FA, prN},   // Nd    [10] OL ONAL DIGIT ZERO..OL ONAL DIGIT NINE
+	{0x1E5FF, 0x1E5FF, prN},   // Po         OL ONAL ABBREVIATION SIGN
+	{0x1E6C0, 0x1E6DE, prN},   // Lo    [31] TAI YO LETTER LOW KO..TAI YO LETTER HIGH KVO
+	{0x1E6E0, 0x1E6E2, prN},   // Lo     [3] TAI YO LETTER AA..TAI YO LETTER UE
+	{0x1E6E3, 0x1E6E3, prN},   // Mn         TAI YO SIGN UE
+	{0x1E6E4, 0x1E6E5, prN},   // Lo     [2] TAI YO LETTER U..TAI YO LETTER AE
+	{0x1E6E6, 0x1E6E6, prN},   // Mn         TAI YO SIGN AU
+	{0x1E6E7, 0x1E6ED, prN},   // Lo     [7] TAI YO LETTER O..TAI YO LETTER AUE
+	{0x1E6EE, 0x1E6EF, prN},   // Mn     [2] TAI YO SIGN AY..TAI YO SIGN ANG
+	{0x1E6F0, 0x1E6F4, prN},   // Lo     [5] TAI YO LETTER AN..TAI YO LETTER AP
+	{0x1E6F5, 0x1E6F5, prN},   // Mn         TAI YO SIGN OM
+	{0x1E6FE, 0x1E6FE, prN},   // Lo         TAI YO SYMBOL MUEANG
+	{0x1E6FF, 0x1E6FF, prN},   // Lm         TAI YO XAM LAI
 	{0x1E7E0, 0x1E7E6, prN},   // Lo     [7] ETHIOPIC SYLLABLE HHYA..ETHIOPIC SYLLABLE HHYO
 	{0x1E7E8, 0x1E7EB, prN},   // Lo     [4] ETHIOPIC SYLLABLE GURAGE HHWA..ETHIOPIC SYLLABLE HHWE
 	{0x1E7ED, 0x1E7EE, prN},   // Lo     [2] ETHIOPIC SYLLABLE GURAGE MWI..ETHIOPIC SYLLABLE GURAGE MWEE
@@ -2528,14 +2628,13 @@ var eastAsianWidth = [][3]int{
 	{0x1F6CD, 0x1F6CF, prN},   // So     [3] SHOPPING BAGS..BED
 	{0x1F6D0, 0x1F6D2, prW},   // So     [3] PLACE OF WORSHIP..SHOPPING TROLLEY
 	{0x1F6D3, 0x1F6D4, prN},   // So     [2] STUPA..PAGODA
-	{0x1F6D5, 0x1F6D7, prW},   // So     [3] HINDU TEMPLE..ELEVATOR
+	{0x1F6D5, 0x1F6D8, prW},   // So     [4] HINDU TEMPLE..LANDSLIDE
 	{0x1F6DC, 0x1F6DF, prW},   // So     [4] WIRELESS..RING BUOY
 	{0x1F6E0, 0x1F6EA, prN},   // So    [11] HAMMER AND WRENCH..NORTHEAST-POINTING AIRPLANE
 	{0x1F6EB, 0x1F6EC, prW},   // So     [2] AIRPLANE DEPARTURE..AIRPLANE ARRIVING
 	{0x1F6F0, 0x1F6F3, prN},   // So     [4] SATELLITE..PASSENGER SHIP
 	{0x1F6F4, 0x1F6FC, prW},   // So     [9] SCOOTER..ROLLER SKATE
-	{0x1F700, 0x1F776, prN},   // So   [119] ALCHEMICAL SYMBOL FOR QUINTESSENCE..LUNAR ECLIPSE
-	{0x1F77B, 0x1F77F, prN},   // So     [5] HAUMEA..ORCUS
+	{0x1F700, 0x1F77F, prN},   // So   [128] ALCHEMICAL SYMBOL FOR QUINTESSENCE..ORCUS
 	{0x1F780, 0x1F7D9, prN},   // So    [90] BLACK LEFT-POINTING ISOSCELES RIGHT TRIANGLE..NINE POINTED WHITE STAR
 	{0x1F7E0, 0x1F7EB, prW},   // So    [12] LARGE ORANGE CIRCLE..LARGE BROWN SQUARE
 	{0x1F7F0, 0x1F7F0, prW},   // So         HEAVY EQUALS SIGN
@@ -2544,42 +2643,49 @@ var eastAsianWidth = [][3]int{
 	{0x1F850, 0x1F859, prN},   // So    [10] LEFTWARDS SANS-SERIF ARROW..UP DOWN SANS-SERIF ARROW
 	{0x1F860, 0x1F887, prN},   // So    [40] WIDE-HEADED LEFTWARDS LIGHT BARB ARROW..WIDE-HEADED SOUTH WEST VERY HEAVY BARB ARROW
 	{0x1F890, 0x1F8AD, prN},   // So    [30] LEFTWARDS TRIANGLE ARROWHEAD..WHITE ARROW SHAFT WIDTH TWO THIRDS
-	{0x1F8B0, 0x1F8B1, prN},   // So     [2] ARROW POINTING UPWARDS THEN NORTH WEST..ARROW POINTING RIGHTWARDS THEN CURVING SOUTH WEST
+	{0x1F8B0, 0x1F8BB, prN},   // So    [12] ARROW POINTING UPWARDS THEN NORTH WEST..SOUTH WEST ARROW FROM BAR
+	{0x1F8C0, 0x1F8C1, prN},   // So     [2] LEFTWARDS ARROW FROM DOWNWARDS ARROW..RIGHTWARDS ARROW FROM DOWNWARDS ARROW
+	{0x1F8D0, 0x1F8D8, prN},   // Sm     [9] LONG RIGHTWARDS ARROW OVER LONG LEFTWARDS ARROW..LONG LEFT RIGHT ARROW WITH DEPENDENT LOBE
 	{0x1F900, 0x1F90B, prN},   // So    [12] CIRCLED CROSS FORMEE WITH FOUR DOTS..DOWNWARD FACING NOTCHED HOOK WITH DOT
 	{0x1F90C, 0x1F93A, prW},   // So    [47] PINCHED FINGERS..FENCER
 	{0x1F93B, 0x1F93B, prN},   // So         MODERN PENTATHLON
 	{0x1F93C, 0x1F945, prW},   // So    [10] WRESTLERS..GOAL NET
 	{0x1F946, 0x1F946, prN},   // So         RIFLE
 	{0x1F947, 0x1F9FF, prW},   // So   [185] FIRST PLACE MEDAL..NAZAR AMULET
-	{0x1FA00, 0x1FA53, prN},   // So    [84] NEUTRAL CHESS KING..BLACK CHESS KNIGHT-BISHOP
+	{0x1FA00, 0x1FA57, prN},   // So    [88] NEUTRAL CHESS KING..BLACK CHESS ALFIL
 	{0x1FA60, 0x1FA6D, prN},   // So    [14] XIANGQI RED GENERAL..XIANGQI BLACK SOLDIER
 	{0x1FA70, 0x1FA7C, prW},   // So    [13] BALLET SHOES..CRUTCH
-	{0x1FA80, 0x1FA88, prW},   // So     [9] YO-YO..FLUTE
-	{0x1FA90, 0x1FABD, prW},   // So    [46] RINGED PLANET..WING
-	{0x1FABF, 0x1FAC5, prW},   // So     [7] GOOSE..PERSON WITH CROWN
-	{0x1FACE, 0x1FADB, prW},   // So    [14] MOOSE..PEA POD
-	{0x1FAE0, 0x1FAE8, prW},   // So     [9] MELTING FACE..SHAKING FACE
-	{0x1FAF0, 0x1FAF8, prW},   // So     [9] HAND WITH INDEX FINGER AND THUMB CROSSED..RIGHTWARDS PUSHING HAND
+	{0x1FA80, 0x1FA8A, prW},   // So    [11] YO-YO..TROMBONE
+	{0x1FA8E, 0x1FAC6, prW},   // So    [57] TREASURE CHEST..FINGERPRINT
+	{0x1FAC8, 0x1FAC8, prW},   // So         HAIRY CREATURE
+	{0x1FACD, 0x1FADC, prW},   // So    [16] ORCA..ROOT VEGETABLE
+	{0x1FADF, 0x1FAEA, prW},   // So    [12] SPLATTER..DISTORTED FACE
+	{0x1FAEF, 0x1FAF8, prW},   // So    [10] FIGHT CLOUD..RIGHTWARDS PUSHING HAND
 	{0x1FB00, 0x1FB92, prN},   // So   [147] BLOCK SEXTANT-1..UPPER HALF INVERSE MEDIUM SHADE AND LOWER HALF BLOCK
-	{0x1FB94, 0x1FBCA, prN},   // So    [55] LEFT HALF INVERSE MEDIUM SHADE AND RIGHT HALF BLOCK..WHITE UP-POINTING CHEVRON
+	{0x1FB94, 0x1FBEF, prN},   // So    [92] LEFT HALF INVERSE MEDIUM SHADE AND RIGHT HALF BLOCK..TOP LEFT JUSTIFIED LOWER RIGHT QUARTER BLACK CIRCLE
 	{0x1FBF0, 0x1FBF9, prN},   // Nd    [10] SEGMENTED DIGIT ZERO..SEGMENTED DIGIT NINE
+	{0x1FBFA, 0x1FBFA, prN},   // So         ALARM BELL SYMBOL
 	{0x20000, 0x2A6DF, prW},   // Lo [42720] CJK UNIFIED IDEOGRAPH-20000..CJK UNIFIED IDEOGRAPH-2A6DF
 	{0x2A6E0, 0x2A6FF, prW},   // Cn    [32] <reserved-2A6E0>..<reserved-2A6FF>
-	{0x2A700, 0x2B739, prW},   // Lo  [4154] CJK UNIFIED IDEOGRAPH-2A700..CJK UNIFIED IDEOGRAPH-2B739
-	{0x2B73A, 0x2B73F, prW},   // Cn     [6] <reserved-2B73A>..<reserved-2B73F>
+	{0x2A700, 0x2B73F, prW},   // Lo  [4160] CJK UNIFIED IDEOGRAPH-2A700..CJK UNIFIED IDEOGRAPH-2B73F
 	{0x2B740, 0x2B81D, prW},   // Lo   [222] CJK UNIFIED IDEOGRAPH-2B740..CJK UNIFIED IDEOGRAPH-2B81D
 	{0x2B81E, 0x2B81F, prW},   // Cn     [2] <reserved-2B81E>..<reserved-2B81F>
-	{0x2B820, 0x2CEA1, prW},   // Lo  [5762] CJK UNIFIED IDEOGRAPH-2B820..CJK UNIFIED IDEOGRAPH-2CEA1
-	{0x2CEA2, 0x2CEAF, prW},   // Cn    [14] <reserved-2CEA2>..<reserved-2CEAF>
+	{0x2B820, 0x2CEAD, prW},   // Lo  [5774] CJK UNIFIED IDEOGRAPH-2B820..CJK UNIFIED IDEOGRAPH-2CEAD
+	{0x2CEAE, 0x2CEAF, prW},   // Cn     [2] <reserved-2CEAE>..<reserved-2CEAF>
 	{0x2CEB0, 0x2EBE0, prW},   // Lo  [7473] CJK UNIFIED IDEOGRAPH-2CEB0..CJK UNIFIED IDEOGRAPH-2EBE0
-	{0x2EBE1, 0x2F7FF, prW},   // Cn  [3103] <reserved-2EBE1>..<reserved-2F7FF>
+	{0x2EBE1, 0x2EBEF, prW},   // Cn    [15] <reserved-2EBE1>..<reserved-2EBEF>
+	{0x2EBF0, 0x2EE5D, prW},   // Lo   [622] CJK UNIFIED IDEOGRAPH-2EBF0..CJK UNIFIED IDEOGRAPH-2EE5D
+	{0x2EE5E, 0x2EE5F, prW},   // Cn     [2] <reserved-2EE5E>..<reserved-2EE5F>
+	{0x2EE60, 0x2F7FF, prW},   // Cn  [2464] <reserved-2EE60>..<reserved-2F7FF>
 	{0x2F800, 0x2FA1D, prW},   // Lo   [542] CJK COMPATIBILITY IDEOGRAPH-2F800..CJK COMPATIBILITY IDEOGRAPH-2FA1D
 	{0x2FA1E, 0x2FA1F, prW},   // Cn     [2] <reserved-2FA1E>..<reserved-2FA1F>
 	{0x2FA20, 0x2FFFD, prW},   // Cn  [1502] <reserved-2FA20>..<reserved-2FFFD>
 	{0x30000, 0x3134A, prW},   // Lo  [4939] CJK UNIFIED IDEOGRAPH-30000..CJK UNIFIED IDEOGRAPH-3134A
 	{0x3134B, 0x3134F, prW},   // Cn     [5] <reserved-3134B>..<reserved-3134F>
 	{0x31350, 0x323AF, prW},   // Lo  [4192] CJK UNIFIED IDEOGRAPH-31350..CJK UNIFIED IDEOGRAPH-323AF
-	{0x323B0, 0x3FFFD, prW},   // Cn [56398] <reserved-323B0>..<reserved-3FFFD>
+	{0x323B0, 0x33479, prW},   // Lo  [4298] CJK UNIFIED IDEOGRAPH-323B0..CJK UNIFIED IDEOGRAPH-33479
+	{0x3347A, 0x3347F, prW},   // Cn     [6] <reserved-3347A>..<reserved-3347F>
+	{0x33480, 0x3FFFD, prW},   // Cn [52094] <reserved-33480>..<reserved-3FFFD>
 	{0xE0001, 0xE0001, prN},   // Cf         LANGUAGE TAG
 	{0xE0020, 0xE007F, prN},   // Cf    [96] TAG SPACE..CANCEL TAG
 	{0xE0100, 0xE01EF, prA},   // Mn   [240] VARIATION SELECTOR-17..VARIATION SELECTOR-256
@@ -0,0 +1,160 @@
+package uniseg
+
+// ECMA-48 control characters which introduce escape sequences.
+const (
+	escESC = 0x1b // Escape.
+	escBEL = 0x07 // Bell, terminates OSC sequences in xterm.
+	escDCS = 0x90 // Device Control String.
+	escSOS = 0x98 // Start of String.
+	escCSI = 0x9b // Control Sequence Introducer.
+	escST  = 0x9c // String Terminator.
+	escOSC = 0x9d // Operating System Command.
+	escPM  = 0x9e // Privacy Message.
+	escAPC = 0x9f // Application Program Command.
+)
+
+// escapeSequenceLength returns the length, in code units, of the ECMA-48
+// escape sequence at the beginning of the given text. If there is no complete
+// escape sequence, 0 is returned. Escape sequences are only recognized in UTF-8
+// encoded text, i.e. 0 is always returned for rune slices and UTF-16 text.
+//
+// The following sequences are recognized, both with a 7-bit ESC prefix and with
+// their UTF-8 encoded 8-bit C1 introducer:
+//
+//   - Control sequences (CSI), e.g. "ESC [ 1 ; 31 m" (SGR).
+//   - Command strings (OSC, terminated by ST or BEL, e.g. OSC 8 hyperlinks)
+//     and character strings (DCS, SOS, PM, APC, terminated by ST).
+//   - Other escape sequences consisting of ESC, any number of intermediate
+//     bytes, and a final byte, e.g. "ESC 7" or "ESC ( B".
+func escapeSequenceLength[T text](t T) int {
+	switch u := any(t).(type) {
+	case []byte:
+		return utf8EscapeSequenceLength(u)
+	case string:
+		return utf8EscapeSequenceLength(u)
+	}
+	return 0
+}
+
+// utf8EscapeSequenceLength implements [escapeSequenceLength] for UTF-8 encoded
+// text.
+func utf8EscapeSequenceLength[T []byte | string](t T) int {
+	length := len(t)
+
+	// Determine the introducer.
+	if length < 2 {
+		return 0
+	}
+	var introducer byte
+	pos := 2
+	switch t[0] {
+	case escESC:
+		switch c := t[1]; c {
+		case '[':
+			introducer = escCSI
+		case ']':
+			introducer = escOSC
+		case 'P':
+			introducer = escDCS
+		case 'X':
+			introducer = escSOS
+		case '^':
+			introducer = escPM
+		case '_':
+			introducer = escAPC
+		default:
+			// ESC, intermediate bytes, final byte.
+			pos = 1
+			for pos < length && t[pos] >= 0x20 && t[pos] <= 0x2f {
+				pos++
+			}
+			if pos < length && t[pos] >= 0x30 && t[pos] <= 0x7e {
+				return pos + 1
+			}
+			return 0
+		}
+	case 0xc2: // UTF-8 encoded C1 control characters (U+0080 to U+009F).
+		switch c := t[1]; c {
+		case escCSI, escOSC, escDCS, escSOS, escPM, escAPC:
+			introducer = c
+		default:
+			return 0
+		}
+	default:
+		return 0
+	}
+
+	// Control sequences: parameter bytes, intermediate bytes, final byte.
+	if introducer == escCSI {
+		for pos < length && t[pos] >= 0x30 && t[pos] <= 0x3f {
+			pos++
+		}
+		for pos < length && t[pos] >= 0x20 && t[pos] <= 0x2f {
+			pos++
+		}
+		if pos < length && t[pos] >= 0x40 && t[pos] <= 0x7e {
+			return pos + 1
+		}
+		return 0
+	}
+
+	// Control strings: anything up to the string terminator.
+	for ; pos < length; pos++ {
+		switch t[pos] {
+		case escBEL:
+			if introducer == escOSC {
+				return pos + 1
+			}
+		case escESC:
+			if pos+1 < length && t[pos+1] == '\\' {
+				return pos + 2
+			}
+		case 0xc2:
+			if pos+1 < length && t[pos+1] == escST {
+				return pos + 2
+			}
+		}
+	}
+	return 0
+}
+
+// skipEscapeSequences returns the given text without the escape sequences at
+// its beginning, if escape sequences are enabled in the width configuration
+// (which may be nil).
+func skipEscapeSequences[T text](t T, config *WidthConfig) T {
+	if config == nil || !config.EscapeSequences {
+		return t
+	}
+	for {
+		length := escapeSequenceLength(t)
+		if length == 0 {
+			return t
+		}
+		_, t = splitText(t, length)
+	}
+}
+
+// escapeBoundaries returns the boundaries value [Step] reports for an escape
+// sequence. Escape sequences have a width of 0 and are transparent, i.e. the
+// boundaries around them are reported with the grapheme cluster preceding
+// them (see [step]). If "last" is true, the escape sequence is at the end of
+// the text.
+func escapeBoundaries(last bool) int {
+	if last {
+		return LineMustBreak | (1 << shiftWord) | (1 << shiftSentence)
+	}
+	return LineDontBreak
+}
+
+// escapeSequences returns all escape sequences contained in the given string,
+// concatenated, in their original order.
+func escapeSequences(str string) string {
+	var result []byte
+	for index := 0; index < len(str); index++ {
+		if length := escapeSequenceLength(str[index:]); length > 0 {
+			result = append(result, str[index:index+length]...)
+			index += length - 1
+		}
+	}
+	return string(result)
+}
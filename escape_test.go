@@ -0,0 +1,205 @@
+package uniseg
+
+import (
+	"strings"
+	"testing"
+)
+
+// Test cases for the recognition of escape sequences.
+var escapeTestCases = []struct {
+	original string
+	length   int
+}{
+	{"", 0},
+	{"a", 0},
+	{"\x1b", 0},
+	{"\x1b[", 0},
+	{"\x1b[m", 3},
+	{"\x1b[0m", 4},
+	{"\x1b[1;31mred", 7},
+	{"\x1b[38;2;255;0;0m", 15},
+	{"\x1b[?25l", 6},
+	{"\x1b[ q", 4},
+	{"\x1b[1;31", 0},
+	{"\x1b[1;31\x07m", 0},
+	{"\u009b1m", 4},
+	{"\x1b]8;;https://example.com\x1b\\link", 26},
+	{"\x1b]8;;https://example.com\x07link", 25},
+	{"\x1b]0;Ümlaut title\u009c", 19},
+	{"\u009d8;;\x07", 6},
+	{"\x1b]8;;https://example.com", 0},
+	{"\x1bPq#0;2;0;0;0\x1b\\", 15},
+	{"\x1bP\x07\x1b\\", 5},
+	{"\x1b_Gf=100\x1b\\", 10},
+	{"\x1b7", 2},
+	{"\x1b(B", 3},
+	{"\x1b(", 0},
+	{"\x1b\x1b[m", 0},
+}
+
+// Test the recognition of escape sequences.
+func TestEscapeSequenceLength(t *testing.T) {
+	for index, testCase := range escapeTestCases {
+		if length := escapeSequenceLength(testCase.original); length != testCase.length {
+			t.Errorf(`Test case %d %q failed: Expected length %d, got %d (string)`, index, testCase.original, testCase.length, length)
+		}
+		if length := escapeSequenceLength([]byte(testCase.original)); length != testCase.length {
+			t.Errorf(`Test case %d %q failed: Expected length %d, got %d (bytes)`, index, testCase.original, testCase.length, length)
+		}
+	}
+}
+
+// Test the segmentation of strings containing escape sequences.
+func TestEscapeSegmentation(t *testing.T) {
+	config := NewWidthConfig()
+	config.EscapeSequences = true
+	for index, testCase := range []struct {
+		original string
+		expected []string
+		width    int
+	}{
+		{"", nil, 0},
+		{"\x1b[31m", []string{"\x1b[31m"}, 0},
+		{"\x1b[31mred\x1b[0m", []string{"\x1b[31m", "r", "e", "d", "\x1b[0m"}, 3},
+		{"\x1b[1m世界\x1b[0m!", []string{"\x1b[1m", "世", "界", "\x1b[0m", "!"}, 5},
+		{"\x1b[1m\x1b[31m🇩🇪\x1b[0m", []string{"\x1b[1m", "\x1b[31m", "🇩🇪", "\x1b[0m"}, 2},
+		{"\x1b]8;;https://example.com\x1b\\link\x1b]8;;\x1b\\", []string{"\x1b]8;;https://example.com\x1b\\", "l", "i", "n", "k", "\x1b]8;;\x1b\\"}, 4},
+		{"\x1b[1", []string{"\x1b", "[", "1"}, 2},
+		{"a\r\n\x1b[0m", []string{"a", "\r\n", "\x1b[0m"}, 1},
+		{"e\x1b[1m\u0301x", []string{"e\x1b[1m\u0301", "x"}, 2},
+		{"🇩\x1b[1m🇪🇩", []string{"🇩\x1b[1m🇪", "🇩"}, 4},
+		{"a\u0301\x1b[0m", []string{"a\u0301", "\x1b[0m"}, 1},
+	} {
+		var (
+			clusters []string
+			width    int
+		)
+		g := config.NewGraphemes(testCase.original)
+		for g.Next() {
+			clusters = append(clusters, g.Str())
+			width += g.Width()
+		}
+		if strings.Join(clusters, "|") != strings.Join(testCase.expected, "|") {
+			t.Errorf(`Test case %d %q failed: Expected clusters %q, got %q`, index, testCase.original, testCase.expected, clusters)
+		}
+		if width != testCase.width {
+			t.Errorf(`Test case %d %q failed: Expected width %d, got %d (Graphemes)`, index, testCase.original, testCase.width, width)
+		}
+		if w := config.StringWidth(testCase.original); w != testCase.width {
+			t.Errorf(`Test case %d %q failed: Expected width %d, got %d (StringWidth)`, index, testCase.original, testCase.width, w)
+		}
+
+		clusters = nil
+		state := -1
+		b := []byte(testCase.original)
+		for len(b) > 0 {
+			var cluster []byte
+			cluster, b, _, state = config.FirstGraphemeCluster(b, state)
+			clusters = append(clusters, string(cluster))
+		}
+		if strings.Join(clusters, "|") != strings.Join(testCase.expected, "|") {
+			t.Errorf(`Test case %d %q failed: Expected clusters %q, got %q (FirstGraphemeCluster)`, index, testCase.original, testCase.expected, clusters)
+		}
+	}
+}
+
+// Escape sequences must not change the boundaries of the text around them.
+func TestEscapeBoundaries(t *testing.T) {
+	config := NewWidthConfig()
+	config.EscapeSequences = true
+	var (
+		words, lines []string
+		word, line   string
+		boundaries   int
+	)
+	state, str := -1, "\x1b[1mHello\x1b[0m, big foo\x1b[1mbar\x1b[0m!"
+	for len(str) > 0 {
+		var cluster string
+		cluster, str, boundaries, state = config.StepString(str, state)
+		word += cluster
+		line += cluster
+		if boundaries&MaskWord != 0 {
+			words = append(words, word)
+			word = ""
+		}
+		if boundaries&MaskLine != LineDontBreak {
+			lines = append(lines, line)
+			line = ""
+		}
+	}
+	expectedWords := []string{"\x1b[1mHello", "\x1b[0m,", " ", "big", " ", "foo\x1b[1mbar", "\x1b[0m!"}
+	if strings.Join(words, "|") != strings.Join(expectedWords, "|") {
+		t.Errorf(`Expected words %q, got %q`, expectedWords, words)
+	}
+	expectedLines := []string{"\x1b[1mHello\x1b[0m, ", "big ", "foo\x1b[1mbar\x1b[0m!"}
+	if strings.Join(lines, "|") != strings.Join(expectedLines, "|") {
+		t.Errorf(`Expected line segments %q, got %q`, expectedLines, lines)
+	}
+}
+
+// Test truncation and wrapping of strings containing escape sequences.
+func TestEscapeTruncateWrap(t *testing.T) {
+	config := NewWidthConfig()
+	config.EscapeSequences = true
+	for index, testCase := range []struct {
+		function func(str string) string
+		original string
+		expected string
+	}{
+		{
+			function: func(str string) string { return config.TruncateString(str, 8, "…") },
+			original: "\x1b[31mHello, world!\x1b[0m",
+			expected: "\x1b[31mHello, \x1b[0m…",
+		},
+		{
+			function: func(str string) string { return config.TruncateString(str, 20, "…") },
+			original: "\x1b[31mHello, world!\x1b[0m",
+			expected: "\x1b[31mHello, world!\x1b[0m",
+		},
+		{
+			function: func(str string) string { return config.TruncateString(str, 3, "…") },
+			original: "\x1b[1m世界\x1b[0m",
+			expected: "\x1b[1m世\x1b[0m…",
+		},
+		{
+			function: func(str string) string { return config.TruncateString(str, 0, "…") },
+			original: "\x1b[1mabc\x1b[0m",
+			expected: "\x1b[1m\x1b[0m",
+		},
+		{
+			function: func(str string) string { return config.TruncateStringLeft(str, 6, "…") },
+			original: "\x1b[34m/home/\x1b[1muser\x1b[0m",
+			expected: "\x1b[34m…/\x1b[1muser\x1b[0m",
+		},
+		{
+			function: func(str string) string { return config.TruncateStringMiddle(str, 5, "…") },
+			original: "ab\x1b[1mcdefg\x1b[0mhi",
+			expected: "ab\x1b[1m…\x1b[0mhi",
+		},
+		{
+			function: func(str string) string { return TruncateString(str, 8, "…") },
+			original: "\x1b[31mHello, world!\x1b[0m",
+			expected: "\x1b[31mHel…", // Escape sequences are text by default.
+		},
+		{
+			function: func(str string) string {
+				return strings.Join(WrapString(str, 10, &WrapOptions{WidthConfig: config}), "\n")
+			},
+			original: "\x1b[1mHello,\x1b[0m this is a \x1b]8;;https://example.com\x07test\x1b]8;;\x07.",
+			expected: "\x1b[1mHello,\x1b[0m\nthis is a\n\x1b]8;;https://example.com\x07test\x1b]8;;\x07.",
+		},
+		{
+			function: func(str string) string {
+				options := NewOptimalWrapOptions()
+				options.WidthConfig = config
+				return strings.Join(WrapStringOptimal(str, 6, options), "\n")
+			},
+			original: "\x1b[32maaa bb cc ddddd\x1b[0m",
+			expected: "\x1b[32maaa\nbb cc\nddddd\x1b[0m",
+		},
+	} {
+		if actual := testCase.function(testCase.original); actual != testCase.expected {
+			t.Errorf(`Test case %d %q failed: Expected %q, got %q`, index, testCase.original, testCase.expected, actual)
+		}
+	}
+}
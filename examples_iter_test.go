@@ -0,0 +1,42 @@
+//go:build go1.23
+
+package uniseg_test
+
+import (
+	"fmt"
+
+	"github.com/rivo/uniseg"
+)
+
+func ExampleGraphemesSeq() {
+	for cluster := range uniseg.GraphemesSeq("🇩🇪🏳️‍🌈!") {
+		fmt.Println(cluster)
+	}
+	// Output: 🇩🇪
+	//🏳️‍🌈
+	//!
+}
+
+func ExampleWordsSeq() {
+	for word := range uniseg.WordsSeq("Hello, world!") {
+		fmt.Printf("(%s)\n", word)
+	}
+	// Output: (Hello)
+	//(,)
+	//( )
+	//(world)
+	//(!)
+}
+
+func ExampleStepBackSeq() {
+	for c, boundaries := range uniseg.StepBackSeq("Hi, 世界!") {
+		fmt.Printf("%q %t %d\n", c, boundaries&uniseg.MaskWord != 0, boundaries>>uniseg.ShiftWidth)
+	}
+	// Output: "!" true 1
+	//"界" true 2
+	//"世" true 2
+	//" " true 1
+	//"," true 1
+	//"i" true 1
+	//"H" false 1
+}
@@ -1,7 +1,10 @@
 package uniseg_test
 
 import (
+	"bufio"
 	"fmt"
+	"strings"
+	"unicode/utf16"
 
 	"github.com/rivo/uniseg"
 )
@@ -40,6 +43,19 @@ func ExampleFirstGraphemeClusterInString() {
 	//! 1
 }
 
+func ExampleLastGraphemeClusterInString() {
+	str := "🇩🇪🏳️‍🌈!"
+	var c string
+	for len(str) > 0 {
+		var width int
+		c, str, width = uniseg.LastGraphemeClusterInString(str)
+		fmt.Println(c, width)
+	}
+	// Output: ! 1
+	//🏳️‍🌈 2
+	//🇩🇪 2
+}
+
 func ExampleFirstWord() {
 	b := []byte("Hello, world!")
 	state := -1
@@ -163,6 +179,13 @@ func ExampleStepString_graphemes() {
 	//! 1
 }
 
+func ExampleStepBackString() {
+	str := "Hi, 世界!"
+	c, boundaries := uniseg.StepBackString(str, 7)
+	fmt.Printf("%q %t %d\n", c, boundaries&uniseg.MaskWord != 0, boundaries>>uniseg.ShiftWidth)
+	// Output: "世" true 2
+}
+
 func ExampleStep_word() {
 	b := []byte("Hello, world!")
 	state := -1
@@ -325,6 +348,215 @@ func ExampleGraphemes_lineBreaking() {
 	//Iterator has been reset. LineDontBreak
 }
 
+func ExampleWords() {
+	w := uniseg.NewWords("Hello, world!")
+	for w.Next() {
+		from, to := w.Positions()
+		fmt.Printf("(%s) %d-%d\n", w.Str(), from, to)
+	}
+	// Output: (Hello) 0-5
+	//(,) 5-6
+	//( ) 6-7
+	//(world) 7-12
+	//(!) 12-13
+}
+
+func ExampleSentences() {
+	s := uniseg.NewSentences("This is sentence 1.0. And this is sentence two.")
+	for s.Next() {
+		from, to := s.Positions()
+		fmt.Printf("(%s) %d-%d\n", s.Str(), from, to)
+	}
+	// Output: (This is sentence 1.0. ) 0-22
+	//(And this is sentence two.) 22-47
+}
+
+func ExampleLineSegments() {
+	l := uniseg.NewLineSegments("First line.\nSecond line.")
+	for l.Next() {
+		fmt.Print(l.Str())
+		if l.MustBreak() {
+			fmt.Print("‖")
+		} else {
+			fmt.Print("|")
+		}
+	}
+	// Output: First |line.
+	//‖Second |line.‖
+}
+
+func ExampleScanWords() {
+	scanner := bufio.NewScanner(strings.NewReader("Hello, world!"))
+	scanner.Split(uniseg.ScanWords)
+	for scanner.Scan() {
+		fmt.Printf("(%s)\n", scanner.Text())
+	}
+	// Output: (Hello)
+	//(,)
+	//( )
+	//(world)
+	//(!)
+}
+
+func ExampleSegmenter() {
+	s := uniseg.NewSegmenter(strings.NewReader("🇩🇪🏳️‍🌈!"))
+	for s.Next() {
+		fmt.Println(s.Str(), s.Width())
+	}
+	if err := s.Err(); err != nil {
+		fmt.Println(err)
+	}
+	// Output: 🇩🇪 2
+	//🏳️‍🌈 2
+	//! 1
+}
+
+func ExamplePreviousWordBoundaryInString() {
+	str := "Hello, world!"
+	click := 9 // Somewhere in "world".
+	from := uniseg.PreviousWordBoundaryInString(str, click+1)
+	to := uniseg.NextWordBoundaryInString(str, click)
+	fmt.Println(str[from:to])
+	// Output: world
+}
+
+func ExampleWrapString() {
+	lines := uniseg.WrapString("Hello, 世界! This is a test.", 10, nil)
+	for _, line := range lines {
+		fmt.Printf("[%s]\n", line)
+	}
+	// Output: [Hello, 世]
+	//[界! This]
+	//[is a test.]
+}
+
+func ExampleWrapStringOptimal() {
+	lines := uniseg.WrapStringOptimal("aaa bb cc ddddd", 6, nil)
+	for _, line := range lines {
+		fmt.Printf("[%s]\n", line)
+	}
+	// Output: [aaa]
+	//[bb cc]
+	//[ddddd]
+}
+
+func ExampleTruncateString() {
+	fmt.Println(uniseg.TruncateString("Hello, 世界!", 9, "…") + "|")
+	fmt.Println(uniseg.TruncateStringLeft("/home/user/🇩🇪/file.txt", 12, "…") + "|")
+	// Output: Hello,  …|
+	//…🇩🇪/file.txt|
+}
+
+func ExampleWidthConfig() {
+	config := uniseg.NewWidthConfig()
+	config.EastAsianAmbiguousWidth = 2
+	config.TabWidth = 8
+	fmt.Println(config.StringWidth("±1\t§"))
+	// Output: 10
+}
+
+func ExampleWidthConfig_escapeSequences() {
+	config := uniseg.NewWidthConfig()
+	config.EscapeSequences = true
+	str := "\x1b[31mHello, world!\x1b[0m"
+	fmt.Println(config.StringWidth(str))
+	fmt.Printf("%q\n", config.TruncateString(str, 8, "…"))
+	// Output: 13
+	//"\x1b[31mHello, \x1b[0m…"
+}
+
+func ExampleExpandTabs() {
+	fmt.Println(uniseg.ExpandTabs("世界\t!\nabc\t!", 4, 0))
+	// Output: 世界    !
+	//abc !
+}
+
+func ExampleFitWidth() {
+	for _, name := range []string{"Käse", "世界", "🇩🇪 Germany"} {
+		fmt.Printf("|%s|%s|\n", uniseg.FitWidth(name, 5), uniseg.PadLeft(name, 10))
+	}
+	// Output: |Käse |      Käse|
+	//|世界 |      世界|
+	//|🇩🇪 Ge|🇩🇪 Germany|
+}
+
+func ExampleRenderTable() {
+	rows := [][]string{
+		{"Country", "Capital", "Population"},
+		{"🇩🇪 Germany", "Berlin", "84,482,267"},
+		{"🇯🇵 Japan", "東京 (Tokyo)", "124,516,650"},
+	}
+	lines := uniseg.RenderTable(rows, &uniseg.TableOptions{
+		Columns:   []uniseg.TableColumn{{}, {}, {Align: uniseg.AlignRight}},
+		Width:     34,
+		Separator: " │ ",
+	})
+	for _, line := range lines {
+		fmt.Printf("[%s]\n", line)
+	}
+	// Output: [Country   │ Capital  │  Population]
+	//[🇩🇪        │ Berlin   │  84,482,267]
+	//[Germany   │          │            ]
+	//[🇯🇵 Japan  │ 東京     │ 124,516,650]
+	//[          │ (Tokyo)  │            ]
+}
+
+func ExampleTextIndex() {
+	index := uniseg.NewTextIndex("Hi 世界!")
+	for _, column := range []int{1, 3, 4, 6} {
+		cluster := index.GraphemeAtColumn(column)
+		str, _ := index.Grapheme(cluster)
+		fmt.Printf("Column %d: %q, cursor at %d\n", column, str, index.CursorAtColumn(column))
+	}
+	// Output: Column 1: "i", cursor at 1
+	//Column 3: "世", cursor at 3
+	//Column 4: "世", cursor at 4
+	//Column 6: "界", cursor at 5
+}
+
+func ExampleStepUTF16() {
+	u := utf16.Encode([]rune("🏳️‍🌈 café"))
+	state, offset := -1, 0
+	var c []uint16
+	for len(u) > 0 {
+		c, u, _, state = uniseg.StepUTF16(u, state)
+		fmt.Printf("%d: [%s]\n", offset, string(utf16.Decode(c)))
+		offset += len(c)
+	}
+	// Output: 0: [🏳️‍🌈]
+	// 6: [ ]
+	// 7: [c]
+	// 8: [a]
+	// 9: [f]
+	// 10: [é]
+}
+
+func ExampleFirstWordRunes() {
+	runes := []rune("Hello, world!")
+	state := -1
+	var w []rune
+	for len(runes) > 0 {
+		w, runes, state = uniseg.FirstWordRunes(runes, state)
+		fmt.Printf("(%s)\n", string(w))
+	}
+	// Output: (Hello)
+	//(,)
+	//( )
+	//(world)
+	//(!)
+}
+
+func ExampleLineBreakOf() {
+	for _, r := range "a1(世😀" {
+		fmt.Printf("%c %s %s %t\n", r, uniseg.LineBreakOf(r), uniseg.EastAsianWidthOf(r), uniseg.IsExtendedPictographic(r))
+	}
+	// Output: a AL Na false
+	//1 NU Na false
+	//( OP Na false
+	//世 ID W false
+	//😀 ID W true
+}
+
 func ExampleStringWidth() {
 	fmt.Println(uniseg.StringWidth("Hello, 世界"))
 	// Output: 11
@@ -8,11 +8,19 @@
 //   2. The name of the locally generated Go file.
 //   3. The name of the slice containing the test cases.
 //   4. The name of the generator, for logging purposes.
+//   5. (Optional) The Unicode version to use (e.g. "17.0.0"). Defaults to the
+//      version set in the defaultVersion constant.
+//   6. (Optional) A build constraint to add to the generated file (e.g.
+//      "uniseg_unicode15").
 //
-//go:generate go run gen_breaktest.go GraphemeBreakTest graphemebreak_test.go graphemeBreakTestCases graphemes
-//go:generate go run gen_breaktest.go WordBreakTest wordbreak_test.go wordBreakTestCases words
-//go:generate go run gen_breaktest.go SentenceBreakTest sentencebreak_test.go sentenceBreakTestCases sentences
-//go:generate go run gen_breaktest.go LineBreakTest linebreak_test.go lineBreakTestCases lines
+//go:generate go run gen_breaktest.go GraphemeBreakTest graphemebreak_test.go graphemeBreakTestCases graphemes 17.0.0 !uniseg_unicode15
+//go:generate go run gen_breaktest.go GraphemeBreakTest graphemebreak_unicode15_test.go graphemeBreakTestCases graphemes 15.0.0 uniseg_unicode15
+//go:generate go run gen_breaktest.go WordBreakTest wordbreak_test.go wordBreakTestCases words 17.0.0 !uniseg_unicode15
+//go:generate go run gen_breaktest.go WordBreakTest wordbreak_unicode15_test.go wordBreakTestCases words 15.0.0 uniseg_unicode15
+//go:generate go run gen_breaktest.go SentenceBreakTest sentencebreak_test.go sentenceBreakTestCases sentences 17.0.0 !uniseg_unicode15
+//go:generate go run gen_breaktest.go SentenceBreakTest sentencebreak_unicode15_test.go sentenceBreakTestCases sentences 15.0.0 uniseg_unicode15
+//go:generate go run gen_breaktest.go LineBreakTest linebreak_test.go lineBreakTestCases lines 17.0.0 !uniseg_unicode15
+//go:generate go run gen_breaktest.go LineBreakTest linebreak_unicode15_test.go lineBreakTestCases lines 15.0.0 uniseg_unicode15
 
 package main
 
@@ -32,7 +40,8 @@ import (
 // We want to test against a specific version rather than the latest. When the
 // package is upgraded to a new version, change these to generate new tests.
 const (
-	testCaseURL = `https://www.unicode.org/Public/15.0.0/ucd/auxiliary/%s.txt`
+	defaultVersion = `17.0.0`
+	testCaseURL    = `https://www.unicode.org/Public/%s/ucd/auxiliary/%s.txt`
 )
 
 func main() {
@@ -45,11 +54,20 @@ func main() {
 	log.SetFlags(0)
 
 	// Read text of testcases and parse into Go source code.
-	src, err := parse(fmt.Sprintf(testCaseURL, os.Args[1]))
+	version := defaultVersion
+	if len(os.Args) >= 6 {
+		version = os.Args[5]
+	}
+	src, err := parse(fmt.Sprintf(testCaseURL, version, os.Args[1]))
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	// Add the build constraint, if any.
+	if len(os.Args) >= 7 {
+		src = append([]byte("//go:build "+os.Args[6]+"\n\n"), src...)
+	}
+
 	// Format the Go code.
 	formatted, err := format.Source(src)
 	if err != nil {
@@ -12,13 +12,35 @@
 //     - "emojis=<property>": include the specified emoji properties (e.g.
 //     "Extended_Pictographic").
 //     - "gencat": include general category properties.
+//     - "derived=<property>": the data file lists multiple properties, with
+//     their values in a separate field (e.g. "InCB" in
+//     DerivedCoreProperties.txt). Only the specified property is included.
+//     - "version=<version>": the Unicode version to use (e.g. "17.0.0").
+//     Defaults to the version set in the defaultVersion constant.
+//     - "build=<constraint>": add a build constraint to the generated file
+//     (e.g. "uniseg_unicode15"). Used to keep the tables of older Unicode
+//     versions available, see the "uniseg_unicode15" build tag in doc.go.
+//     - "trie=<files>": instead of parsing a Unicode data file, read the
+//     property tables from the given Go files (separated by "+") which were
+//     generated before, and combine them into one three-stage lookup table
+//     (see codePointProperties in properties.go). The first argument must be
+//     "-".
 //
-//go:generate go run gen_properties.go auxiliary/GraphemeBreakProperty graphemeproperties.go graphemeCodePoints graphemes emojis=Extended_Pictographic
-//go:generate go run gen_properties.go auxiliary/WordBreakProperty wordproperties.go workBreakCodePoints words emojis=Extended_Pictographic
-//go:generate go run gen_properties.go auxiliary/SentenceBreakProperty sentenceproperties.go sentenceBreakCodePoints sentences
-//go:generate go run gen_properties.go LineBreak lineproperties.go lineBreakCodePoints lines gencat
-//go:generate go run gen_properties.go EastAsianWidth eastasianwidth.go eastAsianWidth eastasianwidth
-//go:generate go run gen_properties.go - emojipresentation.go emojiPresentation emojipresentation emojis=Emoji_Presentation
+//go:generate go run gen_properties.go auxiliary/GraphemeBreakProperty graphemeproperties.go graphemeCodePoints graphemes emojis=Extended_Pictographic,version=17.0.0,build=!uniseg_unicode15
+//go:generate go run gen_properties.go auxiliary/GraphemeBreakProperty graphemeproperties_unicode15.go graphemeCodePoints graphemes emojis=Extended_Pictographic,version=15.0.0,build=uniseg_unicode15
+//go:generate go run gen_properties.go DerivedCoreProperties incbproperties.go indicConjunctBreakCodePoints incb derived=InCB,version=17.0.0
+//go:generate go run gen_properties.go auxiliary/WordBreakProperty wordproperties.go workBreakCodePoints words version=17.0.0,build=!uniseg_unicode15
+//go:generate go run gen_properties.go auxiliary/WordBreakProperty wordproperties_unicode15.go workBreakCodePoints words emojis=Extended_Pictographic,version=15.0.0,build=uniseg_unicode15
+//go:generate go run gen_properties.go auxiliary/SentenceBreakProperty sentenceproperties.go sentenceBreakCodePoints sentences version=17.0.0,build=!uniseg_unicode15
+//go:generate go run gen_properties.go auxiliary/SentenceBreakProperty sentenceproperties_unicode15.go sentenceBreakCodePoints sentences version=15.0.0,build=uniseg_unicode15
+//go:generate go run gen_properties.go LineBreak lineproperties.go lineBreakCodePoints lines gencat,version=17.0.0,build=!uniseg_unicode15
+//go:generate go run gen_properties.go LineBreak lineproperties_unicode15.go lineBreakCodePoints lines gencat,version=15.0.0,build=uniseg_unicode15
+//go:generate go run gen_properties.go EastAsianWidth eastasianwidth.go eastAsianWidth eastasianwidth version=17.0.0,build=!uniseg_unicode15
+//go:generate go run gen_properties.go EastAsianWidth eastasianwidth_unicode15.go eastAsianWidth eastasianwidth version=15.0.0,build=uniseg_unicode15
+//go:generate go run gen_properties.go - emojipresentation.go emojiPresentation emojipresentation emojis=Emoji_Presentation,version=17.0.0,build=!uniseg_unicode15
+//go:generate go run gen_properties.go - emojipresentation_unicode15.go emojiPresentation emojipresentation emojis=Emoji_Presentation,version=15.0.0,build=uniseg_unicode15
+//go:generate go run gen_properties.go - propertytrie.go propertyTrie trie trie=graphemeproperties.go+wordproperties.go+sentenceproperties.go+lineproperties.go+eastasianwidth.go+emojipresentation.go+incbproperties.go,build=!uniseg_unicode15
+//go:generate go run gen_properties.go - propertytrie_unicode15.go propertyTrie trie trie=graphemeproperties_unicode15.go+wordproperties_unicode15.go+sentenceproperties_unicode15.go+lineproperties_unicode15.go+eastasianwidth_unicode15.go+emojipresentation_unicode15.go+incbproperties.go,build=uniseg_unicode15
 package main
 
 import (
@@ -26,7 +48,10 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"go/ast"
 	"go/format"
+	"go/parser"
+	"go/token"
 	"io/ioutil"
 	"log"
 	"net/http"
@@ -41,13 +66,19 @@ import (
 // We want to test against a specific version rather than the latest. When the
 // package is upgraded to a new version, change these to generate new tests.
 const (
-	propertyURL = `https://www.unicode.org/Public/15.0.0/ucd/%s.txt`
-	emojiURL    = `https://unicode.org/Public/15.0.0/ucd/emoji/emoji-data.txt`
+	defaultVersion = `17.0.0`
+	propertyURL    = `https://www.unicode.org/Public/%s/ucd/%s.txt`
+	emojiURL       = `https://unicode.org/Public/%s/ucd/emoji/emoji-data.txt`
 )
 
 // The regular expression for a line containing a code point range property.
 var propertyPattern = regexp.MustCompile(`^([0-9A-F]{4,6})(\.\.([0-9A-F]{4,6}))?\s*;\s*([A-Za-z0-9_]+)\s*#\s(.+)$`)
 
+// The regular expression for a line containing a code point range property
+// whose value is given in a separate field, as used in files listing multiple
+// properties.
+var derivedPropertyPattern = regexp.MustCompile(`^([0-9A-F]{4,6})(\.\.([0-9A-F]{4,6}))?\s*;\s*([A-Za-z0-9_]+)\s*;\s*([A-Za-z0-9_]+)\s*#\s(.+)$`)
+
 func main() {
 	if len(os.Args) < 5 {
 		fmt.Println("Not enough arguments, see code for details")
@@ -72,15 +103,32 @@ func main() {
 
 	// Parse the text file and generate Go source code from it.
 	_, includeGeneralCategory := flags["gencat"]
+	version := flags["version"]
+	if version == "" {
+		version = defaultVersion
+	}
 	var mainURL string
 	if os.Args[1] != "-" {
-		mainURL = fmt.Sprintf(propertyURL, os.Args[1])
+		mainURL = fmt.Sprintf(propertyURL, version, os.Args[1])
+	}
+	var (
+		src string
+		err error
+	)
+	if files := flags["trie"]; files != "" {
+		src, err = parseTrie(strings.Split(files, "+"))
+	} else {
+		src, err = parse(mainURL, fmt.Sprintf(emojiURL, version), flags["emojis"], flags["derived"], includeGeneralCategory)
 	}
-	src, err := parse(mainURL, flags["emojis"], includeGeneralCategory)
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	// Add the build constraint, if any.
+	if constraint := flags["build"]; constraint != "" {
+		src = "//go:build " + constraint + "\n\n" + src
+	}
+
 	// Format the Go code.
 	formatted, err := format.Source([]byte(src))
 	if err != nil {
@@ -99,9 +147,13 @@ func main() {
 // "emojiProperty" is not an empty string, emoji code points for that emoji
 // property (e.g. "Extended_Pictographic") will be included. In those cases, you
 // may pass an empty "propertyURL" to skip parsing the main properties file. If
-// "includeGeneralCategory" is true, the Unicode General Category property will
-// be extracted from the comments and included in the output.
-func parse(propertyURL, emojiProperty string, includeGeneralCategory bool) (string, error) {
+// "derivedProperty" is not an empty string, only that property is extracted
+// from the main properties file, whose lines then contain the property name
+// and its value in separate fields. The resulting property names are the
+// concatenation of both (e.g. "InCBLinker"). If "includeGeneralCategory" is
+// true, the Unicode General Category property will be extracted from the
+// comments and included in the output.
+func parse(propertyURL, emojiURL, emojiProperty, derivedProperty string, includeGeneralCategory bool) (string, error) {
 	if propertyURL == "" && emojiProperty == "" {
 		return "", errors.New("no properties to parse")
 	}
@@ -131,6 +183,19 @@ func parse(propertyURL, emojiProperty string, includeGeneralCategory bool) (stri
 				continue
 			}
 
+			// Derived properties files contain multiple properties. Extract
+			// only the requested one.
+			if derivedProperty != "" {
+				from, to, property, comment, err := parseDerivedProperty(line)
+				if err != nil {
+					return "", fmt.Errorf("%s line %d: %v", os.Args[4], num, err)
+				}
+				if strings.HasPrefix(property, derivedProperty+";") {
+					properties = append(properties, [4]string{from, to, strings.Replace(property, ";", "", 1), comment})
+				}
+				continue
+			}
+
 			// Everything else must be a code point range, a property and a comment.
 			from, to, property, comment, err := parseProperty(line)
 			if err != nil {
@@ -199,11 +264,14 @@ func parse(propertyURL, emojiProperty string, includeGeneralCategory bool) (stri
 	if includeGeneralCategory {
 		columns = 4
 	}
-	if emojiURL != "" {
+	if emojiProperty != "" {
 		emojiComment = `
 // and
 // ` + emojiURL + `
-// ("Extended_Pictographic" only)`
+// ("` + emojiProperty + `" only)`
+	} else if derivedProperty != "" {
+		emojiComment = `
+// ("` + derivedProperty + `" only)`
 	}
 	buf.WriteString(`// Code generated via go generate from gen_properties.go. DO NOT EDIT.
 
@@ -236,6 +304,271 @@ var ` + os.Args[3] + ` = [][` + strconv.Itoa(columns) + `]int{
 	return buf.String(), nil
 }
 
+// trieFields maps the names of the property tables which are combined into
+// the three-stage lookup table to the index of their field in the
+// codePointProperties struct (see properties.go). The line break table also
+// provides the General Category, stored in field trieGeneralCategory.
+var trieFields = map[string]int{
+	"graphemeCodePoints":           0,
+	"workBreakCodePoints":          1,
+	"sentenceBreakCodePoints":      2,
+	"lineBreakCodePoints":          3,
+	"eastAsianWidth":               5,
+	"emojiPresentation":            6,
+	"indicConjunctBreakCodePoints": 7,
+}
+
+const (
+	trieGeneralCategory = 4
+	trieNumFields       = 8
+	trieNumCodePoints   = 0x110000
+)
+
+// parseTrie reads the property tables from the given Go files, as generated
+// by this program, and returns Go source code for a three-stage lookup table
+// which combines all of them. The first stage maps the upper bits of a code
+// point to a block, the second stage maps the lower bits to an index into the
+// third stage, which contains all distinct combinations of properties. The
+// block size is chosen such that the tables are as small as possible.
+func parseTrie(files []string) (string, error) {
+	// Identifiers are mapped to IDs, 0 meaning "not set".
+	var (
+		names = []string{""}
+		ids   = make(map[string]uint16)
+	)
+	id := func(name string) uint16 {
+		if i, ok := ids[name]; ok {
+			return i
+		}
+		ids[name] = uint16(len(names))
+		names = append(names, name)
+		return ids[name]
+	}
+
+	// Read the tables.
+	type trieEntry struct {
+		from, to                  int
+		property, generalCategory uint16
+	}
+	tables := make(map[string][]trieEntry)
+	fileSet := token.NewFileSet()
+	for _, file := range files {
+		log.Printf("Parsing %s", file)
+		f, err := parser.ParseFile(fileSet, file, nil, 0)
+		if err != nil {
+			return "", err
+		}
+		for _, decl := range f.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.VAR {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				valueSpec := spec.(*ast.ValueSpec)
+				name := valueSpec.Names[0].Name
+				if _, ok := trieFields[name]; !ok || len(valueSpec.Values) != 1 {
+					continue
+				}
+				table, ok := valueSpec.Values[0].(*ast.CompositeLit)
+				if !ok {
+					return "", fmt.Errorf("%s: unexpected value of %s", file, name)
+				}
+				entries := make([]trieEntry, 0, len(table.Elts))
+				for _, element := range table.Elts {
+					from, to, property, generalCategory, err := parseTrieEntry(element)
+					if err != nil {
+						return "", fmt.Errorf("%s: %s: %v", file, name, err)
+					}
+					entry := trieEntry{from: from, to: to, property: id(property)}
+					if generalCategory != "" {
+						entry.generalCategory = id(generalCategory)
+					}
+					entries = append(entries, entry)
+				}
+				tables[name] = entries
+			}
+		}
+	}
+	for name := range trieFields {
+		if _, ok := tables[name]; !ok {
+			return "", fmt.Errorf("table %s not found", name)
+		}
+	}
+
+	// Look up the properties of all code points. Some tables contain
+	// overlapping entries (e.g. code points which are both ALetter and
+	// Extended_Pictographic), so we use the same binary search as
+	// propertySearch() in properties_test.go to get the same results.
+	codePoints := make([][trieNumFields]uint16, trieNumCodePoints)
+	for name, entries := range tables {
+		field := trieFields[name]
+		for r := range codePoints {
+			from, to := 0, len(entries)
+			for to > from {
+				middle := (from + to) / 2
+				entry := entries[middle]
+				if r < entry.from {
+					to = middle
+					continue
+				}
+				if r > entry.to {
+					from = middle + 1
+					continue
+				}
+				codePoints[r][field] = entry.property
+				if entry.generalCategory != 0 {
+					codePoints[r][trieGeneralCategory] = entry.generalCategory
+				}
+				break
+			}
+		}
+	}
+
+	// Find all distinct combinations of properties. Code points which are not
+	// listed in a table have the zero property, i.e. prXX or gcNone.
+	var (
+		values       [][trieNumFields]uint16
+		valueIndices = make(map[[trieNumFields]uint16]uint16)
+		indices      = make([]uint16, trieNumCodePoints)
+	)
+	for r, properties := range codePoints {
+		index, ok := valueIndices[properties]
+		if !ok {
+			if len(values) >= 1<<16 {
+				return "", errors.New("too many combinations of properties")
+			}
+			index = uint16(len(values))
+			valueIndices[properties] = index
+			values = append(values, properties)
+		}
+		indices[r] = index
+	}
+
+	// Choose the block size with the smallest tables.
+	var (
+		bestShift              int
+		bestStage1, bestStage2 []uint16
+	)
+	for shift := 4; shift <= 9; shift++ {
+		var (
+			stage1, stage2 []uint16
+			blocks         = make(map[string]uint16)
+			size           = 1 << shift
+		)
+		for start := 0; start < trieNumCodePoints; start += size {
+			block := indices[start : start+size]
+			key := fmt.Sprint(block)
+			number, ok := blocks[key]
+			if !ok {
+				number = uint16(len(blocks))
+				blocks[key] = number
+				stage2 = append(stage2, block...)
+			}
+			stage1 = append(stage1, number)
+		}
+		if bestStage1 == nil || len(stage1)+len(stage2) < len(bestStage1)+len(bestStage2) {
+			bestShift, bestStage1, bestStage2 = shift, stage1, stage2
+		}
+	}
+	log.Printf("Block size %d, %d blocks, %d combinations of properties", 1<<bestShift, len(bestStage2)>>bestShift, len(values))
+
+	// Header.
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `// Code generated via go generate from gen_properties.go. DO NOT EDIT.
+
+package uniseg
+
+// %[1]sShift is the number of lower bits of a code point which are
+// used as an index into a block of %[1]sBlocks.
+const %[1]sShift = %[2]d
+
+// %[1]sIndex, %[1]sBlocks, and %[1]sValues form a
+// three-stage lookup table for the properties of all code points, see
+// [codePointProperties]. They were derived on %[3]s from the
+// property tables in the following files:
+//
+`, os.Args[3], bestShift, time.Now().Format("January 2, 2006"))
+	for _, file := range files {
+		fmt.Fprintf(&buf, "//   - %s\n", file)
+	}
+
+	// Tables.
+	writeUint16s := func(name string, list []uint16) {
+		fmt.Fprintf(&buf, "var %s = [%d]uint16{", name, len(list))
+		for i, n := range list {
+			if i%16 == 0 {
+				buf.WriteString("\n")
+			}
+			fmt.Fprintf(&buf, "%d,", n)
+		}
+		buf.WriteString("\n}\n\n")
+	}
+	writeUint16s(os.Args[3]+"Index", bestStage1)
+	writeUint16s(os.Args[3]+"Blocks", bestStage2)
+	fmt.Fprintf(&buf, "var %sValues = [%d]codePointProperties{\n", os.Args[3], len(values))
+	for _, properties := range values {
+		buf.WriteString("{")
+		for field, i := range properties {
+			name := names[i]
+			if name == "" {
+				name = "prXX"
+				if field == trieGeneralCategory {
+					name = "gcNone"
+				}
+			}
+			fmt.Fprintf(&buf, "%s,", name)
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}")
+
+	return buf.String(), nil
+}
+
+// parseTrieEntry parses an entry of a property table as generated by this
+// program, e.g. {0x0041, 0x005A, prAL, gcLu}. The General Category is empty if
+// the entry doesn't contain one.
+func parseTrieEntry(element ast.Expr) (from, to int, property, generalCategory string, err error) {
+	entry, ok := element.(*ast.CompositeLit)
+	if !ok || len(entry.Elts) < 3 || len(entry.Elts) > 4 {
+		err = errors.New("unexpected table entry")
+		return
+	}
+	var codePoints [2]int
+	for i := range codePoints {
+		literal, ok := entry.Elts[i].(*ast.BasicLit)
+		if !ok {
+			err = errors.New("unexpected code point")
+			return
+		}
+		var n int64
+		n, err = strconv.ParseInt(literal.Value, 0, 32)
+		if err != nil {
+			return
+		}
+		if n < 0 || n >= trieNumCodePoints {
+			err = fmt.Errorf("invalid code point %X", n)
+			return
+		}
+		codePoints[i] = int(n)
+	}
+	from, to = codePoints[0], codePoints[1]
+	identifiers := make([]string, len(entry.Elts)-2)
+	for i := range identifiers {
+		identifier, ok := entry.Elts[i+2].(*ast.Ident)
+		if !ok {
+			err = errors.New("unexpected property")
+			return
+		}
+		identifiers[i] = identifier.Name
+	}
+	property = identifiers[0]
+	if len(identifiers) > 1 {
+		generalCategory = identifiers[1]
+	}
+	return
+}
+
 // parseProperty parses a line of the Unicode properties text file containing a
 // property for a code point range and returns it along with its comment.
 func parseProperty(line string) (from, to, property, comment string, err error) {
@@ -254,6 +587,30 @@ func parseProperty(line string) (from, to, property, comment string, err error)
 	return
 }
 
+// parseDerivedProperty parses a line of a Unicode properties text file which
+// lists multiple properties, e.g. DerivedCoreProperties.txt. Lines containing
+// only a property name (for binary properties) are skipped by returning an
+// empty property. Otherwise, the property is returned as the property name and
+// its value, separated by a semicolon.
+func parseDerivedProperty(line string) (from, to, property, comment string, err error) {
+	fields := derivedPropertyPattern.FindStringSubmatch(line)
+	if fields == nil {
+		if propertyPattern.MatchString(line) {
+			return // Binary property.
+		}
+		err = errors.New("no property found")
+		return
+	}
+	from = fields[1]
+	to = fields[3]
+	if to == "" {
+		to = from
+	}
+	property = fields[4] + ";" + fields[5]
+	comment = fields[6]
+	return
+}
+
 // translateProperty translates a property name as used in the Unicode data file
 // to a variable used in the Go code.
 func translateProperty(prefix, property string) string {
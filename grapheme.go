@@ -1,9 +1,6 @@
 package uniseg
 
-import (
-	"fmt"
-	"unicode/utf8"
-)
+import "fmt"
 
 // Graphemes implements an iterator over Unicode grapheme clusters, or
 // user-perceived characters. While iterating, it also provides information
@@ -39,6 +36,9 @@ type Graphemes struct {
 
 	// The current state of the [Step] parser.
 	state int
+
+	// The width configuration, nil for the package defaults.
+	config *WidthConfig
 }
 
 // NewGraphemes returns a new grapheme cluster iterator.
@@ -72,7 +72,7 @@ func (g *Graphemes) Next() bool {
 		return false
 	}
 	g.offset += len(g.cluster)
-	g.cluster, g.remaining, g.boundaries, g.state = StepString(g.remaining, g.state)
+	g.cluster, g.remaining, g.boundaries, g.state = step(g.remaining, g.state, g.config)
 	return true
 }
 
@@ -227,119 +227,153 @@ const shiftGraphemePropState = 4
 //
 // [Unicode Standard Annex #29, Grapheme Cluster Boundaries]: http://unicode.org/reports/tr29/#Grapheme_Cluster_Boundaries
 func FirstGraphemeCluster(b []byte, state int) (cluster, rest []byte, width, newState int) {
-	// An empty byte slice returns nothing.
-	if len(b) == 0 {
+	return firstGraphemeCluster(b, state, nil)
+}
+
+// FirstGraphemeClusterInString is like [FirstGraphemeCluster] but its input and
+// outputs are strings.
+func FirstGraphemeClusterInString(str string, state int) (cluster, rest string, width, newState int) {
+	return firstGraphemeCluster(str, state, nil)
+}
+
+// firstGraphemeCluster implements [FirstGraphemeCluster] for all supported
+// text representations, using the given width configuration. If config is nil,
+// the package defaults apply.
+func firstGraphemeCluster[T text](t T, state int, config *WidthConfig) (cluster, rest T, width, newState int) {
+	// An empty text returns nothing.
+	if len(t) == 0 {
 		return
 	}
 
+	// Escape sequences are zero-width clusters of their own. They don't change
+	// the state.
+	if config != nil && config.EscapeSequences {
+		if length := escapeSequenceLength(t); length > 0 {
+			cluster, rest = splitText(t, length)
+			return cluster, rest, 0, state
+		}
+	}
+
 	// Extract the first rune.
-	r, length := utf8.DecodeRune(b)
-	if len(b) <= length { // If we're already past the end, there is nothing else to parse.
+	first, remainder := decodeText(t)
+	if len(remainder) == 0 { // If we're already past the end, there is nothing else to parse.
 		var prop int
 		if state < 0 {
-			prop = propertyGraphemes(r)
+			prop = propertyGraphemes(first)
 		} else {
 			prop = state >> shiftGraphemePropState
 		}
-		return b, nil, runeWidth(r, prop), grAny | (prop << shiftGraphemePropState)
+		return t, rest, runeWidth(first, prop, config), grAny | (prop << shiftGraphemePropState)
 	}
 
 	// If we don't know the state, determine it now.
 	var firstProp int
 	if state < 0 {
-		state, firstProp, _ = transitionGraphemeState(state, r)
+		state, firstProp, _ = transitionGraphemeState(state, first)
 	} else {
 		firstProp = state >> shiftGraphemePropState
 	}
-	width += runeWidth(r, firstProp)
+	width = runeWidth(first, firstProp, config)
 
-	// Transition until we find a boundary.
+	// Transition until we find a boundary. Escape sequences are skipped. They
+	// belong to the cluster if it continues after them.
+	prop, previous := firstProp, first
 	for {
-		var (
-			prop     int
-			boundary bool
-		)
+		var boundary bool
 
-		r, l := utf8.DecodeRune(b[length:])
+		skipped := skipEscapeSequences(remainder, config)
+		if len(skipped) == 0 {
+			cluster, rest = splitText(t, len(t)-len(remainder))
+			return cluster, rest, width, grAny | (prop << shiftGraphemePropState)
+		}
+		r, next := decodeText(skipped)
 		state, prop, boundary = transitionGraphemeState(state&maskGraphemeState, r)
 
 		if boundary {
-			return b[:length], b[length:], width, state | (prop << shiftGraphemePropState)
+			cluster, rest = splitText(t, len(t)-len(remainder))
+			return cluster, rest, width, state | (prop << shiftGraphemePropState)
 		}
 
-		if firstProp == prExtendedPictographic {
-			if r == vs15 {
-				width = 1
-			} else if r == vs16 {
-				width = 2
-			}
-		} else if firstProp != prRegionalIndicator && firstProp != prL {
-			width += runeWidth(r, prop)
-		}
+		width = clusterWidth(width, first, firstProp, previous, r, prop, config)
+		previous = r
 
-		length += l
-		if len(b) <= length {
-			return b, nil, width, grAny | (prop << shiftGraphemePropState)
+		remainder = next
+		if len(remainder) == 0 {
+			return t, rest, width, grAny | (prop << shiftGraphemePropState)
 		}
 	}
 }
 
-// FirstGraphemeClusterInString is like [FirstGraphemeCluster] but its input and
-// outputs are strings.
-func FirstGraphemeClusterInString(str string, state int) (cluster, rest string, width, newState int) {
-	// An empty string returns nothing.
-	if len(str) == 0 {
+// LastGraphemeCluster returns the last grapheme cluster found in the given byte
+// slice according to the rules of [Unicode Standard Annex #29, Grapheme Cluster
+// Boundaries]. This is useful, for example, to implement cursor movement to the
+// left or the deletion of the character before the cursor (backspace). This
+// function can be called continuously to extract all grapheme clusters from a
+// byte slice in reverse order, as illustrated in the example below.
+//
+// The "rest" slice is the sub-slice of the original byte slice "b" ending before
+// the first byte of the identified grapheme cluster. If the length of the
+// "rest" slice is 0, the entire byte slice "b" has been processed. The
+// "cluster" byte slice is the sub-slice of the input slice containing the
+// identified grapheme cluster. The returned width is the same as the one
+// returned by [FirstGraphemeCluster].
+//
+// Given an empty byte slice "b", the function returns nil values.
+//
+// The function only looks back as far as needed to determine the cluster
+// unambiguously, e.g. to find out if a regional indicator is the first or the
+// second one of a flag. It therefore does not need to process the entire byte
+// slice.
+//
+// [Unicode Standard Annex #29, Grapheme Cluster Boundaries]: http://unicode.org/reports/tr29/#Grapheme_Cluster_Boundaries
+func LastGraphemeCluster(b []byte) (cluster, rest []byte, width int) {
+	// An empty byte slice returns nothing.
+	if len(b) == 0 {
 		return
 	}
 
-	// Extract the first rune.
-	r, length := utf8.DecodeRuneInString(str)
-	if len(str) <= length { // If we're already past the end, there is nothing else to parse.
-		var prop int
-		if state < 0 {
-			prop = propertyGraphemes(r)
-		} else {
-			prop = state >> shiftGraphemePropState
-		}
-		return str, "", runeWidth(r, prop), grAny | (prop << shiftGraphemePropState)
+	// Parse forward from a safe starting point.
+	remainder, state := b[lastGraphemeSafePoint(b):], -1
+	for len(remainder) > 0 {
+		cluster, remainder, width, state = FirstGraphemeCluster(remainder, state)
 	}
+	return cluster, b[:len(b)-len(cluster)], width
+}
 
-	// If we don't know the state, determine it now.
-	var firstProp int
-	if state < 0 {
-		state, firstProp, _ = transitionGraphemeState(state, r)
-	} else {
-		firstProp = state >> shiftGraphemePropState
+// LastGraphemeClusterInString is like [LastGraphemeCluster] but its input and
+// outputs are strings.
+func LastGraphemeClusterInString(str string) (cluster, rest string, width int) {
+	// An empty string returns nothing.
+	if len(str) == 0 {
+		return
 	}
-	width += runeWidth(r, firstProp)
-
-	// Transition until we find a boundary.
-	for {
-		var (
-			prop     int
-			boundary bool
-		)
-
-		r, l := utf8.DecodeRuneInString(str[length:])
-		state, prop, boundary = transitionGraphemeState(state&maskGraphemeState, r)
-
-		if boundary {
-			return str[:length], str[length:], width, state | (prop << shiftGraphemePropState)
-		}
 
-		if firstProp == prExtendedPictographic {
-			if r == vs15 {
-				width = 1
-			} else if r == vs16 {
-				width = 2
-			}
-		} else if firstProp != prRegionalIndicator && firstProp != prL {
-			width += runeWidth(r, prop)
-		}
+	// Parse forward from a safe starting point.
+	remainder, state := str[lastGraphemeSafePoint(str):], -1
+	for len(remainder) > 0 {
+		cluster, remainder, width, state = FirstGraphemeClusterInString(remainder, state)
+	}
+	return cluster, str[:len(str)-len(cluster)], width
+}
 
-		length += l
-		if len(str) <= length {
-			return str, "", width, grAny | (prop << shiftGraphemePropState)
+// lastGraphemeSafePoint returns the position of the last grapheme cluster
+// boundary in the given text which does not depend on any code points before
+// it. Parsing may therefore start at this position with an initial state of
+// -1. The function returns 0 if no such boundary was found.
+func lastGraphemeSafePoint[T text](t T) int {
+	next, rest := decodeLastText(t)
+	nextProp := propertyGraphemes(next)
+	for len(rest) > 0 {
+		pos := len(rest)
+		var r rune
+		r, rest = decodeLastText(rest)
+		state, prop, _ := transitionGraphemeState(-1, r)
+		_, _, boundary := transitionGraphemeState(state, next)
+		if boundary && (prop != prZWJ || nextProp != prExtendedPictographic) && // GB11 depends on earlier code points.
+			(prop != prExtend && prop != prZWJ || propertiesOf(next).indicConjunctBreak != prInCBConsonant) { // So does GB9c.
+			return pos
 		}
+		next, nextProp = r, prop
 	}
+	return 0
 }
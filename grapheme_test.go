@@ -111,7 +111,7 @@ func TestGraphemesClass(t *testing.T) {
 // class.
 func TestGraphemesClassWord(t *testing.T) {
 	for testNum, testCase := range wordBreakTestCases {
-		if testNum == 1700 {
+		if testCase.original == "\u0061\U0001F1E6\u200D\U0001F1E7\U0001F1E8\u0062" {
 			// This test case reveals an inconsistency in the Unicode rule set,
 			// namely the handling of ZWJ within two RI graphemes. (Grapheme
 			// rules will restart the RI count, word rules will ignore the ZWJ.)
@@ -545,3 +545,62 @@ func BenchmarkGraphemesFunctionString(b *testing.B) {
 		}
 	}
 }
+
+// Test all grapheme cluster test cases by iterating backwards.
+func TestLastGraphemeCluster(t *testing.T) {
+	allCases := append(testCases, graphemeBreakTestCases...)
+	for testNum, testCase := range allCases {
+		b := []byte(testCase.original)
+		str := testCase.original
+		index := len(testCase.expected)
+		for len(b) > 0 || len(str) > 0 {
+			index--
+			if index < 0 {
+				t.Errorf(`Test case %d %q failed: More grapheme clusters returned than expected %d`,
+					testNum,
+					testCase.original,
+					len(testCase.expected))
+				break
+			}
+			var (
+				cluster         []byte
+				clusterStr      string
+				width, widthStr int
+			)
+			cluster, b, width = LastGraphemeCluster(b)
+			clusterStr, str, widthStr = LastGraphemeClusterInString(str)
+			expected := string(testCase.expected[index])
+			if string(cluster) != expected || clusterStr != expected {
+				t.Errorf(`Test case %d %q failed: Grapheme cluster at index %d is %x (bytes) and %x (string), expected %x`,
+					testNum,
+					testCase.original,
+					index,
+					[]rune(string(cluster)),
+					[]rune(clusterStr),
+					testCase.expected[index])
+				break
+			}
+			_, _, expectedWidth, _ := FirstGraphemeClusterInString(expected, -1)
+			if width != expectedWidth || widthStr != expectedWidth {
+				t.Errorf(`Test case %d %q failed: Grapheme cluster at index %d has width %d (bytes) and %d (string), expected %d`,
+					testNum,
+					testCase.original,
+					index,
+					width,
+					widthStr,
+					expectedWidth)
+			}
+		}
+		if index > 0 {
+			t.Errorf(`Test case %d %q failed: Fewer grapheme clusters returned (%d) than expected (%d)`,
+				testNum,
+				testCase.original,
+				len(testCase.expected)-index,
+				len(testCase.expected))
+		}
+	}
+	cluster, rest, width := LastGraphemeCluster([]byte{})
+	if len(cluster) > 0 || len(rest) > 0 || width != 0 {
+		t.Errorf(`Expected empty results, got %q, %q, %d`, cluster, rest, width)
+	}
+}
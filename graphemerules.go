@@ -13,6 +13,8 @@ const (
 	grExtendedPictographicZWJ
 	grRIOdd
 	grRIEven
+	grInCBConsonant
+	grInCBLinker
 )
 
 // The grapheme cluster parser's breaking instructions.
@@ -37,7 +39,9 @@ const (
 //     are equal. Stop.
 //  6. Assume grAny and grBoundary.
 //
-// Unicode version 15.0.0.
+// Unicode version 17.0.0. Rule GB9c is implemented in [transitionGraphemeState]
+// as it depends on the Indic_Conjunct_Break property. It is skipped if
+// [unicode15] is true.
 func grTransitions(state, prop int) (newState int, newProp int, boundary int) {
 	// It turns out that using a big switch statement is much faster than using
 	// a map.
@@ -137,11 +141,49 @@ func transitionGraphemeState(state int, r rune) (newState, prop int, boundary bo
 	// Determine the property of the next character.
 	prop = propertyGraphemes(r)
 
+	// Apply the grapheme cluster rules. The states of Indic conjuncts are
+	// treated like grAny here.
+	conjunctState := state
+	if state == grInCBConsonant || state == grInCBLinker {
+		state = grAny
+	}
+	newState, boundary = transitionGraphemeProperty(state, prop)
+
+	// GB9c: Do not break within Indic conjuncts, i.e. between a Consonant
+	// followed by a Linker (with any number of Extend or Linker characters in
+	// between) and another Consonant. Consonants have no grapheme property.
+	inConjunct := conjunctState == grInCBConsonant || conjunctState == grInCBLinker
+	if unicode15 || newState != grAny || r < 0x80 || prop != prXX && !inConjunct {
+		return // Indic_Conjunct_Break is None or irrelevant.
+	}
+	switch propertiesOf(r).indicConjunctBreak {
+	case prInCBConsonant:
+		if conjunctState == grInCBLinker {
+			boundary = false
+		}
+		newState = grInCBConsonant
+	case prInCBLinker:
+		if inConjunct {
+			newState = grInCBLinker
+		}
+	case prInCBExtend:
+		if inConjunct {
+			newState = conjunctState
+		}
+	}
+	return
+}
+
+// transitionGraphemeProperty determines the new state of the grapheme cluster
+// parser given the current state and the grapheme property of the next code
+// point. It also returns whether a cluster boundary was detected. Rule GB9c is
+// not considered here.
+func transitionGraphemeProperty(state, prop int) (newState int, boundary bool) {
 	// Find the applicable transition.
 	nextState, nextProp, _ := grTransitions(state, prop)
 	if nextState >= 0 {
 		// We have a specific transition. We'll use it.
-		return nextState, prop, nextProp == grBoundary
+		return nextState, nextProp == grBoundary
 	}
 
 	// No specific transition found. Try the less specific ones.
@@ -159,7 +201,7 @@ func transitionGraphemeState(state int, r rune) (newState, prop int, boundary bo
 
 	if anyPropState >= 0 {
 		// We only have a specific state.
-		return anyPropState, prop, anyPropProp == grBoundary
+		return anyPropState, anyPropProp == grBoundary
 		// This branch will probably never be reached because okAnyState will
 		// always be true given the current transition map. But we keep it here
 		// for future modifications to the transition map where this may not be
@@ -168,9 +210,9 @@ func transitionGraphemeState(state int, r rune) (newState, prop int, boundary bo
 
 	if anyStateState >= 0 {
 		// We only have a specific property.
-		return anyStateState, prop, anyStateProp == grBoundary
+		return anyStateState, anyStateProp == grBoundary
 	}
 
 	// No known transition. GB999: Any ÷ Any.
-	return grAny, prop, true
+	return grAny, true
 }
@@ -0,0 +1,15 @@
+//go:build !uniseg_unicode15
+
+package uniseg
+
+// Indic conjuncts are only kept together since Unicode 15.1.0 (rule GB9c).
+func init() {
+	testCases = append(testCases, []testCase{
+		{original: "\u0915\u094d\u0937", expected: [][]rune{{0x915, 0x94d, 0x937}}},
+		{original: "\u0915\u094d\u0937\u093f", expected: [][]rune{{0x915, 0x94d, 0x937, 0x93f}}},
+		{original: "\u0915\u094d\u200d\u0937", expected: [][]rune{{0x915, 0x94d, 0x200d, 0x937}}},
+		{original: "\u0915\u094d\u200c\u0937", expected: [][]rune{{0x915, 0x94d, 0x200c}, {0x937}}},
+		{original: "a\u094d\u0937", expected: [][]rune{{0x61, 0x94d}, {0x937}}},
+		{original: "\u0938\u094d\u0924\u094d\u0930\u0940", expected: [][]rune{{0x938, 0x94d, 0x924, 0x94d, 0x930, 0x940}}},
+	}...)
+}
@@ -0,0 +1,203 @@
+//go:build go1.23
+
+package uniseg
+
+import "iter"
+
+// GraphemesSeq returns an iterator over the grapheme clusters of the given
+// string, to be used in a "for ... range" loop. It is based on
+// [FirstGraphemeClusterInString].
+func GraphemesSeq(str string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		var cluster string
+		state := -1
+		for len(str) > 0 {
+			cluster, str, _, state = FirstGraphemeClusterInString(str, state)
+			if !yield(cluster) {
+				return
+			}
+		}
+	}
+}
+
+// GraphemesSeqBytes is like [GraphemesSeq] but its input and outputs are byte
+// slices. It is based on [FirstGraphemeCluster].
+func GraphemesSeqBytes(b []byte) iter.Seq[[]byte] {
+	return func(yield func([]byte) bool) {
+		var cluster []byte
+		state := -1
+		for len(b) > 0 {
+			cluster, b, _, state = FirstGraphemeCluster(b, state)
+			if !yield(cluster) {
+				return
+			}
+		}
+	}
+}
+
+// WordsSeq returns an iterator over the words of the given string, to be used
+// in a "for ... range" loop. It is based on [FirstWordInString].
+func WordsSeq(str string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		var word string
+		state := -1
+		for len(str) > 0 {
+			word, str, state = FirstWordInString(str, state)
+			if !yield(word) {
+				return
+			}
+		}
+	}
+}
+
+// WordsSeqBytes is like [WordsSeq] but its input and outputs are byte slices.
+// It is based on [FirstWord].
+func WordsSeqBytes(b []byte) iter.Seq[[]byte] {
+	return func(yield func([]byte) bool) {
+		var word []byte
+		state := -1
+		for len(b) > 0 {
+			word, b, state = FirstWord(b, state)
+			if !yield(word) {
+				return
+			}
+		}
+	}
+}
+
+// SentencesSeq returns an iterator over the sentences of the given string, to
+// be used in a "for ... range" loop. It is based on [FirstSentenceInString].
+func SentencesSeq(str string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		var sentence string
+		state := -1
+		for len(str) > 0 {
+			sentence, str, state = FirstSentenceInString(str, state)
+			if !yield(sentence) {
+				return
+			}
+		}
+	}
+}
+
+// SentencesSeqBytes is like [SentencesSeq] but its input and outputs are byte
+// slices. It is based on [FirstSentence].
+func SentencesSeqBytes(b []byte) iter.Seq[[]byte] {
+	return func(yield func([]byte) bool) {
+		var sentence []byte
+		state := -1
+		for len(b) > 0 {
+			sentence, b, state = FirstSentence(b, state)
+			if !yield(sentence) {
+				return
+			}
+		}
+	}
+}
+
+// LineSegmentsSeq returns an iterator over the line segments of the given
+// string, to be used in a "for ... range" loop. Each segment is accompanied by
+// the "mustBreak" flag which indicates whether the line must be broken after
+// the segment. It is based on [FirstLineSegmentInString].
+func LineSegmentsSeq(str string) iter.Seq2[string, bool] {
+	return func(yield func(string, bool) bool) {
+		var (
+			segment   string
+			mustBreak bool
+		)
+		state := -1
+		for len(str) > 0 {
+			segment, str, mustBreak, state = FirstLineSegmentInString(str, state)
+			if !yield(segment, mustBreak) {
+				return
+			}
+		}
+	}
+}
+
+// LineSegmentsSeqBytes is like [LineSegmentsSeq] but its input and outputs are
+// byte slices. It is based on [FirstLineSegment].
+func LineSegmentsSeqBytes(b []byte) iter.Seq2[[]byte, bool] {
+	return func(yield func([]byte, bool) bool) {
+		var (
+			segment   []byte
+			mustBreak bool
+		)
+		state := -1
+		for len(b) > 0 {
+			segment, b, mustBreak, state = FirstLineSegment(b, state)
+			if !yield(segment, mustBreak) {
+				return
+			}
+		}
+	}
+}
+
+// StepSeq returns an iterator over the grapheme clusters of the given string,
+// to be used in a "for ... range" loop. Each grapheme cluster is accompanied
+// by the boundary information described in [Step]. It is based on
+// [StepString].
+func StepSeq(str string) iter.Seq2[string, int] {
+	return func(yield func(string, int) bool) {
+		var (
+			cluster    string
+			boundaries int
+		)
+		state := -1
+		for len(str) > 0 {
+			cluster, str, boundaries, state = StepString(str, state)
+			if !yield(cluster, boundaries) {
+				return
+			}
+		}
+	}
+}
+
+// StepSeqBytes is like [StepSeq] but its input and outputs are byte slices. It
+// is based on [Step].
+func StepSeqBytes(b []byte) iter.Seq2[[]byte, int] {
+	return func(yield func([]byte, int) bool) {
+		var (
+			cluster    []byte
+			boundaries int
+		)
+		state := -1
+		for len(b) > 0 {
+			cluster, b, boundaries, state = Step(b, state)
+			if !yield(cluster, boundaries) {
+				return
+			}
+		}
+	}
+}
+
+// StepBackSeq returns an iterator over the grapheme clusters of the given
+// string in reverse order, to be used in a "for ... range" loop. Each grapheme
+// cluster is accompanied by the boundary information described in [Step],
+// i.e. the clusters and boundaries are the same as those of [StepSeq]. Unlike
+// repeated calls to [StepBackString], each paragraph is parsed only once.
+func StepBackSeq(str string) iter.Seq2[string, int] {
+	return func(yield func(string, int) bool) {
+		s := backSteps[string]{text: str}
+		for {
+			cluster, boundaries, ok := s.previous()
+			if !ok || !yield(cluster, boundaries) {
+				return
+			}
+		}
+	}
+}
+
+// StepBackSeqBytes is like [StepBackSeq] but its input and outputs are byte
+// slices.
+func StepBackSeqBytes(b []byte) iter.Seq2[[]byte, int] {
+	return func(yield func([]byte, int) bool) {
+		s := backSteps[[]byte]{text: b}
+		for {
+			cluster, boundaries, ok := s.previous()
+			if !ok || !yield(cluster, boundaries) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,160 @@
+//go:build go1.23
+
+package uniseg
+
+import (
+	"iter"
+	"testing"
+)
+
+// testSeq checks that the given iterators return the segments expected by the
+// given test cases.
+func testSeq(t *testing.T, name string, testCases []testCase, seq func(string) iter.Seq[string], seqBytes func([]byte) iter.Seq[[]byte]) {
+	for testNum, testCase := range testCases {
+		var segments, segmentsBytes []string
+		for segment := range seq(testCase.original) {
+			segments = append(segments, segment)
+		}
+		for segment := range seqBytes([]byte(testCase.original)) {
+			segmentsBytes = append(segmentsBytes, string(segment))
+		}
+		if len(segments) != len(testCase.expected) || len(segmentsBytes) != len(testCase.expected) {
+			t.Errorf(`%s test case %d %q failed: Got %d (string) and %d (bytes) segments, expected %d`,
+				name,
+				testNum,
+				testCase.original,
+				len(segments),
+				len(segmentsBytes),
+				len(testCase.expected))
+			continue
+		}
+		for index, expected := range testCase.expected {
+			if segments[index] != string(expected) || segmentsBytes[index] != string(expected) {
+				t.Errorf(`%s test case %d %q failed: Segment at index %d is %x (string) and %x (bytes), expected %x`,
+					name,
+					testNum,
+					testCase.original,
+					index,
+					[]rune(segments[index]),
+					[]rune(segmentsBytes[index]),
+					expected)
+				break
+			}
+		}
+	}
+}
+
+// Test the iterators for grapheme clusters, words, and sentences.
+func TestSeq(t *testing.T) {
+	testSeq(t, "Graphemes", append(testCases, graphemeBreakTestCases...), GraphemesSeq, GraphemesSeqBytes)
+	testSeq(t, "Words", wordBreakTestCases, WordsSeq, WordsSeqBytes)
+	testSeq(t, "Sentences", sentenceBreakTestCases, SentencesSeq, SentencesSeqBytes)
+	testSeq(t, "LineSegments", lineBreakTestCases, func(str string) iter.Seq[string] {
+		return func(yield func(string) bool) {
+			for segment := range LineSegmentsSeq(str) {
+				if !yield(segment) {
+					return
+				}
+			}
+		}
+	}, func(b []byte) iter.Seq[[]byte] {
+		return func(yield func([]byte) bool) {
+			for segment := range LineSegmentsSeqBytes(b) {
+				if !yield(segment) {
+					return
+				}
+			}
+		}
+	})
+}
+
+// Test the Step iterators against the Step functions.
+func TestStepSeq(t *testing.T) {
+	str, state := benchmarkStr, -1
+	b := []byte(benchmarkStr)
+	next, stop := iter.Pull2(StepSeq(benchmarkStr))
+	defer stop()
+	nextBytes, stopBytes := iter.Pull2(StepSeqBytes(b))
+	defer stopBytes()
+	for len(str) > 0 {
+		var (
+			cluster    string
+			boundaries int
+		)
+		cluster, str, boundaries, state = StepString(str, state)
+		seqCluster, seqBoundaries, ok := next()
+		seqClusterBytes, seqBoundariesBytes, okBytes := nextBytes()
+		if !ok || !okBytes {
+			t.Fatalf(`Iterator ended early, expected %q`, cluster)
+		}
+		if seqCluster != cluster || string(seqClusterBytes) != cluster || seqBoundaries != boundaries || seqBoundariesBytes != boundaries {
+			t.Fatalf(`Got %q/%d (string) and %q/%d (bytes), expected %q/%d`, seqCluster, seqBoundaries, seqClusterBytes, seqBoundariesBytes, cluster, boundaries)
+		}
+	}
+	if _, _, ok := next(); ok {
+		t.Error(`Expected iterator to end`)
+	}
+}
+
+// Test the reverse Step iterators against the Step functions.
+func TestStepBackSeq(t *testing.T) {
+	texts := []string{"", benchmarkStr, "a\r\n\r\nb.\u2029\u2029c\n"}
+	for _, testCases := range [][]testCase{graphemeBreakTestCases, wordBreakTestCases, sentenceBreakTestCases, lineBreakTestCases} {
+		for _, testCase := range testCases {
+			texts = append(texts, testCase.original)
+		}
+	}
+	for index, text := range texts {
+		var (
+			clusters   []string
+			boundaries []int
+		)
+		str, state := text, -1
+		for len(str) > 0 {
+			var (
+				cluster  string
+				boundary int
+			)
+			cluster, str, boundary, state = StepString(str, state)
+			clusters = append(clusters, cluster)
+			boundaries = append(boundaries, boundary)
+		}
+		count := len(clusters)
+		nextBytes, stopBytes := iter.Pull2(StepBackSeqBytes([]byte(text)))
+		for cluster, boundary := range StepBackSeq(text) {
+			clusterBytes, boundaryBytes, _ := nextBytes()
+			count--
+			if count < 0 {
+				t.Errorf(`Test case %d %q failed: More clusters than expected, got %q`, index, text, cluster)
+				break
+			}
+			if cluster != clusters[count] || string(clusterBytes) != clusters[count] || boundary != boundaries[count] || boundaryBytes != boundaries[count] {
+				t.Errorf(`Test case %d %q failed: Cluster %d is %q/%x (string) and %q/%x (bytes), expected %q/%x`, index, text, count, cluster, boundary, clusterBytes, boundaryBytes, clusters[count], boundaries[count])
+				break
+			}
+		}
+		if count > 0 {
+			t.Errorf(`Test case %d %q failed: Iterator ended early, %d clusters remaining`, index, text, count)
+		}
+		stopBytes()
+	}
+}
+
+// Test that the line segment iterators report mandatory breaks.
+func TestLineSegmentsSeqMustBreak(t *testing.T) {
+	var result string
+	for segment, mustBreak := range LineSegmentsSeq("First line.\nSecond line.") {
+		result += segment
+		if mustBreak {
+			result += "‖"
+		} else {
+			result += "|"
+		}
+	}
+	if expected := "First |line.\n‖Second |line.‖"; result != expected {
+		t.Errorf(`Expected %q, got %q`, expected, result)
+	}
+	for range LineSegmentsSeqBytes([]byte("a b c")) {
+		break // Must not panic.
+	}
+}
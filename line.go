@@ -1,6 +1,163 @@
 package uniseg
 
-import "unicode/utf8"
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// LineSegments implements an iterator over line segments, i.e. the parts of a
+// string between which a line break may or must occur according to the rules
+// of [Unicode Standard Annex #14].
+//
+// After constructing the class via [NewLineSegments] for a given string "str"
+// (or [NewLineSegmentsBytes] for a byte slice), [LineSegments.Next] is called
+// for every segment in a loop until it returns false. Inside the loop,
+// information about the segment is available via the various methods (see
+// examples below).
+//
+// This class basically wraps the [FirstLineSegmentInString] parser and provides
+// a convenient interface to it. If performance is important, using
+// [FirstLineSegmentInString] directly is faster. Note that, like that function,
+// this class may break within grapheme clusters. Use [Step] or [Graphemes] if
+// you need to avoid this.
+//
+// [Unicode Standard Annex #14]: https://www.unicode.org/reports/tr14/
+type LineSegments struct {
+	// The original string.
+	original string
+
+	// The remaining string to be parsed.
+	remaining string
+
+	// The current line segment.
+	segment string
+
+	// The byte offset of the current line segment relative to the original
+	// string.
+	offset int
+
+	// Whether the line must be broken after the current segment.
+	mustBreak bool
+
+	// The current state of the line break parser. -1 before the first call to
+	// [LineSegments.Next], -2 after the iterator has reached the end.
+	state int
+}
+
+// NewLineSegments returns a new line segment iterator.
+func NewLineSegments(str string) *LineSegments {
+	return &LineSegments{
+		original:  str,
+		remaining: str,
+		state:     -1,
+	}
+}
+
+// NewLineSegmentsBytes returns a new line segment iterator for the given byte
+// slice. The byte slice is copied, i.e. later changes to it will not affect the
+// iterator.
+func NewLineSegmentsBytes(b []byte) *LineSegments {
+	return NewLineSegments(string(b))
+}
+
+// String returns a string representation of the current line segment iterator.
+// It includes the current segment, wrapped in curly brackets, and the first 10
+// bytes of the remaining string.
+func (l *LineSegments) String() string {
+	remaining := l.remaining
+	if len(remaining) > 10 {
+		remaining = remaining[:10] + "..."
+	}
+	return fmt.Sprintf("{%s}%s", l.segment, remaining)
+}
+
+// Next advances the iterator by one line segment and returns false if no
+// segments are left. This function must be called before the first segment is
+// accessed.
+func (l *LineSegments) Next() bool {
+	if len(l.remaining) == 0 {
+		// We're already past the end.
+		l.state = -2
+		l.segment = ""
+		l.mustBreak = true
+		return false
+	}
+	l.offset += len(l.segment)
+	l.segment, l.remaining, l.mustBreak, l.state = FirstLineSegmentInString(l.remaining, l.state)
+	return true
+}
+
+// Runes returns a slice of runes (code points) which corresponds to the current
+// line segment. If the iterator is already past the end or [LineSegments.Next]
+// has not yet been called, nil is returned.
+func (l *LineSegments) Runes() []rune {
+	if l.state < 0 {
+		return nil
+	}
+	return []rune(l.segment)
+}
+
+// Str returns a substring of the original string which corresponds to the
+// current line segment. If the iterator is already past the end or
+// [LineSegments.Next] has not yet been called, an empty string is returned.
+func (l *LineSegments) Str() string {
+	return l.segment
+}
+
+// Bytes returns a byte slice which corresponds to the current line segment. If
+// the iterator is already past the end or [LineSegments.Next] has not yet been
+// called, nil is returned.
+func (l *LineSegments) Bytes() []byte {
+	if l.state < 0 {
+		return nil
+	}
+	return []byte(l.segment)
+}
+
+// Positions returns the interval of the current line segment as byte positions
+// into the original string. The first returned value "from" indexes the first
+// byte and the second returned value "to" indexes the first byte that is not
+// included anymore, i.e. str[from:to] is the current segment of the original
+// string "str". If [LineSegments.Next] has not yet been called, both values are
+// 0. If the iterator is already past the end, both values are 1.
+func (l *LineSegments) Positions() (int, int) {
+	if l.state == -1 {
+		return 0, 0
+	} else if l.state == -2 {
+		return 1, 1
+	}
+	return l.offset, l.offset + len(l.segment)
+}
+
+// MustBreak returns true if the line must be broken after the current segment,
+// for example after newline characters, and false if it may be broken. In
+// accordance with [UAX #14 LB3], this returns true for the last segment. It
+// also returns true if the iterator is already past the end and false if
+// [LineSegments.Next] has not yet been called.
+//
+// [UAX #14 LB3]: https://www.unicode.org/reports/tr14/#Algorithm
+func (l *LineSegments) MustBreak() bool {
+	return l.mustBreak
+}
+
+// Width returns the monospace width of the current line segment. See
+// [StringWidth] for details on how the width is calculated.
+func (l *LineSegments) Width() int {
+	if l.state < 0 {
+		return 0
+	}
+	return StringWidth(l.segment)
+}
+
+// Reset puts the iterator into its initial state such that the next call to
+// [LineSegments.Next] sets it to the first segment again.
+func (l *LineSegments) Reset() {
+	l.state = -1
+	l.offset = 0
+	l.segment = ""
+	l.mustBreak = false
+	l.remaining = l.original
+}
 
 // FirstLineSegment returns the prefix of the given byte slice after which a
 // decision to break the string over to the next line can or must be made,
@@ -47,71 +204,48 @@ import "unicode/utf8"
 // [Unicode Standard Annex #14]: https://www.unicode.org/reports/tr14/
 // [UAX #14 LB3]: https://www.unicode.org/reports/tr14/#Algorithm
 func FirstLineSegment(b []byte, state int) (segment, rest []byte, mustBreak bool, newState int) {
-	// An empty byte slice returns nothing.
-	if len(b) == 0 {
-		return
-	}
-
-	// Extract the first rune.
-	r, length := utf8.DecodeRune(b)
-	if len(b) <= length { // If we're already past the end, there is nothing else to parse.
-		return b, nil, true, lbAny // LB3.
-	}
-
-	// If we don't know the state, determine it now.
-	if state < 0 {
-		state, _ = transitionLineBreakState(state, r, b[length:], "")
-	}
-
-	// Transition until we find a boundary.
-	var boundary int
-	for {
-		r, l := utf8.DecodeRune(b[length:])
-		state, boundary = transitionLineBreakState(state, r, b[length+l:], "")
-
-		if boundary != LineDontBreak {
-			return b[:length], b[length:], boundary == LineMustBreak, state
-		}
-
-		length += l
-		if len(b) <= length {
-			return b, nil, true, lbAny // LB3
-		}
-	}
+	return firstLineSegment(b, state)
 }
 
 // FirstLineSegmentInString is like [FirstLineSegment] but its input and outputs
 // are strings.
 func FirstLineSegmentInString(str string, state int) (segment, rest string, mustBreak bool, newState int) {
-	// An empty byte slice returns nothing.
-	if len(str) == 0 {
+	return firstLineSegment(str, state)
+}
+
+// firstLineSegment implements [FirstLineSegment] for all supported text
+// representations.
+func firstLineSegment[T text](t T, state int) (segment, rest T, mustBreak bool, newState int) {
+	// An empty text returns nothing.
+	if len(t) == 0 {
 		return
 	}
 
 	// Extract the first rune.
-	r, length := utf8.DecodeRuneInString(str)
-	if len(str) <= length { // If we're already past the end, there is nothing else to parse.
-		return str, "", true, lbAny // LB3.
+	r, remainder := decodeText(t)
+	if len(remainder) == 0 { // If we're already past the end, there is nothing else to parse.
+		return t, rest, true, lbAny // LB3.
 	}
 
 	// If we don't know the state, determine it now.
 	if state < 0 {
-		state, _ = transitionLineBreakState(state, r, nil, str[length:])
+		state, _ = transitionLineBreakState(state, r, remainder)
 	}
 
 	// Transition until we find a boundary.
 	var boundary int
 	for {
-		r, l := utf8.DecodeRuneInString(str[length:])
-		state, boundary = transitionLineBreakState(state, r, nil, str[length+l:])
+		r, next := decodeText(remainder)
+		state, boundary = transitionLineBreakState(state, r, next)
 
 		if boundary != LineDontBreak {
-			return str[:length], str[length:], boundary == LineMustBreak, state
+			segment, rest = splitText(t, len(t)-len(remainder))
+			return segment, rest, boundary == LineMustBreak, state
 		}
 
-		length += l
-		if len(str) <= length {
-			return str, "", true, lbAny // LB3.
+		remainder = next
+		if len(remainder) == 0 {
+			return t, rest, true, lbAny // LB3.
 		}
 	}
 }
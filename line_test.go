@@ -206,3 +206,107 @@ func BenchmarkLineFunctionString(b *testing.B) {
 		}
 	}
 }
+
+// Test all official Unicode test cases for line breaks using the LineSegments
+// class.
+func TestLineSegmentsClass(t *testing.T) {
+	for testNum, testCase := range lineBreakTestCases {
+		segments := NewLineSegments(testCase.original)
+		var index int
+	SegmentLoop:
+		for index = 0; segments.Next(); index++ {
+			if index >= len(testCase.expected) {
+				t.Errorf(`Test case %d %q failed: More segments %d returned than expected %d`,
+					testNum,
+					testCase.original,
+					index,
+					len(testCase.expected))
+				break
+			}
+			segment := segments.Runes()
+			if len(segment) != len(testCase.expected[index]) {
+				t.Errorf(`Test case %d %q failed: Segment at index %d has %d codepoints %x, %d expected %x`,
+					testNum,
+					testCase.original,
+					index,
+					len(segment),
+					segment,
+					len(testCase.expected[index]),
+					testCase.expected[index])
+				break
+			}
+			for i, r := range segment {
+				if r != testCase.expected[index][i] {
+					t.Errorf(`Test case %d %q failed: Segment at index %d is %x, expected %x`,
+						testNum,
+						testCase.original,
+						index,
+						segment,
+						testCase.expected[index])
+					break SegmentLoop
+				}
+			}
+		}
+		if index < len(testCase.expected) {
+			t.Errorf(`Test case %d %q failed: Fewer segments returned (%d) than expected (%d)`,
+				testNum,
+				testCase.original,
+				index,
+				len(testCase.expected))
+		}
+	}
+}
+
+// Test the positions, break flags, and widths returned by the LineSegments
+// class.
+func TestLineSegmentsPositions(t *testing.T) {
+	segments := NewLineSegmentsBytes([]byte("Hä wo\n世界"))
+	expected := []struct {
+		segment   string
+		from, to  int
+		mustBreak bool
+		width     int
+	}{
+		{"Hä ", 0, 4, false, 3},
+		{"wo\n", 4, 7, true, 2},
+		{"世", 7, 10, false, 2},
+		{"界", 10, 13, true, 2},
+	}
+	if segments.MustBreak() {
+		t.Error(`Expected no mandatory break before calling Next()`)
+	}
+	for index, e := range expected {
+		if !segments.Next() {
+			t.Fatalf(`Expected segment %d, got none`, index)
+		}
+		if str := segments.Str(); str != e.segment {
+			t.Errorf(`Segment %d: expected %q, got %q`, index, e.segment, str)
+		}
+		if from, to := segments.Positions(); from != e.from || to != e.to {
+			t.Errorf(`Segment %d: expected from=%d to=%d, got from=%d to=%d`, index, e.from, e.to, from, to)
+		}
+		if mustBreak := segments.MustBreak(); mustBreak != e.mustBreak {
+			t.Errorf(`Segment %d: expected mustBreak=%t, got %t`, index, e.mustBreak, mustBreak)
+		}
+		if width := segments.Width(); width != e.width {
+			t.Errorf(`Segment %d: expected width %d, got %d`, index, e.width, width)
+		}
+	}
+	if segments.Next() {
+		t.Errorf(`Expected no more segments, got %q`, segments.Str())
+	}
+	if from, to := segments.Positions(); from != 1 || to != 1 {
+		t.Errorf(`Expected from=%d to=%d, got from=%d to=%d`, 1, 1, from, to)
+	}
+	if !segments.MustBreak() {
+		t.Error(`Expected mandatory break after the end`)
+	}
+	segments.Reset()
+	if r := segments.Runes(); r != nil {
+		t.Errorf(`Expected nil rune slice, got %x`, r)
+	}
+	segments.Next()
+	if str := segments.Str(); str != "Hä " {
+		t.Errorf(`Expected "Hä ", got %q`, str)
+	}
+}
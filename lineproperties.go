@@ -1,13 +1,12 @@
+//go:build !uniseg_unicode15
+
 // Code generated via go generate from gen_properties.go. DO NOT EDIT.
 
 package uniseg
 
 // lineBreakCodePoints are taken from
-// https://www.unicode.org/Public/15.0.0/ucd/LineBreak.txt
-// and
-// https://unicode.org/Public/15.0.0/ucd/emoji/emoji-data.txt
-// ("Extended_Pictographic" only)
-// on September 5, 2023. See https://www.unicode.org/license.html for the Unicode
+// https://www.unicode.org/Public/17.0.0/ucd/LineBreak.txt
+// on October 18, 2026. See https://www.unicode.org/license.html for the Unicode
 // license agreement.
 var lineBreakCodePoints = [][4]int{
 	{0x0000, 0x0008, prCM, gcCc},     //     [9] <control-0000>..<control-0008>
@@ -91,8 +90,8 @@ var lineBreakCodePoints = [][4]int{
 	{0x01C0, 0x01C3, prAL, gcLo},     //     [4] LATIN LETTER DENTAL CLICK..LATIN LETTER RETROFLEX CLICK
 	{0x01C4, 0x024F, prAL, gcLC},     //   [140] LATIN CAPITAL LETTER DZ WITH CARON..LATIN SMALL LETTER Y WITH STROKE
 	{0x0250, 0x0293, prAL, gcLl},     //    [68] LATIN SMALL LETTER TURNED A..LATIN SMALL LETTER EZH WITH CURL
-	{0x0294, 0x0294, prAL, gcLo},     //         LATIN LETTER GLOTTAL STOP
-	{0x0295, 0x02AF, prAL, gcLl},     //    [27] LATIN LETTER PHARYNGEAL VOICED FRICATIVE..LATIN SMALL LETTER TURNED H WITH FISHHOOK AND TAIL
+	{0x0294, 0x0295, prAL, gcLo},     //     [2] LATIN LETTER GLOTTAL STOP..LATIN LETTER PHARYNGEAL VOICED FRICATIVE
+	{0x0296, 0x02AF, prAL, gcLl},     //    [26] LATIN LETTER INVERTED GLOTTAL STOP..LATIN SMALL LETTER TURNED H WITH FISHHOOK AND TAIL
 	{0x02B0, 0x02C1, prAL, gcLm},     //    [18] MODIFIER LETTER SMALL H..MODIFIER LETTER REVERSED GLOTTAL STOP
 	{0x02C2, 0x02C5, prAL, gcSk},     //     [4] MODIFIER LETTER LEFT ARROWHEAD..MODIFIER LETTER DOWN ARROWHEAD
 	{0x02C6, 0x02C6, prAL, gcLm},     //         MODIFIER LETTER CIRCUMFLEX ACCENT
@@ -116,9 +115,7 @@ var lineBreakCodePoints = [][4]int{
 	{0x02ED, 0x02ED, prAL, gcSk},     //         MODIFIER LETTER UNASPIRATED
 	{0x02EE, 0x02EE, prAL, gcLm},     //         MODIFIER LETTER DOUBLE APOSTROPHE
 	{0x02EF, 0x02FF, prAL, gcSk},     //    [17] MODIFIER LETTER LOW DOWN ARROWHEAD..MODIFIER LETTER LOW LEFT ARROW
-	{0x0300, 0x034E, prCM, gcMn},     //    [79] COMBINING GRAVE ACCENT..COMBINING UPWARDS ARROW BELOW
-	{0x034F, 0x034F, prGL, gcMn},     //         COMBINING GRAPHEME JOINER
-	{0x0350, 0x035B, prCM, gcMn},     //    [12] COMBINING RIGHT ARROWHEAD ABOVE..COMBINING ZIGZAG ABOVE
+	{0x0300, 0x035B, prCM, gcMn},     //    [92] COMBINING GRAVE ACCENT..COMBINING ZIGZAG ABOVE
 	{0x035C, 0x0362, prGL, gcMn},     //     [7] COMBINING DOUBLE BREVE BELOW..COMBINING DOUBLE RIGHTWARDS ARROW BELOW
 	{0x0363, 0x036F, prCM, gcMn},     //    [13] COMBINING LATIN SMALL LETTER A..COMBINING LATIN SMALL LETTER X
 	{0x0370, 0x0373, prAL, gcLC},     //     [4] GREEK CAPITAL LETTER HETA..GREEK SMALL LETTER ARCHAIC SAMPI
@@ -149,11 +146,11 @@ var lineBreakCodePoints = [][4]int{
 	{0x055A, 0x055F, prAL, gcPo},     //     [6] ARMENIAN APOSTROPHE..ARMENIAN ABBREVIATION MARK
 	{0x0560, 0x0588, prAL, gcLl},     //    [41] ARMENIAN SMALL LETTER TURNED AYB..ARMENIAN SMALL LETTER YI WITH STROKE
 	{0x0589, 0x0589, prIS, gcPo},     //         ARMENIAN FULL STOP
-	{0x058A, 0x058A, prBA, gcPd},     //         ARMENIAN HYPHEN
+	{0x058A, 0x058A, prHH, gcPd},     //         ARMENIAN HYPHEN
 	{0x058D, 0x058E, prAL, gcSo},     //     [2] RIGHT-FACING ARMENIAN ETERNITY SIGN..LEFT-FACING ARMENIAN ETERNITY SIGN
 	{0x058F, 0x058F, prPR, gcSc},     //         ARMENIAN DRAM SIGN
 	{0x0591, 0x05BD, prCM, gcMn},     //    [45] HEBREW ACCENT ETNAHTA..HEBREW POINT METEG
-	{0x05BE, 0x05BE, prBA, gcPd},     //         HEBREW PUNCTUATION MAQAF
+	{0x05BE, 0x05BE, prHH, gcPd},     //         HEBREW PUNCTUATION MAQAF
 	{0x05BF, 0x05BF, prCM, gcMn},     //         HEBREW POINT RAFE
 	{0x05C0, 0x05C0, prAL, gcPo},     //         HEBREW PUNCTUATION PASEQ
 	{0x05C1, 0x05C2, prCM, gcMn},     //     [2] HEBREW POINT SHIN DOT..HEBREW POINT SIN DOT
@@ -164,7 +161,7 @@ var lineBreakCodePoints = [][4]int{
 	{0x05D0, 0x05EA, prHL, gcLo},     //    [27] HEBREW LETTER ALEF..HEBREW LETTER TAV
 	{0x05EF, 0x05F2, prHL, gcLo},     //     [4] HEBREW YOD TRIANGLE..HEBREW LIGATURE YIDDISH DOUBLE YOD
 	{0x05F3, 0x05F4, prAL, gcPo},     //     [2] HEBREW PUNCTUATION GERESH..HEBREW PUNCTUATION GERSHAYIM
-	{0x0600, 0x0605, prAL, gcCf},     //     [6] ARABIC NUMBER SIGN..ARABIC NUMBER MARK ABOVE
+	{0x0600, 0x0605, prNU, gcCf},     //     [6] ARABIC NUMBER SIGN..ARABIC NUMBER MARK ABOVE
 	{0x0606, 0x0608, prAL, gcSm},     //     [3] ARABIC-INDIC CUBE ROOT..ARABIC RAY
 	{0x0609, 0x060A, prPO, gcPo},     //     [2] ARABIC-INDIC PER MILLE SIGN..ARABIC-INDIC PER TEN THOUSAND SIGN
 	{0x060B, 0x060B, prPO, gcSc},     //         AFGHANI SIGN
@@ -188,7 +185,7 @@ var lineBreakCodePoints = [][4]int{
 	{0x06D4, 0x06D4, prEX, gcPo},     //         ARABIC FULL STOP
 	{0x06D5, 0x06D5, prAL, gcLo},     //         ARABIC LETTER AE
 	{0x06D6, 0x06DC, prCM, gcMn},     //     [7] ARABIC SMALL HIGH LIGATURE SAD WITH LAM WITH ALEF MAKSURA..ARABIC SMALL HIGH SEEN
-	{0x06DD, 0x06DD, prAL, gcCf},     //         ARABIC END OF AYAH
+	{0x06DD, 0x06DD, prNU, gcCf},     //         ARABIC END OF AYAH
 	{0x06DE, 0x06DE, prAL, gcSo},     //         ARABIC START OF RUB EL HIZB
 	{0x06DF, 0x06E4, prCM, gcMn},     //     [6] ARABIC SMALL HIGH ROUNDED ZERO..ARABIC SMALL HIGH MADDA
 	{0x06E5, 0x06E6, prAL, gcLm},     //     [2] ARABIC SMALL WAW..ARABIC SMALL YEH
@@ -237,13 +234,13 @@ var lineBreakCodePoints = [][4]int{
 	{0x0860, 0x086A, prAL, gcLo},     //    [11] SYRIAC LETTER MALAYALAM NGA..SYRIAC LETTER MALAYALAM SSA
 	{0x0870, 0x0887, prAL, gcLo},     //    [24] ARABIC LETTER ALEF WITH ATTACHED FATHA..ARABIC BASELINE ROUND DOT
 	{0x0888, 0x0888, prAL, gcSk},     //         ARABIC RAISED ROUND DOT
-	{0x0889, 0x088E, prAL, gcLo},     //     [6] ARABIC LETTER NOON WITH INVERTED SMALL V..ARABIC VERTICAL TAIL
-	{0x0890, 0x0891, prAL, gcCf},     //     [2] ARABIC POUND MARK ABOVE..ARABIC PIASTRE MARK ABOVE
-	{0x0898, 0x089F, prCM, gcMn},     //     [8] ARABIC SMALL HIGH WORD AL-JUZ..ARABIC HALF MADDA OVER MADDA
+	{0x0889, 0x088F, prAL, gcLo},     //     [7] ARABIC LETTER NOON WITH INVERTED SMALL V..ARABIC LETTER NOON WITH RING ABOVE
+	{0x0890, 0x0891, prNU, gcCf},     //     [2] ARABIC POUND MARK ABOVE..ARABIC PIASTRE MARK ABOVE
+	{0x0897, 0x089F, prCM, gcMn},     //     [9] ARABIC PEPET..ARABIC HALF MADDA OVER MADDA
 	{0x08A0, 0x08C8, prAL, gcLo},     //    [41] ARABIC LETTER BEH WITH SMALL V BELOW..ARABIC LETTER GRAF
 	{0x08C9, 0x08C9, prAL, gcLm},     //         ARABIC SMALL FARSI YEH
 	{0x08CA, 0x08E1, prCM, gcMn},     //    [24] ARABIC SMALL HIGH FARSI YEH..ARABIC SMALL HIGH SIGN SAFHA
-	{0x08E2, 0x08E2, prAL, gcCf},     //         ARABIC DISPUTED END OF AYAH
+	{0x08E2, 0x08E2, prNU, gcCf},     //         ARABIC DISPUTED END OF AYAH
 	{0x08E3, 0x08FF, prCM, gcMn},     //    [29] ARABIC TURNED DAMMA BELOW..ARABIC MARK SIDEWAYS NOON GHUNNA
 	{0x0900, 0x0902, prCM, gcMn},     //     [3] DEVANAGARI SIGN INVERTED CANDRABINDU..DEVANAGARI SIGN ANUSVARA
 	{0x0903, 0x0903, prCM, gcMc},     //         DEVANAGARI SIGN VISARGA
@@ -408,7 +405,7 @@ var lineBreakCodePoints = [][4]int{
 	{0x0C4A, 0x0C4D, prCM, gcMn},     //     [4] TELUGU VOWEL SIGN O..TELUGU SIGN VIRAMA
 	{0x0C55, 0x0C56, prCM, gcMn},     //     [2] TELUGU LENGTH MARK..TELUGU AI LENGTH MARK
 	{0x0C58, 0x0C5A, prAL, gcLo},     //     [3] TELUGU LETTER TSA..TELUGU LETTER RRRA
-	{0x0C5D, 0x0C5D, prAL, gcLo},     //         TELUGU LETTER NAKAARA POLLU
+	{0x0C5C, 0x0C5D, prAL, gcLo},     //     [2] TELUGU ARCHAIC SHRII..TELUGU LETTER NAKAARA POLLU
 	{0x0C60, 0x0C61, prAL, gcLo},     //     [2] TELUGU LETTER VOCALIC RR..TELUGU LETTER VOCALIC LL
 	{0x0C62, 0x0C63, prCM, gcMn},     //     [2] TELUGU VOWEL SIGN VOCALIC L..TELUGU VOWEL SIGN VOCALIC LL
 	{0x0C66, 0x0C6F, prNU, gcNd},     //    [10] TELUGU DIGIT ZERO..TELUGU DIGIT NINE
@@ -434,7 +431,7 @@ var lineBreakCodePoints = [][4]int{
 	{0x0CCA, 0x0CCB, prCM, gcMc},     //     [2] KANNADA VOWEL SIGN O..KANNADA VOWEL SIGN OO
 	{0x0CCC, 0x0CCD, prCM, gcMn},     //     [2] KANNADA VOWEL SIGN AU..KANNADA SIGN VIRAMA
 	{0x0CD5, 0x0CD6, prCM, gcMc},     //     [2] KANNADA LENGTH MARK..KANNADA AI LENGTH MARK
-	{0x0CDD, 0x0CDE, prAL, gcLo},     //     [2] KANNADA LETTER NAKAARA POLLU..KANNADA LETTER FA
+	{0x0CDC, 0x0CDE, prAL, gcLo},     //     [3] KANNADA ARCHAIC SHRII..KANNADA LETTER FA
 	{0x0CE0, 0x0CE1, prAL, gcLo},     //     [2] KANNADA LETTER VOCALIC RR..KANNADA LETTER VOCALIC LL
 	{0x0CE2, 0x0CE3, prCM, gcMn},     //     [2] KANNADA VOWEL SIGN VOCALIC L..KANNADA VOWEL SIGN VOCALIC LL
 	{0x0CE6, 0x0CEF, prNU, gcNd},     //    [10] KANNADA DIGIT ZERO..KANNADA DIGIT NINE
@@ -625,7 +622,7 @@ var lineBreakCodePoints = [][4]int{
 	{0x1390, 0x1399, prAL, gcSo},     //    [10] ETHIOPIC TONAL MARK YIZET..ETHIOPIC TONAL MARK KURT
 	{0x13A0, 0x13F5, prAL, gcLu},     //    [86] CHEROKEE LETTER A..CHEROKEE LETTER MV
 	{0x13F8, 0x13FD, prAL, gcLl},     //     [6] CHEROKEE SMALL LETTER YE..CHEROKEE SMALL LETTER MV
-	{0x1400, 0x1400, prBA, gcPd},     //         CANADIAN SYLLABICS HYPHEN
+	{0x1400, 0x1400, prHH, gcPd},     //         CANADIAN SYLLABICS HYPHEN
 	{0x1401, 0x166C, prAL, gcLo},     //   [620] CANADIAN SYLLABICS E..CANADIAN SYLLABICS CARRIER TTSA
 	{0x166D, 0x166D, prAL, gcSo},     //         CANADIAN SYLLABICS CHI SIGN
 	{0x166E, 0x166E, prAL, gcPo},     //         CANADIAN SYLLABICS FULL STOP
@@ -707,7 +704,7 @@ var lineBreakCodePoints = [][4]int{
 	{0x1980, 0x19AB, prSA, gcLo},     //    [44] NEW TAI LUE LETTER HIGH QA..NEW TAI LUE LETTER LOW SUA
 	{0x19B0, 0x19C9, prSA, gcLo},     //    [26] NEW TAI LUE VOWEL SIGN VOWEL SHORTENER..NEW TAI LUE TONE MARK-2
 	{0x19D0, 0x19D9, prNU, gcNd},     //    [10] NEW TAI LUE DIGIT ZERO..NEW TAI LUE DIGIT NINE
-	{0x19DA, 0x19DA, prSA, gcNo},     //         NEW TAI LUE THAM DIGIT ONE
+	{0x19DA, 0x19DA, prNU, gcNo},     //         NEW TAI LUE THAM DIGIT ONE
 	{0x19DE, 0x19DF, prSA, gcSo},     //     [2] NEW TAI LUE SIGN LAE..NEW TAI LUE SIGN LAEV
 	{0x19E0, 0x19FF, prAL, gcSo},     //    [32] KHMER SYMBOL PATHAMASAT..KHMER SYMBOL DAP-PRAM ROC
 	{0x1A00, 0x1A16, prAL, gcLo},     //    [23] BUGINESE LETTER KA..BUGINESE LETTER HA
@@ -735,10 +732,12 @@ var lineBreakCodePoints = [][4]int{
 	{0x1AA8, 0x1AAD, prSA, gcPo},     //     [6] TAI THAM SIGN KAAN..TAI THAM SIGN CAANG
 	{0x1AB0, 0x1ABD, prCM, gcMn},     //    [14] COMBINING DOUBLED CIRCUMFLEX ACCENT..COMBINING PARENTHESES BELOW
 	{0x1ABE, 0x1ABE, prCM, gcMe},     //         COMBINING PARENTHESES OVERLAY
-	{0x1ABF, 0x1ACE, prCM, gcMn},     //    [16] COMBINING LATIN SMALL LETTER W BELOW..COMBINING LATIN SMALL LETTER INSULAR T
+	{0x1ABF, 0x1ADD, prCM, gcMn},     //    [31] COMBINING LATIN SMALL LETTER W BELOW..COMBINING DOT-AND-RING BELOW
+	{0x1AE0, 0x1AEA, prCM, gcMn},     //    [11] COMBINING LEFT TACK ABOVE..COMBINING UPWARDS ARROW ABOVE
+	{0x1AEB, 0x1AEB, prGL, gcMn},     //         COMBINING DOUBLE RIGHTWARDS ARROW ABOVE
 	{0x1B00, 0x1B03, prCM, gcMn},     //     [4] BALINESE SIGN ULU RICEM..BALINESE SIGN SURANG
 	{0x1B04, 0x1B04, prCM, gcMc},     //         BALINESE SIGN BISAH
-	{0x1B05, 0x1B33, prAL, gcLo},     //    [47] BALINESE LETTER AKARA..BALINESE LETTER HA
+	{0x1B05, 0x1B33, prAK, gcLo},     //    [47] BALINESE LETTER AKARA..BALINESE LETTER HA
 	{0x1B34, 0x1B34, prCM, gcMn},     //         BALINESE SIGN REREKAN
 	{0x1B35, 0x1B35, prCM, gcMc},     //         BALINESE VOWEL SIGN TEDUNG
 	{0x1B36, 0x1B3A, prCM, gcMn},     //     [5] BALINESE VOWEL SIGN ULU..BALINESE VOWEL SIGN RA REPA
@@ -746,16 +745,18 @@ var lineBreakCodePoints = [][4]int{
 	{0x1B3C, 0x1B3C, prCM, gcMn},     //         BALINESE VOWEL SIGN LA LENGA
 	{0x1B3D, 0x1B41, prCM, gcMc},     //     [5] BALINESE VOWEL SIGN LA LENGA TEDUNG..BALINESE VOWEL SIGN TALING REPA TEDUNG
 	{0x1B42, 0x1B42, prCM, gcMn},     //         BALINESE VOWEL SIGN PEPET
-	{0x1B43, 0x1B44, prCM, gcMc},     //     [2] BALINESE VOWEL SIGN PEPET TEDUNG..BALINESE ADEG ADEG
-	{0x1B45, 0x1B4C, prAL, gcLo},     //     [8] BALINESE LETTER KAF SASAK..BALINESE LETTER ARCHAIC JNYA
-	{0x1B50, 0x1B59, prNU, gcNd},     //    [10] BALINESE DIGIT ZERO..BALINESE DIGIT NINE
+	{0x1B43, 0x1B43, prCM, gcMc},     //         BALINESE VOWEL SIGN PEPET TEDUNG
+	{0x1B44, 0x1B44, prVI, gcMc},     //         BALINESE ADEG ADEG
+	{0x1B45, 0x1B4C, prAK, gcLo},     //     [8] BALINESE LETTER KAF SASAK..BALINESE LETTER ARCHAIC JNYA
+	{0x1B4E, 0x1B4F, prBA, gcPo},     //     [2] BALINESE INVERTED CARIK SIKI..BALINESE INVERTED CARIK PAREREN
+	{0x1B50, 0x1B59, prAS, gcNd},     //    [10] BALINESE DIGIT ZERO..BALINESE DIGIT NINE
 	{0x1B5A, 0x1B5B, prBA, gcPo},     //     [2] BALINESE PANTI..BALINESE PAMADA
-	{0x1B5C, 0x1B5C, prAL, gcPo},     //         BALINESE WINDU
+	{0x1B5C, 0x1B5C, prID, gcPo},     //         BALINESE WINDU
 	{0x1B5D, 0x1B60, prBA, gcPo},     //     [4] BALINESE CARIK PAMUNGKAH..BALINESE PAMENENG
-	{0x1B61, 0x1B6A, prAL, gcSo},     //    [10] BALINESE MUSICAL SYMBOL DONG..BALINESE MUSICAL SYMBOL DANG GEDE
+	{0x1B61, 0x1B6A, prID, gcSo},     //    [10] BALINESE MUSICAL SYMBOL DONG..BALINESE MUSICAL SYMBOL DANG GEDE
 	{0x1B6B, 0x1B73, prCM, gcMn},     //     [9] BALINESE MUSICAL SYMBOL COMBINING TEGEH..BALINESE MUSICAL SYMBOL COMBINING GONG
-	{0x1B74, 0x1B7C, prAL, gcSo},     //     [9] BALINESE MUSICAL SYMBOL RIGHT-HAND OPEN DUG..BALINESE MUSICAL SYMBOL LEFT-HAND OPEN PING
-	{0x1B7D, 0x1B7E, prBA, gcPo},     //     [2] BALINESE PANTI LANTANG..BALINESE PAMADA LANTANG
+	{0x1B74, 0x1B7C, prID, gcSo},     //     [9] BALINESE MUSICAL SYMBOL RIGHT-HAND OPEN DUG..BALINESE MUSICAL SYMBOL LEFT-HAND OPEN PING
+	{0x1B7D, 0x1B7F, prBA, gcPo},     //     [3] BALINESE PANTI LANTANG..BALINESE PANTI BAWAK
 	{0x1B80, 0x1B81, prCM, gcMn},     //     [2] SUNDANESE SIGN PANYECEK..SUNDANESE SIGN PANGLAYAR
 	{0x1B82, 0x1B82, prCM, gcMc},     //         SUNDANESE SIGN PANGWISAD
 	{0x1B83, 0x1BA0, prAL, gcLo},     //    [30] SUNDANESE LETTER A..SUNDANESE LETTER HA
@@ -768,7 +769,7 @@ var lineBreakCodePoints = [][4]int{
 	{0x1BAE, 0x1BAF, prAL, gcLo},     //     [2] SUNDANESE LETTER KHA..SUNDANESE LETTER SYA
 	{0x1BB0, 0x1BB9, prNU, gcNd},     //    [10] SUNDANESE DIGIT ZERO..SUNDANESE DIGIT NINE
 	{0x1BBA, 0x1BBF, prAL, gcLo},     //     [6] SUNDANESE AVAGRAHA..SUNDANESE LETTER FINAL M
-	{0x1BC0, 0x1BE5, prAL, gcLo},     //    [38] BATAK LETTER A..BATAK LETTER U
+	{0x1BC0, 0x1BE5, prAS, gcLo},     //    [38] BATAK LETTER A..BATAK LETTER U
 	{0x1BE6, 0x1BE6, prCM, gcMn},     //         BATAK SIGN TOMPI
 	{0x1BE7, 0x1BE7, prCM, gcMc},     //         BATAK VOWEL SIGN E
 	{0x1BE8, 0x1BE9, prCM, gcMn},     //     [2] BATAK VOWEL SIGN PAKPAK E..BATAK VOWEL SIGN EE
@@ -776,7 +777,7 @@ var lineBreakCodePoints = [][4]int{
 	{0x1BED, 0x1BED, prCM, gcMn},     //         BATAK VOWEL SIGN KARO O
 	{0x1BEE, 0x1BEE, prCM, gcMc},     //         BATAK VOWEL SIGN U
 	{0x1BEF, 0x1BF1, prCM, gcMn},     //     [3] BATAK VOWEL SIGN U FOR SIMALUNGUN SA..BATAK CONSONANT SIGN H
-	{0x1BF2, 0x1BF3, prCM, gcMc},     //     [2] BATAK PANGOLAT..BATAK PANONGONAN
+	{0x1BF2, 0x1BF3, prVF, gcMc},     //     [2] BATAK PANGOLAT..BATAK PANONGONAN
 	{0x1BFC, 0x1BFF, prAL, gcPo},     //     [4] BATAK SYMBOL BINDU NA METEK..BATAK SYMBOL BINDU PANGOLAT
 	{0x1C00, 0x1C23, prAL, gcLo},     //    [36] LEPCHA LETTER KA..LEPCHA LETTER A
 	{0x1C24, 0x1C2B, prCM, gcMc},     //     [8] LEPCHA SUBJOINED LETTER YA..LEPCHA VOWEL SIGN UU
@@ -790,7 +791,7 @@ var lineBreakCodePoints = [][4]int{
 	{0x1C5A, 0x1C77, prAL, gcLo},     //    [30] OL CHIKI LETTER LA..OL CHIKI LETTER OH
 	{0x1C78, 0x1C7D, prAL, gcLm},     //     [6] OL CHIKI MU TTUDDAG..OL CHIKI AHAD
 	{0x1C7E, 0x1C7F, prBA, gcPo},     //     [2] OL CHIKI PUNCTUATION MUCAAD..OL CHIKI PUNCTUATION DOUBLE MUCAAD
-	{0x1C80, 0x1C88, prAL, gcLl},     //     [9] CYRILLIC SMALL LETTER ROUNDED VE..CYRILLIC SMALL LETTER UNBLENDED UK
+	{0x1C80, 0x1C8A, prAL, gcLC},     //    [11] CYRILLIC SMALL LETTER ROUNDED VE..CYRILLIC SMALL LETTER TJE
 	{0x1C90, 0x1CBA, prAL, gcLu},     //    [43] GEORGIAN MTAVRULI CAPITAL LETTER AN..GEORGIAN MTAVRULI CAPITAL LETTER AIN
 	{0x1CBD, 0x1CBF, prAL, gcLu},     //     [3] GEORGIAN MTAVRULI CAPITAL LETTER AEN..GEORGIAN MTAVRULI CAPITAL LETTER LABIAL SIGN
 	{0x1CC0, 0x1CC7, prAL, gcPo},     //     [8] SUNDANESE PUNCTUATION BINDU SURYA..SUNDANESE PUNCTUATION BINDU BA SATANGA
@@ -853,9 +854,9 @@ var lineBreakCodePoints = [][4]int{
 	{0x200C, 0x200C, prCM, gcCf},     //         ZERO WIDTH NON-JOINER
 	{0x200D, 0x200D, prZWJ, gcCf},    //         ZERO WIDTH JOINER
 	{0x200E, 0x200F, prCM, gcCf},     //     [2] LEFT-TO-RIGHT MARK..RIGHT-TO-LEFT MARK
-	{0x2010, 0x2010, prBA, gcPd},     //         HYPHEN
+	{0x2010, 0x2010, prHH, gcPd},     //         HYPHEN
 	{0x2011, 0x2011, prGL, gcPd},     //         NON-BREAKING HYPHEN
-	{0x2012, 0x2013, prBA, gcPd},     //     [2] FIGURE DASH..EN DASH
+	{0x2012, 0x2013, prHH, gcPd},     //     [2] FIGURE DASH..EN DASH
 	{0x2014, 0x2014, prB2, gcPd},     //         EM DASH
 	{0x2015, 0x2015, prAI, gcPd},     //         HORIZONTAL BAR
 	{0x2016, 0x2016, prAI, gcPo},     //         DOUBLE VERTICAL LINE
@@ -927,7 +928,8 @@ var lineBreakCodePoints = [][4]int{
 	{0x20BE, 0x20BE, prPO, gcSc},     //         LARI SIGN
 	{0x20BF, 0x20BF, prPR, gcSc},     //         BITCOIN SIGN
 	{0x20C0, 0x20C0, prPO, gcSc},     //         SOM SIGN
-	{0x20C1, 0x20CF, prPR, gcCn},     //    [15] <reserved-20C1>..<reserved-20CF>
+	{0x20C1, 0x20C1, prPR, gcSc},     //         SAUDI RIYAL SIGN
+	{0x20C2, 0x20CF, prPR, gcCn},     //    [14] <reserved-20C2>..<reserved-20CF>
 	{0x20D0, 0x20DC, prCM, gcMn},     //    [13] COMBINING LEFT HARPOON ABOVE..COMBINING FOUR DOTS ABOVE
 	{0x20DD, 0x20E0, prCM, gcMe},     //     [4] COMBINING ENCLOSING CIRCLE..COMBINING ENCLOSING CIRCLE BACKSLASH
 	{0x20E1, 0x20E1, prCM, gcMn},     //         COMBINING LEFT RIGHT ARROW ABOVE
@@ -975,12 +977,7 @@ var lineBreakCodePoints = [][4]int{
 	{0x214C, 0x214D, prAL, gcSo},     //     [2] PER SIGN..AKTIESELSKAB
 	{0x214E, 0x214E, prAL, gcLl},     //         TURNED SMALL F
 	{0x214F, 0x214F, prAL, gcSo},     //         SYMBOL FOR SAMARITAN SOURCE
-	{0x2150, 0x2153, prAL, gcNo},     //     [4] VULGAR FRACTION ONE SEVENTH..VULGAR FRACTION ONE THIRD
-	{0x2154, 0x2155, prAI, gcNo},     //     [2] VULGAR FRACTION TWO THIRDS..VULGAR FRACTION ONE FIFTH
-	{0x2156, 0x215A, prAL, gcNo},     //     [5] VULGAR FRACTION TWO FIFTHS..VULGAR FRACTION FIVE SIXTHS
-	{0x215B, 0x215B, prAI, gcNo},     //         VULGAR FRACTION ONE EIGHTH
-	{0x215C, 0x215D, prAL, gcNo},     //     [2] VULGAR FRACTION THREE EIGHTHS..VULGAR FRACTION FIVE EIGHTHS
-	{0x215E, 0x215E, prAI, gcNo},     //         VULGAR FRACTION SEVEN EIGHTHS
+	{0x2150, 0x215E, prAI, gcNo},     //    [15] VULGAR FRACTION ONE SEVENTH..VULGAR FRACTION SEVEN EIGHTHS
 	{0x215F, 0x215F, prAL, gcNo},     //         FRACTION NUMERATOR ONE
 	{0x2160, 0x216B, prAI, gcNl},     //    [12] ROMAN NUMERAL ONE..ROMAN NUMERAL TWELVE
 	{0x216C, 0x216F, prAL, gcNl},     //     [4] ROMAN NUMERAL FIFTY..ROMAN NUMERAL ONE THOUSAND
@@ -1091,7 +1088,7 @@ var lineBreakCodePoints = [][4]int{
 	{0x23E2, 0x23EF, prAL, gcSo},     //    [14] WHITE TRAPEZIUM..BLACK RIGHT-POINTING TRIANGLE WITH DOUBLE VERTICAL BAR
 	{0x23F0, 0x23F3, prID, gcSo},     //     [4] ALARM CLOCK..HOURGLASS WITH FLOWING SAND
 	{0x23F4, 0x23FF, prAL, gcSo},     //    [12] BLACK MEDIUM LEFT-POINTING TRIANGLE..OBSERVER EYE SYMBOL
-	{0x2400, 0x2426, prAL, gcSo},     //    [39] SYMBOL FOR NULL..SYMBOL FOR SUBSTITUTE FORM TWO
+	{0x2400, 0x2429, prAL, gcSo},     //    [42] SYMBOL FOR NULL..SYMBOL FOR DELETE MEDIUM SHADE FORM
 	{0x2440, 0x244A, prAL, gcSo},     //    [11] OCR HOOK..OCR DOUBLE BACKSLASH
 	{0x2460, 0x249B, prAI, gcNo},     //    [60] CIRCLED DIGIT ONE..NUMBER TWENTY FULL STOP
 	{0x249C, 0x24E9, prAI, gcSo},     //    [78] PARENTHESIZED LATIN SMALL LETTER A..CIRCLED LATIN SMALL LETTER Z
@@ -1237,7 +1234,8 @@ var lineBreakCodePoints = [][4]int{
 	{0x27EE, 0x27EE, prOP, gcPs},     //         MATHEMATICAL LEFT FLATTENED PARENTHESIS
 	{0x27EF, 0x27EF, prCL, gcPe},     //         MATHEMATICAL RIGHT FLATTENED PARENTHESIS
 	{0x27F0, 0x27FF, prAL, gcSm},     //    [16] UPWARDS QUADRUPLE ARROW..LONG RIGHTWARDS SQUIGGLE ARROW
-	{0x2800, 0x28FF, prAL, gcSo},     //   [256] BRAILLE PATTERN BLANK..BRAILLE PATTERN DOTS-12345678
+	{0x2800, 0x2800, prBA, gcSo},     //         BRAILLE PATTERN BLANK
+	{0x2801, 0x28FF, prAL, gcSo},     //   [255] BRAILLE PATTERN DOTS-1..BRAILLE PATTERN DOTS-12345678
 	{0x2900, 0x297F, prAL, gcSm},     //   [128] RIGHTWARDS TWO-HEADED ARROW WITH VERTICAL STROKE..DOWN FISH TAIL
 	{0x2980, 0x2982, prAL, gcSm},     //     [3] TRIPLE VERTICAL BAR DELIMITER..Z NOTATION TYPE COLON
 	{0x2983, 0x2983, prOP, gcPs},     //         LEFT WHITE CURLY BRACKET
@@ -1279,8 +1277,7 @@ var lineBreakCodePoints = [][4]int{
 	{0x2B4D, 0x2B54, prAL, gcSo},     //     [8] DOWNWARDS TRIANGLE-HEADED ZIGZAG ARROW..WHITE RIGHT-POINTING PENTAGON
 	{0x2B55, 0x2B59, prAI, gcSo},     //     [5] HEAVY LARGE CIRCLE..HEAVY CIRCLED SALTIRE
 	{0x2B5A, 0x2B73, prAL, gcSo},     //    [26] SLANTED NORTH ARROW WITH HOOKED HEAD..DOWNWARDS TRIANGLE-HEADED ARROW TO BAR
-	{0x2B76, 0x2B95, prAL, gcSo},     //    [32] NORTH WEST TRIANGLE-HEADED ARROW TO BAR..RIGHTWARDS BLACK ARROW
-	{0x2B97, 0x2BFF, prAL, gcSo},     //   [105] SYMBOL FOR TYPE A ELECTRONICS..HELLSCHREIBER PAUSE SYMBOL
+	{0x2B76, 0x2BFF, prAL, gcSo},     //   [138] NORTH WEST TRIANGLE-HEADED ARROW TO BAR..HELLSCHREIBER PAUSE SYMBOL
 	{0x2C00, 0x2C5F, prAL, gcLC},     //    [96] GLAGOLITIC CAPITAL LETTER AZU..GLAGOLITIC SMALL LETTER CAUDATE CHRIVI
 	{0x2C60, 0x2C7B, prAL, gcLC},     //    [28] LATIN CAPITAL LETTER L WITH DOUBLE BAR..LATIN LETTER SMALL CAPITAL TURNED E
 	{0x2C7C, 0x2C7D, prAL, gcLm},     //     [2] LATIN SUBSCRIPT SMALL LETTER J..MODIFIER LETTER CAPITAL V
@@ -1325,7 +1322,7 @@ var lineBreakCodePoints = [][4]int{
 	{0x2E0D, 0x2E0D, prQU, gcPf},     //         RIGHT RAISED OMISSION BRACKET
 	{0x2E0E, 0x2E15, prBA, gcPo},     //     [8] EDITORIAL CORONIS..UPWARDS ANCORA
 	{0x2E16, 0x2E16, prAL, gcPo},     //         DOTTED RIGHT-POINTING ANGLE
-	{0x2E17, 0x2E17, prBA, gcPd},     //         DOUBLE OBLIQUE HYPHEN
+	{0x2E17, 0x2E17, prHH, gcPd},     //         DOUBLE OBLIQUE HYPHEN
 	{0x2E18, 0x2E18, prOP, gcPo},     //         INVERTED INTERROBANG
 	{0x2E19, 0x2E19, prBA, gcPo},     //         PALM BRANCH
 	{0x2E1A, 0x2E1A, prAL, gcPd},     //         HYPHEN WITH DIAERESIS
@@ -1353,7 +1350,7 @@ var lineBreakCodePoints = [][4]int{
 	{0x2E3A, 0x2E3B, prB2, gcPd},     //     [2] TWO-EM DASH..THREE-EM DASH
 	{0x2E3C, 0x2E3E, prBA, gcPo},     //     [3] STENOGRAPHIC FULL STOP..WIGGLY VERTICAL LINE
 	{0x2E3F, 0x2E3F, prAL, gcPo},     //         CAPITULUM
-	{0x2E40, 0x2E40, prBA, gcPd},     //         DOUBLE HYPHEN
+	{0x2E40, 0x2E40, prHH, gcPd},     //         DOUBLE HYPHEN
 	{0x2E41, 0x2E41, prBA, gcPo},     //         REVERSED COMMA
 	{0x2E42, 0x2E42, prOP, gcPs},     //         DOUBLE LOW-REVERSED-9 QUOTATION MARK
 	{0x2E43, 0x2E4A, prBA, gcPo},     //     [8] DASH WITH LEFT UPTURN..DOTTED SOLIDUS
@@ -1365,18 +1362,18 @@ var lineBreakCodePoints = [][4]int{
 	{0x2E52, 0x2E52, prAL, gcPo},     //         TIRONIAN SIGN CAPITAL ET
 	{0x2E53, 0x2E54, prEX, gcPo},     //     [2] MEDIEVAL EXCLAMATION MARK..MEDIEVAL QUESTION MARK
 	{0x2E55, 0x2E55, prOP, gcPs},     //         LEFT SQUARE BRACKET WITH STROKE
-	{0x2E56, 0x2E56, prCL, gcPe},     //         RIGHT SQUARE BRACKET WITH STROKE
+	{0x2E56, 0x2E56, prCP, gcPe},     //         RIGHT SQUARE BRACKET WITH STROKE
 	{0x2E57, 0x2E57, prOP, gcPs},     //         LEFT SQUARE BRACKET WITH DOUBLE STROKE
-	{0x2E58, 0x2E58, prCL, gcPe},     //         RIGHT SQUARE BRACKET WITH DOUBLE STROKE
+	{0x2E58, 0x2E58, prCP, gcPe},     //         RIGHT SQUARE BRACKET WITH DOUBLE STROKE
 	{0x2E59, 0x2E59, prOP, gcPs},     //         TOP HALF LEFT PARENTHESIS
-	{0x2E5A, 0x2E5A, prCL, gcPe},     //         TOP HALF RIGHT PARENTHESIS
+	{0x2E5A, 0x2E5A, prCP, gcPe},     //         TOP HALF RIGHT PARENTHESIS
 	{0x2E5B, 0x2E5B, prOP, gcPs},     //         BOTTOM HALF LEFT PARENTHESIS
-	{0x2E5C, 0x2E5C, prCL, gcPe},     //         BOTTOM HALF RIGHT PARENTHESIS
-	{0x2E5D, 0x2E5D, prBA, gcPd},     //         OBLIQUE HYPHEN
+	{0x2E5C, 0x2E5C, prCP, gcPe},     //         BOTTOM HALF RIGHT PARENTHESIS
+	{0x2E5D, 0x2E5D, prHH, gcPd},     //         OBLIQUE HYPHEN
 	{0x2E80, 0x2E99, prID, gcSo},     //    [26] CJK RADICAL REPEAT..CJK RADICAL RAP
 	{0x2E9B, 0x2EF3, prID, gcSo},     //    [89] CJK RADICAL CHOKE..CJK RADICAL C-SIMPLIFIED TURTLE
 	{0x2F00, 0x2FD5, prID, gcSo},     //   [214] KANGXI RADICAL ONE..KANGXI RADICAL FLUTE
-	{0x2FF0, 0x2FFB, prID, gcSo},     //    [12] IDEOGRAPHIC DESCRIPTION CHARACTER LEFT TO RIGHT..IDEOGRAPHIC DESCRIPTION CHARACTER OVERLAID
+	{0x2FF0, 0x2FFF, prID, gcSo},     //    [16] IDEOGRAPHIC DESCRIPTION CHARACTER LEFT TO RIGHT..IDEOGRAPHIC DESCRIPTION CHARACTER ROTATION
 	{0x3000, 0x3000, prBA, gcZs},     //         IDEOGRAPHIC SPACE
 	{0x3001, 0x3002, prCL, gcPo},     //     [2] IDEOGRAPHIC COMMA..IDEOGRAPHIC FULL STOP
 	{0x3003, 0x3003, prID, gcPo},     //         DITTO MARK
@@ -1477,7 +1474,8 @@ var lineBreakCodePoints = [][4]int{
 	{0x3192, 0x3195, prID, gcNo},     //     [4] IDEOGRAPHIC ANNOTATION ONE MARK..IDEOGRAPHIC ANNOTATION FOUR MARK
 	{0x3196, 0x319F, prID, gcSo},     //    [10] IDEOGRAPHIC ANNOTATION TOP MARK..IDEOGRAPHIC ANNOTATION MAN MARK
 	{0x31A0, 0x31BF, prID, gcLo},     //    [32] BOPOMOFO LETTER BU..BOPOMOFO LETTER AH
-	{0x31C0, 0x31E3, prID, gcSo},     //    [36] CJK STROKE T..CJK STROKE Q
+	{0x31C0, 0x31E5, prID, gcSo},     //    [38] CJK STROKE T..CJK STROKE SZP
+	{0x31EF, 0x31EF, prID, gcSo},     //         IDEOGRAPHIC DESCRIPTION CHARACTER SUBTRACTION
 	{0x31F0, 0x31FF, prCJ, gcLo},     //    [16] KATAKANA LETTER SMALL KU..KATAKANA LETTER SMALL RO
 	{0x3200, 0x321E, prID, gcSo},     //    [31] PARENTHESIZED HANGUL KIYEOK..PARENTHESIZED KOREAN CHARACTER O HU
 	{0x3220, 0x3229, prID, gcNo},     //    [10] PARENTHESIZED IDEOGRAPH ONE..PARENTHESIZED IDEOGRAPH TEN
@@ -1535,11 +1533,8 @@ var lineBreakCodePoints = [][4]int{
 	{0xA789, 0xA78A, prAL, gcSk},     //     [2] MODIFIER LETTER COLON..MODIFIER LETTER SHORT EQUALS SIGN
 	{0xA78B, 0xA78E, prAL, gcLC},     //     [4] LATIN CAPITAL LETTER SALTILLO..LATIN SMALL LETTER L WITH RETROFLEX HOOK AND BELT
 	{0xA78F, 0xA78F, prAL, gcLo},     //         LATIN LETTER SINOLOGICAL DOT
-	{0xA790, 0xA7CA, prAL, gcLC},     //    [59] LATIN CAPITAL LETTER N WITH DESCENDER..LATIN SMALL LETTER S WITH SHORT STROKE OVERLAY
-	{0xA7D0, 0xA7D1, prAL, gcLC},     //     [2] LATIN CAPITAL LETTER CLOSED INSULAR G..LATIN SMALL LETTER CLOSED INSULAR G
-	{0xA7D3, 0xA7D3, prAL, gcLl},     //         LATIN SMALL LETTER DOUBLE THORN
-	{0xA7D5, 0xA7D9, prAL, gcLC},     //     [5] LATIN SMALL LETTER DOUBLE WYNN..LATIN SMALL LETTER SIGMOID S
-	{0xA7F2, 0xA7F4, prAL, gcLm},     //     [3] MODIFIER LETTER CAPITAL C..MODIFIER LETTER CAPITAL Q
+	{0xA790, 0xA7DC, prAL, gcLC},     //    [77] LATIN CAPITAL LETTER N WITH DESCENDER..LATIN CAPITAL LETTER LAMBDA WITH STROKE
+	{0xA7F1, 0xA7F4, prAL, gcLm},     //     [4] MODIFIER LETTER CAPITAL S..MODIFIER LETTER CAPITAL Q
 	{0xA7F5, 0xA7F6, prAL, gcLC},     //     [2] LATIN CAPITAL LETTER REVERSED HALF H..LATIN SMALL LETTER REVERSED HALF H
 	{0xA7F7, 0xA7F7, prAL, gcLo},     //         LATIN EPIGRAPHIC LETTER SIDEWAYS I
 	{0xA7F8, 0xA7F9, prAL, gcLm},     //     [2] MODIFIER LETTER CAPITAL H WITH STROKE..MODIFIER LETTER SMALL LIGATURE OE
@@ -1588,38 +1583,39 @@ var lineBreakCodePoints = [][4]int{
 	{0xA960, 0xA97C, prJL, gcLo},     //    [29] HANGUL CHOSEONG TIKEUT-MIEUM..HANGUL CHOSEONG SSANGYEORINHIEUH
 	{0xA980, 0xA982, prCM, gcMn},     //     [3] JAVANESE SIGN PANYANGGA..JAVANESE SIGN LAYAR
 	{0xA983, 0xA983, prCM, gcMc},     //         JAVANESE SIGN WIGNYAN
-	{0xA984, 0xA9B2, prAL, gcLo},     //    [47] JAVANESE LETTER A..JAVANESE LETTER HA
+	{0xA984, 0xA9B2, prAK, gcLo},     //    [47] JAVANESE LETTER A..JAVANESE LETTER HA
 	{0xA9B3, 0xA9B3, prCM, gcMn},     //         JAVANESE SIGN CECAK TELU
 	{0xA9B4, 0xA9B5, prCM, gcMc},     //     [2] JAVANESE VOWEL SIGN TARUNG..JAVANESE VOWEL SIGN TOLONG
 	{0xA9B6, 0xA9B9, prCM, gcMn},     //     [4] JAVANESE VOWEL SIGN WULU..JAVANESE VOWEL SIGN SUKU MENDUT
 	{0xA9BA, 0xA9BB, prCM, gcMc},     //     [2] JAVANESE VOWEL SIGN TALING..JAVANESE VOWEL SIGN DIRGA MURE
 	{0xA9BC, 0xA9BD, prCM, gcMn},     //     [2] JAVANESE VOWEL SIGN PEPET..JAVANESE CONSONANT SIGN KERET
-	{0xA9BE, 0xA9C0, prCM, gcMc},     //     [3] JAVANESE CONSONANT SIGN PENGKAL..JAVANESE PANGKON
-	{0xA9C1, 0xA9C6, prAL, gcPo},     //     [6] JAVANESE LEFT RERENGGAN..JAVANESE PADA WINDU
+	{0xA9BE, 0xA9BF, prCM, gcMc},     //     [2] JAVANESE CONSONANT SIGN PENGKAL..JAVANESE CONSONANT SIGN CAKRA
+	{0xA9C0, 0xA9C0, prVI, gcMc},     //         JAVANESE PANGKON
+	{0xA9C1, 0xA9C6, prID, gcPo},     //     [6] JAVANESE LEFT RERENGGAN..JAVANESE PADA WINDU
 	{0xA9C7, 0xA9C9, prBA, gcPo},     //     [3] JAVANESE PADA PANGKAT..JAVANESE PADA LUNGSI
-	{0xA9CA, 0xA9CD, prAL, gcPo},     //     [4] JAVANESE PADA ADEG..JAVANESE TURNED PADA PISELEH
-	{0xA9CF, 0xA9CF, prAL, gcLm},     //         JAVANESE PANGRANGKEP
-	{0xA9D0, 0xA9D9, prNU, gcNd},     //    [10] JAVANESE DIGIT ZERO..JAVANESE DIGIT NINE
-	{0xA9DE, 0xA9DF, prAL, gcPo},     //     [2] JAVANESE PADA TIRTA TUMETES..JAVANESE PADA ISEN-ISEN
+	{0xA9CA, 0xA9CD, prID, gcPo},     //     [4] JAVANESE PADA ADEG..JAVANESE TURNED PADA PISELEH
+	{0xA9CF, 0xA9CF, prBA, gcLm},     //         JAVANESE PANGRANGKEP
+	{0xA9D0, 0xA9D9, prAS, gcNd},     //    [10] JAVANESE DIGIT ZERO..JAVANESE DIGIT NINE
+	{0xA9DE, 0xA9DF, prID, gcPo},     //     [2] JAVANESE PADA TIRTA TUMETES..JAVANESE PADA ISEN-ISEN
 	{0xA9E0, 0xA9E4, prSA, gcLo},     //     [5] MYANMAR LETTER SHAN GHA..MYANMAR LETTER SHAN BHA
 	{0xA9E5, 0xA9E5, prSA, gcMn},     //         MYANMAR SIGN SHAN SAW
 	{0xA9E6, 0xA9E6, prSA, gcLm},     //         MYANMAR MODIFIER LETTER SHAN REDUPLICATION
 	{0xA9E7, 0xA9EF, prSA, gcLo},     //     [9] MYANMAR LETTER TAI LAING NYA..MYANMAR LETTER TAI LAING NNA
 	{0xA9F0, 0xA9F9, prNU, gcNd},     //    [10] MYANMAR TAI LAING DIGIT ZERO..MYANMAR TAI LAING DIGIT NINE
 	{0xA9FA, 0xA9FE, prSA, gcLo},     //     [5] MYANMAR LETTER TAI LAING LLA..MYANMAR LETTER TAI LAING BHA
-	{0xAA00, 0xAA28, prAL, gcLo},     //    [41] CHAM LETTER A..CHAM LETTER HA
+	{0xAA00, 0xAA28, prAS, gcLo},     //    [41] CHAM LETTER A..CHAM LETTER HA
 	{0xAA29, 0xAA2E, prCM, gcMn},     //     [6] CHAM VOWEL SIGN AA..CHAM VOWEL SIGN OE
 	{0xAA2F, 0xAA30, prCM, gcMc},     //     [2] CHAM VOWEL SIGN O..CHAM VOWEL SIGN AI
 	{0xAA31, 0xAA32, prCM, gcMn},     //     [2] CHAM VOWEL SIGN AU..CHAM VOWEL SIGN UE
 	{0xAA33, 0xAA34, prCM, gcMc},     //     [2] CHAM CONSONANT SIGN YA..CHAM CONSONANT SIGN RA
 	{0xAA35, 0xAA36, prCM, gcMn},     //     [2] CHAM CONSONANT SIGN LA..CHAM CONSONANT SIGN WA
-	{0xAA40, 0xAA42, prAL, gcLo},     //     [3] CHAM LETTER FINAL K..CHAM LETTER FINAL NG
+	{0xAA40, 0xAA42, prBA, gcLo},     //     [3] CHAM LETTER FINAL K..CHAM LETTER FINAL NG
 	{0xAA43, 0xAA43, prCM, gcMn},     //         CHAM CONSONANT SIGN FINAL NG
-	{0xAA44, 0xAA4B, prAL, gcLo},     //     [8] CHAM LETTER FINAL CH..CHAM LETTER FINAL SS
+	{0xAA44, 0xAA4B, prBA, gcLo},     //     [8] CHAM LETTER FINAL CH..CHAM LETTER FINAL SS
 	{0xAA4C, 0xAA4C, prCM, gcMn},     //         CHAM CONSONANT SIGN FINAL M
 	{0xAA4D, 0xAA4D, prCM, gcMc},     //         CHAM CONSONANT SIGN FINAL H
-	{0xAA50, 0xAA59, prNU, gcNd},     //    [10] CHAM DIGIT ZERO..CHAM DIGIT NINE
-	{0xAA5C, 0xAA5C, prAL, gcPo},     //         CHAM PUNCTUATION SPIRAL
+	{0xAA50, 0xAA59, prAS, gcNd},     //    [10] CHAM DIGIT ZERO..CHAM DIGIT NINE
+	{0xAA5C, 0xAA5C, prID, gcPo},     //         CHAM PUNCTUATION SPIRAL
 	{0xAA5D, 0xAA5F, prBA, gcPo},     //     [3] CHAM PUNCTUATION DANDA..CHAM PUNCTUATION TRIPLE DANDA
 	{0xAA60, 0xAA6F, prSA, gcLo},     //    [16] MYANMAR LETTER KHAMTI GA..MYANMAR LETTER KHAMTI FA
 	{0xAA70, 0xAA70, prSA, gcLm},     //         MYANMAR MODIFIER LETTER KHAMTI REDUPLICATION
@@ -2497,25 +2493,39 @@ var lineBreakCodePoints = [][4]int{
 	{0xFB46, 0xFB4F, prHL, gcLo},     //    [10] HEBREW LETTER TSADI WITH DAGESH..HEBREW LIGATURE ALEF LAMED
 	{0xFB50, 0xFBB1, prAL, gcLo},     //    [98] ARABIC LETTER ALEF WASLA ISOLATED FORM..ARABIC LETTER YEH BARREE WITH HAMZA ABOVE FINAL FORM
 	{0xFBB2, 0xFBC2, prAL, gcSk},     //    [17] ARABIC SYMBOL DOT ABOVE..ARABIC SYMBOL WASLA ABOVE
+	{0xFBC3, 0xFBD2, prAL, gcSo},     //    [16] ARABIC LIGATURE JALLA WA-ALAA..ARABIC LIGATURE ALAYHI AR-RAHMAH
 	{0xFBD3, 0xFD3D, prAL, gcLo},     //   [363] ARABIC LETTER NG ISOLATED FORM..ARABIC LIGATURE ALEF WITH FATHATAN ISOLATED FORM
 	{0xFD3E, 0xFD3E, prCL, gcPe},     //         ORNATE LEFT PARENTHESIS
 	{0xFD3F, 0xFD3F, prOP, gcPs},     //         ORNATE RIGHT PARENTHESIS
 	{0xFD40, 0xFD4F, prAL, gcSo},     //    [16] ARABIC LIGATURE RAHIMAHU ALLAAH..ARABIC LIGATURE RAHIMAHUM ALLAAH
 	{0xFD50, 0xFD8F, prAL, gcLo},     //    [64] ARABIC LIGATURE TEH WITH JEEM WITH MEEM INITIAL FORM..ARABIC LIGATURE MEEM WITH KHAH WITH MEEM INITIAL FORM
+	{0xFD90, 0xFD91, prAL, gcSo},     //     [2] ARABIC LIGATURE RAHMATU ALLAAHI ALAYH..ARABIC LIGATURE RAHMATU ALLAAHI ALAYHAA
 	{0xFD92, 0xFDC7, prAL, gcLo},     //    [54] ARABIC LIGATURE MEEM WITH JEEM WITH KHAH INITIAL FORM..ARABIC LIGATURE NOON WITH JEEM WITH YEH FINAL FORM
-	{0xFDCF, 0xFDCF, prAL, gcSo},     //         ARABIC LIGATURE SALAAMUHU ALAYNAA
+	{0xFDC8, 0xFDCF, prAL, gcSo},     //     [8] ARABIC LIGATURE RAHIMAHU ALLAAH TAAALAA..ARABIC LIGATURE SALAAMUHU ALAYNAA
 	{0xFDF0, 0xFDFB, prAL, gcLo},     //    [12] ARABIC LIGATURE SALLA USED AS KORANIC STOP SIGN ISOLATED FORM..ARABIC LIGATURE JALLAJALALOUHOU
 	{0xFDFC, 0xFDFC, prPO, gcSc},     //         RIAL SIGN
 	{0xFDFD, 0xFDFF, prAL, gcSo},     //     [3] ARABIC LIGATURE BISMILLAH AR-RAHMAN AR-RAHEEM..ARABIC LIGATURE AZZA WA JALL
 	{0xFE00, 0xFE0F, prCM, gcMn},     //    [16] VARIATION SELECTOR-1..VARIATION SELECTOR-16
-	{0xFE10, 0xFE10, prIS, gcPo},     //         PRESENTATION FORM FOR VERTICAL COMMA
-	{0xFE11, 0xFE12, prCL, gcPo},     //     [2] PRESENTATION FORM FOR VERTICAL IDEOGRAPHIC COMMA..PRESENTATION FORM FOR VERTICAL IDEOGRAPHIC FULL STOP
-	{0xFE13, 0xFE14, prIS, gcPo},     //     [2] PRESENTATION FORM FOR VERTICAL COLON..PRESENTATION FORM FOR VERTICAL SEMICOLON
+	{0xFE10, 0xFE12, prCL, gcPo},     //     [3] PRESENTATION FORM FOR VERTICAL COMMA..PRESENTATION FORM FOR VERTICAL IDEOGRAPHIC FULL STOP
+	{0xFE13, 0xFE14, prNS, gcPo},     //     [2] PRESENTATION FORM FOR VERTICAL COLON..PRESENTATION FORM FOR VERTICAL SEMICOLON
 	{0xFE15, 0xFE16, prEX, gcPo},     //     [2] PRESENTATION FORM FOR VERTICAL EXCLAMATION MARK..PRESENTATION FORM FOR VERTICAL QUESTION MARK
 	{0xFE17, 0xFE17, prOP, gcPs},     //         PRESENTATION FORM FOR VERTICAL LEFT WHITE LENTICULAR BRACKET
 	{0xFE18, 0xFE18, prCL, gcPe},     //         PRESENTATION FORM FOR VERTICAL RIGHT WHITE LENTICULAR BRAKCET
 	{0xFE19, 0xFE19, prIN, gcPo},     //         PRESENTATION FORM FOR VERTICAL HORIZONTAL ELLIPSIS
-	{0xFE20, 0xFE2F, prCM, gcMn},     //    [16] COMBINING LIGATURE LEFT HALF..COMBINING CYRILLIC TITLO RIGHT HALF
+	{0xFE20, 0xFE20, prGL, gcMn},     //         COMBINING LIGATURE LEFT HALF
+	{0xFE21, 0xFE21, prCM, gcMn},     //         COMBINING LIGATURE RIGHT HALF
+	{0xFE22, 0xFE22, prGL, gcMn},     //         COMBINING DOUBLE TILDE LEFT HALF
+	{0xFE23, 0xFE23, prCM, gcMn},     //         COMBINING DOUBLE TILDE RIGHT HALF
+	{0xFE24, 0xFE24, prGL, gcMn},     //         COMBINING MACRON LEFT HALF
+	{0xFE25, 0xFE25, prCM, gcMn},     //         COMBINING MACRON RIGHT HALF
+	{0xFE26, 0xFE27, prGL, gcMn},     //     [2] COMBINING CONJOINING MACRON..COMBINING LIGATURE LEFT HALF BELOW
+	{0xFE28, 0xFE28, prCM, gcMn},     //         COMBINING LIGATURE RIGHT HALF BELOW
+	{0xFE29, 0xFE29, prGL, gcMn},     //         COMBINING TILDE LEFT HALF BELOW
+	{0xFE2A, 0xFE2A, prCM, gcMn},     //         COMBINING TILDE RIGHT HALF BELOW
+	{0xFE2B, 0xFE2B, prGL, gcMn},     //         COMBINING MACRON LEFT HALF BELOW
+	{0xFE2C, 0xFE2C, prCM, gcMn},     //         COMBINING MACRON RIGHT HALF BELOW
+	{0xFE2D, 0xFE2E, prGL, gcMn},     //     [2] COMBINING CONJOINING MACRON BELOW..COMBINING CYRILLIC TITLO LEFT HALF
+	{0xFE2F, 0xFE2F, prCM, gcMn},     //         COMBINING CYRILLIC TITLO RIGHT HALF
 	{0xFE30, 0xFE30, prID, gcPo},     //         PRESENTATION FORM FOR VERTICAL TWO DOT LEADER
 	{0xFE31, 0xFE32, prID, gcPd},     //     [2] PRESENTATION FORM FOR VERTICAL EM DASH..PRESENTATION FORM FOR VERTICAL EN DASH
 	{0xFE33, 0xFE34, prID, gcPc},     //     [2] PRESENTATION FORM FOR VERTICAL LOW LINE..PRESENTATION FORM FOR VERTICAL WAVY LOW LINE
@@ -2677,6 +2687,7 @@ var lineBreakCodePoints = [][4]int{
 	{0x105A3, 0x105B1, prAL, gcLl},   //    [15] VITHKUQI SMALL LETTER HA..VITHKUQI SMALL LETTER RE
 	{0x105B3, 0x105B9, prAL, gcLl},   //     [7] VITHKUQI SMALL LETTER SE..VITHKUQI SMALL LETTER XE
 	{0x105BB, 0x105BC, prAL, gcLl},   //     [2] VITHKUQI SMALL LETTER Y..VITHKUQI SMALL LETTER ZE
+	{0x105C0, 0x105F3, prAL, gcLo},   //    [52] TODHRI LETTER A..TODHRI LETTER OO
 	{0x10600, 0x10736, prAL, gcLo},   //   [311] LINEAR A SIGN AB001..LINEAR A SIGN A664
 	{0x10740, 0x10755, prAL, gcLo},   //    [22] LINEAR A SIGN A701 A..LINEAR A SIGN A732 JE
 	{0x10760, 0x10767, prAL, gcLo},   //     [8] LINEAR A SIGN A800..LINEAR A SIGN A807
@@ -2705,6 +2716,7 @@ var lineBreakCodePoints = [][4]int{
 	{0x1091F, 0x1091F, prBA, gcPo},   //         PHOENICIAN WORD SEPARATOR
 	{0x10920, 0x10939, prAL, gcLo},   //    [26] LYDIAN LETTER A..LYDIAN LETTER C
 	{0x1093F, 0x1093F, prAL, gcPo},   //         LYDIAN TRIANGULAR MARK
+	{0x10940, 0x10959, prAL, gcLo},   //    [26] SIDETIC LETTER N01..SIDETIC LETTER N26
 	{0x10980, 0x1099F, prAL, gcLo},   //    [32] MEROITIC HIEROGLYPHIC LETTER A..MEROITIC HIEROGLYPHIC SYMBOL VIDJ-2
 	{0x109A0, 0x109B7, prAL, gcLo},   //    [24] MEROITIC CURSIVE LETTER A..MEROITIC CURSIVE LETTER DA
 	{0x109BC, 0x109BD, prAL, gcNo},   //     [2] MEROITIC CURSIVE FRACTION ELEVEN TWELFTHS..MEROITIC CURSIVE FRACTION ONE HALF
@@ -2751,12 +2763,27 @@ var lineBreakCodePoints = [][4]int{
 	{0x10D00, 0x10D23, prAL, gcLo},   //    [36] HANIFI ROHINGYA LETTER A..HANIFI ROHINGYA MARK NA KHONNA
 	{0x10D24, 0x10D27, prCM, gcMn},   //     [4] HANIFI ROHINGYA SIGN HARBAHAY..HANIFI ROHINGYA SIGN TASSI
 	{0x10D30, 0x10D39, prNU, gcNd},   //    [10] HANIFI ROHINGYA DIGIT ZERO..HANIFI ROHINGYA DIGIT NINE
+	{0x10D40, 0x10D49, prNU, gcNd},   //    [10] GARAY DIGIT ZERO..GARAY DIGIT NINE
+	{0x10D4A, 0x10D4D, prAL, gcLo},   //     [4] GARAY VOWEL SIGN A..GARAY VOWEL SIGN EE
+	{0x10D4E, 0x10D4E, prAL, gcLm},   //         GARAY VOWEL LENGTH MARK
+	{0x10D4F, 0x10D4F, prAL, gcLo},   //         GARAY SUKUN
+	{0x10D50, 0x10D65, prAL, gcLu},   //    [22] GARAY CAPITAL LETTER A..GARAY CAPITAL LETTER OLD NA
+	{0x10D69, 0x10D6D, prCM, gcMn},   //     [5] GARAY VOWEL SIGN E..GARAY CONSONANT NASALIZATION MARK
+	{0x10D6E, 0x10D6E, prHH, gcPd},   //         GARAY HYPHEN
+	{0x10D6F, 0x10D6F, prAL, gcLm},   //         GARAY REDUPLICATION MARK
+	{0x10D70, 0x10D85, prAL, gcLl},   //    [22] GARAY SMALL LETTER A..GARAY SMALL LETTER OLD NA
+	{0x10D8E, 0x10D8F, prAL, gcSm},   //     [2] GARAY PLUS SIGN..GARAY MINUS SIGN
 	{0x10E60, 0x10E7E, prAL, gcNo},   //    [31] RUMI DIGIT ONE..RUMI FRACTION TWO THIRDS
 	{0x10E80, 0x10EA9, prAL, gcLo},   //    [42] YEZIDI LETTER ELIF..YEZIDI LETTER ET
 	{0x10EAB, 0x10EAC, prCM, gcMn},   //     [2] YEZIDI COMBINING HAMZA MARK..YEZIDI COMBINING MADDA MARK
-	{0x10EAD, 0x10EAD, prBA, gcPd},   //         YEZIDI HYPHENATION MARK
+	{0x10EAD, 0x10EAD, prHH, gcPd},   //         YEZIDI HYPHENATION MARK
 	{0x10EB0, 0x10EB1, prAL, gcLo},   //     [2] YEZIDI LETTER LAM WITH DOT ABOVE..YEZIDI LETTER YOT WITH CIRCUMFLEX ABOVE
-	{0x10EFD, 0x10EFF, prCM, gcMn},   //     [3] ARABIC SMALL LOW WORD SAKTA..ARABIC SMALL LOW WORD MADDA
+	{0x10EC2, 0x10EC4, prAL, gcLo},   //     [3] ARABIC LETTER DAL WITH TWO DOTS VERTICALLY BELOW..ARABIC LETTER KAF WITH TWO DOTS VERTICALLY BELOW
+	{0x10EC5, 0x10EC5, prAL, gcLm},   //         ARABIC SMALL YEH BARREE WITH TWO DOTS BELOW
+	{0x10EC6, 0x10EC7, prAL, gcLo},   //     [2] ARABIC LETTER THIN NOON..ARABIC LETTER YEH WITH FOUR DOTS BELOW
+	{0x10ED0, 0x10ED0, prBA, gcPo},   //         ARABIC BIBLICAL END OF VERSE
+	{0x10ED1, 0x10ED8, prAL, gcSo},   //     [8] ARABIC LIGATURE ALAYHAA AS-SALAATU WAS-SALAAM..ARABIC LIGATURE NAWWARA ALLAAHU MARQADAH
+	{0x10EFA, 0x10EFF, prCM, gcMn},   //     [6] ARABIC DOUBLE VERTICAL BAR BELOW..ARABIC SMALL LOW WORD MADDA
 	{0x10F00, 0x10F1C, prAL, gcLo},   //    [29] OLD SOGDIAN LETTER ALEPH..OLD SOGDIAN LETTER FINAL TAW WITH VERTICAL TAIL
 	{0x10F1D, 0x10F26, prAL, gcNo},   //    [10] OLD SOGDIAN NUMBER ONE..OLD SOGDIAN FRACTION ONE HALF
 	{0x10F27, 0x10F27, prAL, gcLo},   //         OLD SOGDIAN LIGATURE AYIN-DALETH
@@ -2773,17 +2800,19 @@ var lineBreakCodePoints = [][4]int{
 	{0x11000, 0x11000, prCM, gcMc},   //         BRAHMI SIGN CANDRABINDU
 	{0x11001, 0x11001, prCM, gcMn},   //         BRAHMI SIGN ANUSVARA
 	{0x11002, 0x11002, prCM, gcMc},   //         BRAHMI SIGN VISARGA
-	{0x11003, 0x11037, prAL, gcLo},   //    [53] BRAHMI SIGN JIHVAMULIYA..BRAHMI LETTER OLD TAMIL NNNA
-	{0x11038, 0x11046, prCM, gcMn},   //    [15] BRAHMI VOWEL SIGN AA..BRAHMI VIRAMA
+	{0x11003, 0x11004, prAP, gcLo},   //     [2] BRAHMI SIGN JIHVAMULIYA..BRAHMI SIGN UPADHMANIYA
+	{0x11005, 0x11037, prAK, gcLo},   //    [51] BRAHMI LETTER A..BRAHMI LETTER OLD TAMIL NNNA
+	{0x11038, 0x11045, prCM, gcMn},   //    [14] BRAHMI VOWEL SIGN AA..BRAHMI VOWEL SIGN AU
+	{0x11046, 0x11046, prVI, gcMn},   //         BRAHMI VIRAMA
 	{0x11047, 0x11048, prBA, gcPo},   //     [2] BRAHMI DANDA..BRAHMI DOUBLE DANDA
-	{0x11049, 0x1104D, prAL, gcPo},   //     [5] BRAHMI PUNCTUATION DOT..BRAHMI PUNCTUATION LOTUS
-	{0x11052, 0x11065, prAL, gcNo},   //    [20] BRAHMI NUMBER ONE..BRAHMI NUMBER ONE THOUSAND
-	{0x11066, 0x1106F, prNU, gcNd},   //    [10] BRAHMI DIGIT ZERO..BRAHMI DIGIT NINE
+	{0x11049, 0x1104D, prID, gcPo},   //     [5] BRAHMI PUNCTUATION DOT..BRAHMI PUNCTUATION LOTUS
+	{0x11052, 0x11065, prID, gcNo},   //    [20] BRAHMI NUMBER ONE..BRAHMI NUMBER ONE THOUSAND
+	{0x11066, 0x1106F, prAS, gcNd},   //    [10] BRAHMI DIGIT ZERO..BRAHMI DIGIT NINE
 	{0x11070, 0x11070, prCM, gcMn},   //         BRAHMI SIGN OLD TAMIL VIRAMA
-	{0x11071, 0x11072, prAL, gcLo},   //     [2] BRAHMI LETTER OLD TAMIL SHORT E..BRAHMI LETTER OLD TAMIL SHORT O
+	{0x11071, 0x11072, prAK, gcLo},   //     [2] BRAHMI LETTER OLD TAMIL SHORT E..BRAHMI LETTER OLD TAMIL SHORT O
 	{0x11073, 0x11074, prCM, gcMn},   //     [2] BRAHMI VOWEL SIGN OLD TAMIL SHORT E..BRAHMI VOWEL SIGN OLD TAMIL SHORT O
-	{0x11075, 0x11075, prAL, gcLo},   //         BRAHMI LETTER OLD TAMIL LLA
-	{0x1107F, 0x1107F, prCM, gcMn},   //         BRAHMI NUMBER JOINER
+	{0x11075, 0x11075, prAK, gcLo},   //         BRAHMI LETTER OLD TAMIL LLA
+	{0x1107F, 0x1107F, prGL, gcMn},   //         BRAHMI NUMBER JOINER
 	{0x11080, 0x11081, prCM, gcMn},   //     [2] KAITHI SIGN CANDRABINDU..KAITHI SIGN ANUSVARA
 	{0x11082, 0x11082, prCM, gcMc},   //         KAITHI SIGN VISARGA
 	{0x11083, 0x110AF, prAL, gcLo},   //    [45] KAITHI LETTER A..KAITHI LETTER HA
@@ -2792,10 +2821,10 @@ var lineBreakCodePoints = [][4]int{
 	{0x110B7, 0x110B8, prCM, gcMc},   //     [2] KAITHI VOWEL SIGN O..KAITHI VOWEL SIGN AU
 	{0x110B9, 0x110BA, prCM, gcMn},   //     [2] KAITHI SIGN VIRAMA..KAITHI SIGN NUKTA
 	{0x110BB, 0x110BC, prAL, gcPo},   //     [2] KAITHI ABBREVIATION SIGN..KAITHI ENUMERATION SIGN
-	{0x110BD, 0x110BD, prAL, gcCf},   //         KAITHI NUMBER SIGN
+	{0x110BD, 0x110BD, prNU, gcCf},   //         KAITHI NUMBER SIGN
 	{0x110BE, 0x110C1, prBA, gcPo},   //     [4] KAITHI SECTION MARK..KAITHI DOUBLE DANDA
 	{0x110C2, 0x110C2, prCM, gcMn},   //         KAITHI VOWEL SIGN VOCALIC R
-	{0x110CD, 0x110CD, prAL, gcCf},   //         KAITHI NUMBER SIGN ABOVE
+	{0x110CD, 0x110CD, prNU, gcCf},   //         KAITHI NUMBER SIGN ABOVE
 	{0x110D0, 0x110E8, prAL, gcLo},   //    [25] SORA SOMPENG LETTER SAH..SORA SOMPENG LETTER MAE
 	{0x110F0, 0x110F9, prNU, gcNd},   //    [10] SORA SOMPENG DIGIT ZERO..SORA SOMPENG DIGIT NINE
 	{0x11100, 0x11102, prCM, gcMn},   //     [3] CHAKMA SIGN CANDRABINDU..CHAKMA SIGN VISARGA
@@ -2861,25 +2890,49 @@ var lineBreakCodePoints = [][4]int{
 	{0x112F0, 0x112F9, prNU, gcNd},   //    [10] KHUDAWADI DIGIT ZERO..KHUDAWADI DIGIT NINE
 	{0x11300, 0x11301, prCM, gcMn},   //     [2] GRANTHA SIGN COMBINING ANUSVARA ABOVE..GRANTHA SIGN CANDRABINDU
 	{0x11302, 0x11303, prCM, gcMc},   //     [2] GRANTHA SIGN ANUSVARA..GRANTHA SIGN VISARGA
-	{0x11305, 0x1130C, prAL, gcLo},   //     [8] GRANTHA LETTER A..GRANTHA LETTER VOCALIC L
-	{0x1130F, 0x11310, prAL, gcLo},   //     [2] GRANTHA LETTER EE..GRANTHA LETTER AI
-	{0x11313, 0x11328, prAL, gcLo},   //    [22] GRANTHA LETTER OO..GRANTHA LETTER NA
-	{0x1132A, 0x11330, prAL, gcLo},   //     [7] GRANTHA LETTER PA..GRANTHA LETTER RA
-	{0x11332, 0x11333, prAL, gcLo},   //     [2] GRANTHA LETTER LA..GRANTHA LETTER LLA
-	{0x11335, 0x11339, prAL, gcLo},   //     [5] GRANTHA LETTER VA..GRANTHA LETTER HA
+	{0x11305, 0x1130C, prAK, gcLo},   //     [8] GRANTHA LETTER A..GRANTHA LETTER VOCALIC L
+	{0x1130F, 0x11310, prAK, gcLo},   //     [2] GRANTHA LETTER EE..GRANTHA LETTER AI
+	{0x11313, 0x11328, prAK, gcLo},   //    [22] GRANTHA LETTER OO..GRANTHA LETTER NA
+	{0x1132A, 0x11330, prAK, gcLo},   //     [7] GRANTHA LETTER PA..GRANTHA LETTER RA
+	{0x11332, 0x11333, prAK, gcLo},   //     [2] GRANTHA LETTER LA..GRANTHA LETTER LLA
+	{0x11335, 0x11339, prAK, gcLo},   //     [5] GRANTHA LETTER VA..GRANTHA LETTER HA
 	{0x1133B, 0x1133C, prCM, gcMn},   //     [2] COMBINING BINDU BELOW..GRANTHA SIGN NUKTA
-	{0x1133D, 0x1133D, prAL, gcLo},   //         GRANTHA SIGN AVAGRAHA
+	{0x1133D, 0x1133D, prBA, gcLo},   //         GRANTHA SIGN AVAGRAHA
 	{0x1133E, 0x1133F, prCM, gcMc},   //     [2] GRANTHA VOWEL SIGN AA..GRANTHA VOWEL SIGN I
 	{0x11340, 0x11340, prCM, gcMn},   //         GRANTHA VOWEL SIGN II
 	{0x11341, 0x11344, prCM, gcMc},   //     [4] GRANTHA VOWEL SIGN U..GRANTHA VOWEL SIGN VOCALIC RR
 	{0x11347, 0x11348, prCM, gcMc},   //     [2] GRANTHA VOWEL SIGN EE..GRANTHA VOWEL SIGN AI
-	{0x1134B, 0x1134D, prCM, gcMc},   //     [3] GRANTHA VOWEL SIGN OO..GRANTHA SIGN VIRAMA
-	{0x11350, 0x11350, prAL, gcLo},   //         GRANTHA OM
+	{0x1134B, 0x1134C, prCM, gcMc},   //     [2] GRANTHA VOWEL SIGN OO..GRANTHA VOWEL SIGN AU
+	{0x1134D, 0x1134D, prVI, gcMc},   //         GRANTHA SIGN VIRAMA
+	{0x11350, 0x11350, prAS, gcLo},   //         GRANTHA OM
 	{0x11357, 0x11357, prCM, gcMc},   //         GRANTHA AU LENGTH MARK
-	{0x1135D, 0x11361, prAL, gcLo},   //     [5] GRANTHA SIGN PLUTA..GRANTHA LETTER VOCALIC LL
+	{0x1135D, 0x1135D, prBA, gcLo},   //         GRANTHA SIGN PLUTA
+	{0x1135E, 0x1135F, prAS, gcLo},   //     [2] GRANTHA LETTER VEDIC ANUSVARA..GRANTHA LETTER VEDIC DOUBLE ANUSVARA
+	{0x11360, 0x11361, prAK, gcLo},   //     [2] GRANTHA LETTER VOCALIC RR..GRANTHA LETTER VOCALIC LL
 	{0x11362, 0x11363, prCM, gcMc},   //     [2] GRANTHA VOWEL SIGN VOCALIC L..GRANTHA VOWEL SIGN VOCALIC LL
 	{0x11366, 0x1136C, prCM, gcMn},   //     [7] COMBINING GRANTHA DIGIT ZERO..COMBINING GRANTHA DIGIT SIX
 	{0x11370, 0x11374, prCM, gcMn},   //     [5] COMBINING GRANTHA LETTER A..COMBINING GRANTHA LETTER PA
+	{0x11380, 0x11389, prAS, gcLo},   //    [10] TULU-TIGALARI LETTER A..TULU-TIGALARI LETTER VOCALIC LL
+	{0x1138B, 0x1138B, prAS, gcLo},   //         TULU-TIGALARI LETTER EE
+	{0x1138E, 0x1138E, prAS, gcLo},   //         TULU-TIGALARI LETTER AI
+	{0x11390, 0x11391, prAS, gcLo},   //     [2] TULU-TIGALARI LETTER OO..TULU-TIGALARI LETTER AU
+	{0x11392, 0x113B5, prAK, gcLo},   //    [36] TULU-TIGALARI LETTER KA..TULU-TIGALARI LETTER LLLA
+	{0x113B7, 0x113B7, prID, gcLo},   //         TULU-TIGALARI SIGN AVAGRAHA
+	{0x113B8, 0x113BA, prCM, gcMc},   //     [3] TULU-TIGALARI VOWEL SIGN AA..TULU-TIGALARI VOWEL SIGN II
+	{0x113BB, 0x113C0, prCM, gcMn},   //     [6] TULU-TIGALARI VOWEL SIGN U..TULU-TIGALARI VOWEL SIGN VOCALIC LL
+	{0x113C2, 0x113C2, prCM, gcMc},   //         TULU-TIGALARI VOWEL SIGN EE
+	{0x113C5, 0x113C5, prCM, gcMc},   //         TULU-TIGALARI VOWEL SIGN AI
+	{0x113C7, 0x113CA, prCM, gcMc},   //     [4] TULU-TIGALARI VOWEL SIGN OO..TULU-TIGALARI SIGN CANDRA ANUNASIKA
+	{0x113CC, 0x113CD, prCM, gcMc},   //     [2] TULU-TIGALARI SIGN ANUSVARA..TULU-TIGALARI SIGN VISARGA
+	{0x113CE, 0x113CE, prCM, gcMn},   //         TULU-TIGALARI SIGN VIRAMA
+	{0x113CF, 0x113CF, prCM, gcMc},   //         TULU-TIGALARI SIGN LOOPED VIRAMA
+	{0x113D0, 0x113D0, prVI, gcMn},   //         TULU-TIGALARI CONJOINER
+	{0x113D1, 0x113D1, prAP, gcLo},   //         TULU-TIGALARI REPHA
+	{0x113D2, 0x113D2, prCM, gcMn},   //         TULU-TIGALARI GEMINATION MARK
+	{0x113D3, 0x113D3, prID, gcLo},   //         TULU-TIGALARI SIGN PLUTA
+	{0x113D4, 0x113D5, prID, gcPo},   //     [2] TULU-TIGALARI DANDA..TULU-TIGALARI DOUBLE DANDA
+	{0x113D7, 0x113D8, prID, gcPo},   //     [2] TULU-TIGALARI SIGN OM PUSHPIKA..TULU-TIGALARI SIGN SHRII PUSHPIKA
+	{0x113E1, 0x113E2, prCM, gcMn},   //     [2] TULU-TIGALARI VEDIC TONE SVARITA..TULU-TIGALARI VEDIC TONE ANUDATTA
 	{0x11400, 0x11434, prAL, gcLo},   //    [53] NEWA LETTER A..NEWA LETTER HA
 	{0x11435, 0x11437, prCM, gcMc},   //     [3] NEWA VOWEL SIGN AA..NEWA VOWEL SIGN II
 	{0x11438, 0x1143F, prCM, gcMn},   //     [8] NEWA VOWEL SIGN U..NEWA VOWEL SIGN AI
@@ -2945,8 +2998,11 @@ var lineBreakCodePoints = [][4]int{
 	{0x116B8, 0x116B8, prAL, gcLo},   //         TAKRI LETTER ARCHAIC KHA
 	{0x116B9, 0x116B9, prAL, gcPo},   //         TAKRI ABBREVIATION SIGN
 	{0x116C0, 0x116C9, prNU, gcNd},   //    [10] TAKRI DIGIT ZERO..TAKRI DIGIT NINE
+	{0x116D0, 0x116E3, prNU, gcNd},   //    [20] MYANMAR PAO DIGIT ZERO..MYANMAR EASTERN PWO KAREN DIGIT NINE
 	{0x11700, 0x1171A, prSA, gcLo},   //    [27] AHOM LETTER KA..AHOM LETTER ALTERNATE BA
-	{0x1171D, 0x1171F, prSA, gcMn},   //     [3] AHOM CONSONANT SIGN MEDIAL LA..AHOM CONSONANT SIGN MEDIAL LIGATING RA
+	{0x1171D, 0x1171D, prSA, gcMn},   //         AHOM CONSONANT SIGN MEDIAL LA
+	{0x1171E, 0x1171E, prSA, gcMc},   //         AHOM CONSONANT SIGN MEDIAL RA
+	{0x1171F, 0x1171F, prSA, gcMn},   //         AHOM CONSONANT SIGN MEDIAL LIGATING RA
 	{0x11720, 0x11721, prSA, gcMc},   //     [2] AHOM VOWEL SIGN A..AHOM VOWEL SIGN AA
 	{0x11722, 0x11725, prSA, gcMn},   //     [4] AHOM VOWEL SIGN I..AHOM VOWEL SIGN UU
 	{0x11726, 0x11726, prSA, gcMc},   //         AHOM VOWEL SIGN E
@@ -2966,23 +3022,23 @@ var lineBreakCodePoints = [][4]int{
 	{0x118E0, 0x118E9, prNU, gcNd},   //    [10] WARANG CITI DIGIT ZERO..WARANG CITI DIGIT NINE
 	{0x118EA, 0x118F2, prAL, gcNo},   //     [9] WARANG CITI NUMBER TEN..WARANG CITI NUMBER NINETY
 	{0x118FF, 0x118FF, prAL, gcLo},   //         WARANG CITI OM
-	{0x11900, 0x11906, prAL, gcLo},   //     [7] DIVES AKURU LETTER A..DIVES AKURU LETTER E
-	{0x11909, 0x11909, prAL, gcLo},   //         DIVES AKURU LETTER O
-	{0x1190C, 0x11913, prAL, gcLo},   //     [8] DIVES AKURU LETTER KA..DIVES AKURU LETTER JA
-	{0x11915, 0x11916, prAL, gcLo},   //     [2] DIVES AKURU LETTER NYA..DIVES AKURU LETTER TTA
-	{0x11918, 0x1192F, prAL, gcLo},   //    [24] DIVES AKURU LETTER DDA..DIVES AKURU LETTER ZA
+	{0x11900, 0x11906, prAK, gcLo},   //     [7] DIVES AKURU LETTER A..DIVES AKURU LETTER E
+	{0x11909, 0x11909, prAK, gcLo},   //         DIVES AKURU LETTER O
+	{0x1190C, 0x11913, prAK, gcLo},   //     [8] DIVES AKURU LETTER KA..DIVES AKURU LETTER JA
+	{0x11915, 0x11916, prAK, gcLo},   //     [2] DIVES AKURU LETTER NYA..DIVES AKURU LETTER TTA
+	{0x11918, 0x1192F, prAK, gcLo},   //    [24] DIVES AKURU LETTER DDA..DIVES AKURU LETTER ZA
 	{0x11930, 0x11935, prCM, gcMc},   //     [6] DIVES AKURU VOWEL SIGN AA..DIVES AKURU VOWEL SIGN E
 	{0x11937, 0x11938, prCM, gcMc},   //     [2] DIVES AKURU VOWEL SIGN AI..DIVES AKURU VOWEL SIGN O
 	{0x1193B, 0x1193C, prCM, gcMn},   //     [2] DIVES AKURU SIGN ANUSVARA..DIVES AKURU SIGN CANDRABINDU
 	{0x1193D, 0x1193D, prCM, gcMc},   //         DIVES AKURU SIGN HALANTA
-	{0x1193E, 0x1193E, prCM, gcMn},   //         DIVES AKURU VIRAMA
-	{0x1193F, 0x1193F, prAL, gcLo},   //         DIVES AKURU PREFIXED NASAL SIGN
+	{0x1193E, 0x1193E, prVI, gcMn},   //         DIVES AKURU VIRAMA
+	{0x1193F, 0x1193F, prAP, gcLo},   //         DIVES AKURU PREFIXED NASAL SIGN
 	{0x11940, 0x11940, prCM, gcMc},   //         DIVES AKURU MEDIAL YA
-	{0x11941, 0x11941, prAL, gcLo},   //         DIVES AKURU INITIAL RA
+	{0x11941, 0x11941, prAP, gcLo},   //         DIVES AKURU INITIAL RA
 	{0x11942, 0x11942, prCM, gcMc},   //         DIVES AKURU MEDIAL RA
 	{0x11943, 0x11943, prCM, gcMn},   //         DIVES AKURU SIGN NUKTA
 	{0x11944, 0x11946, prBA, gcPo},   //     [3] DIVES AKURU DOUBLE DANDA..DIVES AKURU END OF TEXT MARK
-	{0x11950, 0x11959, prNU, gcNd},   //    [10] DIVES AKURU DIGIT ZERO..DIVES AKURU DIGIT NINE
+	{0x11950, 0x11959, prAS, gcNd},   //    [10] DIVES AKURU DIGIT ZERO..DIVES AKURU DIGIT NINE
 	{0x119A0, 0x119A7, prAL, gcLo},   //     [8] NANDINAGARI LETTER A..NANDINAGARI LETTER VOCALIC RR
 	{0x119AA, 0x119D0, prAL, gcLo},   //    [39] NANDINAGARI LETTER E..NANDINAGARI LETTER RRA
 	{0x119D1, 0x119D3, prCM, gcMc},   //     [3] NANDINAGARI VOWEL SIGN AA..NANDINAGARI VOWEL SIGN II
@@ -3022,6 +3078,15 @@ var lineBreakCodePoints = [][4]int{
 	{0x11AB0, 0x11ABF, prAL, gcLo},   //    [16] CANADIAN SYLLABICS NATTILIK HI..CANADIAN SYLLABICS SPA
 	{0x11AC0, 0x11AF8, prAL, gcLo},   //    [57] PAU CIN HAU LETTER PA..PAU CIN HAU GLOTTAL STOP FINAL
 	{0x11B00, 0x11B09, prBB, gcPo},   //    [10] DEVANAGARI HEAD MARK..DEVANAGARI SIGN MINDU
+	{0x11B60, 0x11B60, prCM, gcMn},   //         SHARADA VOWEL SIGN OE
+	{0x11B61, 0x11B61, prCM, gcMc},   //         SHARADA VOWEL SIGN OOE
+	{0x11B62, 0x11B64, prCM, gcMn},   //     [3] SHARADA VOWEL SIGN UE..SHARADA VOWEL SIGN SHORT E
+	{0x11B65, 0x11B65, prCM, gcMc},   //         SHARADA VOWEL SIGN SHORT O
+	{0x11B66, 0x11B66, prCM, gcMn},   //         SHARADA VOWEL SIGN CANDRA E
+	{0x11B67, 0x11B67, prCM, gcMc},   //         SHARADA VOWEL SIGN CANDRA O
+	{0x11BC0, 0x11BE0, prAL, gcLo},   //    [33] SUNUWAR LETTER DEVI..SUNUWAR LETTER KLOKO
+	{0x11BE1, 0x11BE1, prAL, gcPo},   //         SUNUWAR SIGN PVO
+	{0x11BF0, 0x11BF9, prNU, gcNd},   //    [10] SUNUWAR DIGIT ZERO..SUNUWAR DIGIT NINE
 	{0x11C00, 0x11C08, prAL, gcLo},   //     [9] BHAIKSUKI LETTER A..BHAIKSUKI LETTER VOCALIC L
 	{0x11C0A, 0x11C2E, prAL, gcLo},   //    [37] BHAIKSUKI LETTER E..BHAIKSUKI LETTER HA
 	{0x11C2F, 0x11C2F, prCM, gcMc},   //         BHAIKSUKI VOWEL SIGN AA
@@ -3064,24 +3129,30 @@ var lineBreakCodePoints = [][4]int{
 	{0x11D97, 0x11D97, prCM, gcMn},   //         GUNJALA GONDI VIRAMA
 	{0x11D98, 0x11D98, prAL, gcLo},   //         GUNJALA GONDI OM
 	{0x11DA0, 0x11DA9, prNU, gcNd},   //    [10] GUNJALA GONDI DIGIT ZERO..GUNJALA GONDI DIGIT NINE
-	{0x11EE0, 0x11EF2, prAL, gcLo},   //    [19] MAKASAR LETTER KA..MAKASAR ANGKA
+	{0x11DB0, 0x11DD8, prAL, gcLo},   //    [41] TOLONG SIKI LETTER I..TOLONG SIKI LETTER RRH
+	{0x11DD9, 0x11DD9, prAL, gcLm},   //         TOLONG SIKI SIGN SELA
+	{0x11DDA, 0x11DDB, prAL, gcLo},   //     [2] TOLONG SIKI SIGN HECAKA..TOLONG SIKI UNGGA
+	{0x11DE0, 0x11DE9, prNU, gcNd},   //    [10] TOLONG SIKI DIGIT ZERO..TOLONG SIKI DIGIT NINE
+	{0x11EE0, 0x11EF1, prAS, gcLo},   //    [18] MAKASAR LETTER KA..MAKASAR LETTER A
+	{0x11EF2, 0x11EF2, prBA, gcLo},   //         MAKASAR ANGKA
 	{0x11EF3, 0x11EF4, prCM, gcMn},   //     [2] MAKASAR VOWEL SIGN I..MAKASAR VOWEL SIGN U
 	{0x11EF5, 0x11EF6, prCM, gcMc},   //     [2] MAKASAR VOWEL SIGN E..MAKASAR VOWEL SIGN O
-	{0x11EF7, 0x11EF8, prAL, gcPo},   //     [2] MAKASAR PASSIMBANG..MAKASAR END OF SECTION
+	{0x11EF7, 0x11EF8, prBA, gcPo},   //     [2] MAKASAR PASSIMBANG..MAKASAR END OF SECTION
 	{0x11F00, 0x11F01, prCM, gcMn},   //     [2] KAWI SIGN CANDRABINDU..KAWI SIGN ANUSVARA
-	{0x11F02, 0x11F02, prAL, gcLo},   //         KAWI SIGN REPHA
+	{0x11F02, 0x11F02, prAP, gcLo},   //         KAWI SIGN REPHA
 	{0x11F03, 0x11F03, prCM, gcMc},   //         KAWI SIGN VISARGA
-	{0x11F04, 0x11F10, prAL, gcLo},   //    [13] KAWI LETTER A..KAWI LETTER O
-	{0x11F12, 0x11F33, prAL, gcLo},   //    [34] KAWI LETTER KA..KAWI LETTER JNYA
+	{0x11F04, 0x11F10, prAK, gcLo},   //    [13] KAWI LETTER A..KAWI LETTER O
+	{0x11F12, 0x11F33, prAK, gcLo},   //    [34] KAWI LETTER KA..KAWI LETTER JNYA
 	{0x11F34, 0x11F35, prCM, gcMc},   //     [2] KAWI VOWEL SIGN AA..KAWI VOWEL SIGN ALTERNATE AA
 	{0x11F36, 0x11F3A, prCM, gcMn},   //     [5] KAWI VOWEL SIGN I..KAWI VOWEL SIGN VOCALIC R
 	{0x11F3E, 0x11F3F, prCM, gcMc},   //     [2] KAWI VOWEL SIGN E..KAWI VOWEL SIGN AI
 	{0x11F40, 0x11F40, prCM, gcMn},   //         KAWI VOWEL SIGN EU
 	{0x11F41, 0x11F41, prCM, gcMc},   //         KAWI SIGN KILLER
-	{0x11F42, 0x11F42, prCM, gcMn},   //         KAWI CONJOINER
+	{0x11F42, 0x11F42, prVI, gcMn},   //         KAWI CONJOINER
 	{0x11F43, 0x11F44, prBA, gcPo},   //     [2] KAWI DANDA..KAWI DOUBLE DANDA
 	{0x11F45, 0x11F4F, prID, gcPo},   //    [11] KAWI PUNCTUATION SECTION MARKER..KAWI PUNCTUATION CLOSING SPIRAL
-	{0x11F50, 0x11F59, prNU, gcNd},   //    [10] KAWI DIGIT ZERO..KAWI DIGIT NINE
+	{0x11F50, 0x11F59, prAS, gcNd},   //    [10] KAWI DIGIT ZERO..KAWI DIGIT NINE
+	{0x11F5A, 0x11F5A, prCM, gcMn},   //         KAWI SIGN NUKTA
 	{0x11FB0, 0x11FB0, prAL, gcLo},   //         LISU LETTER YHA
 	{0x11FC0, 0x11FD4, prAL, gcNo},   //    [21] TAMIL FRACTION ONE THREE-HUNDRED-AND-TWENTIETH..TAMIL FRACTION DOWNSCALING FACTOR KIIZH
 	{0x11FD5, 0x11FDC, prAL, gcSo},   //     [8] TAMIL SIGN NEL..TAMIL SIGN MUKKURUNI
@@ -3107,7 +3178,8 @@ var lineBreakCodePoints = [][4]int{
 	{0x1328A, 0x13378, prAL, gcLo},   //   [239] EGYPTIAN HIEROGLYPH O037..EGYPTIAN HIEROGLYPH V011
 	{0x13379, 0x13379, prOP, gcLo},   //         EGYPTIAN HIEROGLYPH V011A
 	{0x1337A, 0x1337B, prCL, gcLo},   //     [2] EGYPTIAN HIEROGLYPH V011B..EGYPTIAN HIEROGLYPH V011C
-	{0x1337C, 0x1342F, prAL, gcLo},   //   [180] EGYPTIAN HIEROGLYPH V012..EGYPTIAN HIEROGLYPH V011D
+	{0x1337C, 0x1342E, prAL, gcLo},   //   [179] EGYPTIAN HIEROGLYPH V012..EGYPTIAN HIEROGLYPH AA032
+	{0x1342F, 0x1342F, prOP, gcLo},   //         EGYPTIAN HIEROGLYPH V011D
 	{0x13430, 0x13436, prGL, gcCf},   //     [7] EGYPTIAN HIEROGLYPH VERTICAL JOINER..EGYPTIAN HIEROGLYPH OVERLAY MIDDLE
 	{0x13437, 0x13437, prOP, gcCf},   //         EGYPTIAN HIEROGLYPH BEGIN SEGMENT
 	{0x13438, 0x13438, prCL, gcCf},   //         EGYPTIAN HIEROGLYPH END SEGMENT
@@ -3119,10 +3191,16 @@ var lineBreakCodePoints = [][4]int{
 	{0x13440, 0x13440, prCM, gcMn},   //         EGYPTIAN HIEROGLYPH MIRROR HORIZONTALLY
 	{0x13441, 0x13446, prAL, gcLo},   //     [6] EGYPTIAN HIEROGLYPH FULL BLANK..EGYPTIAN HIEROGLYPH WIDE LOST SIGN
 	{0x13447, 0x13455, prCM, gcMn},   //    [15] EGYPTIAN HIEROGLYPH MODIFIER DAMAGED AT TOP START..EGYPTIAN HIEROGLYPH MODIFIER DAMAGED
+	{0x13460, 0x143FA, prAL, gcLo},   //  [3995] EGYPTIAN HIEROGLYPH-13460..EGYPTIAN HIEROGLYPH-143FA
 	{0x14400, 0x145CD, prAL, gcLo},   //   [462] ANATOLIAN HIEROGLYPH A001..ANATOLIAN HIEROGLYPH A409
 	{0x145CE, 0x145CE, prOP, gcLo},   //         ANATOLIAN HIEROGLYPH A410 BEGIN LOGOGRAM MARK
 	{0x145CF, 0x145CF, prCL, gcLo},   //         ANATOLIAN HIEROGLYPH A410A END LOGOGRAM MARK
 	{0x145D0, 0x14646, prAL, gcLo},   //   [119] ANATOLIAN HIEROGLYPH A411..ANATOLIAN HIEROGLYPH A530
+	{0x16100, 0x1611D, prAS, gcLo},   //    [30] GURUNG KHEMA LETTER A..GURUNG KHEMA LETTER SA
+	{0x1611E, 0x16129, prCM, gcMn},   //    [12] GURUNG KHEMA VOWEL SIGN AA..GURUNG KHEMA VOWEL LENGTH MARK
+	{0x1612A, 0x1612C, prCM, gcMc},   //     [3] GURUNG KHEMA CONSONANT SIGN MEDIAL YA..GURUNG KHEMA CONSONANT SIGN MEDIAL HA
+	{0x1612D, 0x1612F, prCM, gcMn},   //     [3] GURUNG KHEMA SIGN ANUSVARA..GURUNG KHEMA SIGN THOLHOMA
+	{0x16130, 0x16139, prAS, gcNd},   //    [10] GURUNG KHEMA DIGIT ZERO..GURUNG KHEMA DIGIT NINE
 	{0x16800, 0x16A38, prAL, gcLo},   //   [569] BAMUM LETTER PHASE-A NGKUE MFON..BAMUM LETTER PHASE-F VUEQ
 	{0x16A40, 0x16A5E, prAL, gcLo},   //    [31] MRO LETTER TA..MRO LETTER TEK
 	{0x16A60, 0x16A69, prNU, gcNd},   //    [10] MRO DIGIT ZERO..MRO DIGIT NINE
@@ -3144,10 +3222,18 @@ var lineBreakCodePoints = [][4]int{
 	{0x16B5B, 0x16B61, prAL, gcNo},   //     [7] PAHAWH HMONG NUMBER TENS..PAHAWH HMONG NUMBER TRILLIONS
 	{0x16B63, 0x16B77, prAL, gcLo},   //    [21] PAHAWH HMONG SIGN VOS LUB..PAHAWH HMONG SIGN CIM NRES TOS
 	{0x16B7D, 0x16B8F, prAL, gcLo},   //    [19] PAHAWH HMONG CLAN SIGN TSHEEJ..PAHAWH HMONG CLAN SIGN VWJ
+	{0x16D40, 0x16D42, prAL, gcLm},   //     [3] KIRAT RAI SIGN ANUSVARA..KIRAT RAI SIGN VISARGA
+	{0x16D43, 0x16D6A, prAL, gcLo},   //    [40] KIRAT RAI LETTER A..KIRAT RAI VOWEL SIGN AU
+	{0x16D6B, 0x16D6C, prAL, gcLm},   //     [2] KIRAT RAI SIGN VIRAMA..KIRAT RAI SIGN SAAT
+	{0x16D6D, 0x16D6D, prAL, gcPo},   //         KIRAT RAI SIGN YUPI
+	{0x16D6E, 0x16D6F, prBA, gcPo},   //     [2] KIRAT RAI DANDA..KIRAT RAI DOUBLE DANDA
+	{0x16D70, 0x16D79, prNU, gcNd},   //    [10] KIRAT RAI DIGIT ZERO..KIRAT RAI DIGIT NINE
 	{0x16E40, 0x16E7F, prAL, gcLC},   //    [64] MEDEFAIDRIN CAPITAL LETTER M..MEDEFAIDRIN SMALL LETTER Y
 	{0x16E80, 0x16E96, prAL, gcNo},   //    [23] MEDEFAIDRIN DIGIT ZERO..MEDEFAIDRIN DIGIT THREE ALTERNATE FORM
 	{0x16E97, 0x16E98, prBA, gcPo},   //     [2] MEDEFAIDRIN COMMA..MEDEFAIDRIN FULL STOP
 	{0x16E99, 0x16E9A, prAL, gcPo},   //     [2] MEDEFAIDRIN SYMBOL AIVA..MEDEFAIDRIN EXCLAMATION OH
+	{0x16EA0, 0x16EB8, prAL, gcLu},   //    [25] BERIA ERFE CAPITAL LETTER ARKAB..BERIA ERFE CAPITAL LETTER AY
+	{0x16EBB, 0x16ED3, prAL, gcLl},   //    [25] BERIA ERFE SMALL LETTER ARKAB..BERIA ERFE SMALL LETTER AY
 	{0x16F00, 0x16F4A, prAL, gcLo},   //    [75] MIAO LETTER PA..MIAO LETTER RTE
 	{0x16F4F, 0x16F4F, prCM, gcMn},   //         MIAO SIGN CONSONANT MODIFIER BAR
 	{0x16F50, 0x16F50, prAL, gcLo},   //         MIAO LETTER NASALIZATION
@@ -3159,10 +3245,14 @@ var lineBreakCodePoints = [][4]int{
 	{0x16FE3, 0x16FE3, prNS, gcLm},   //         OLD CHINESE ITERATION MARK
 	{0x16FE4, 0x16FE4, prGL, gcMn},   //         KHITAN SMALL SCRIPT FILLER
 	{0x16FF0, 0x16FF1, prCM, gcMc},   //     [2] VIETNAMESE ALTERNATE READING MARK CA..VIETNAMESE ALTERNATE READING MARK NHAY
-	{0x17000, 0x187F7, prID, gcLo},   //  [6136] TANGUT IDEOGRAPH-17000..TANGUT IDEOGRAPH-187F7
+	{0x16FF2, 0x16FF3, prNS, gcLm},   //     [2] CHINESE SMALL SIMPLIFIED ER..CHINESE SMALL TRADITIONAL ER
+	{0x16FF4, 0x16FF6, prID, gcNl},   //     [3] YANGQIN SIGN SLOW ONE BEAT..YANGQIN SIGN SLOW TWO BEATS
+	{0x17000, 0x187FF, prID, gcLo},   //  [6144] TANGUT IDEOGRAPH-17000..TANGUT IDEOGRAPH-187FF
 	{0x18800, 0x18AFF, prID, gcLo},   //   [768] TANGUT COMPONENT-001..TANGUT COMPONENT-768
 	{0x18B00, 0x18CD5, prAL, gcLo},   //   [470] KHITAN SMALL SCRIPT CHARACTER-18B00..KHITAN SMALL SCRIPT CHARACTER-18CD5
-	{0x18D00, 0x18D08, prID, gcLo},   //     [9] TANGUT IDEOGRAPH-18D00..TANGUT IDEOGRAPH-18D08
+	{0x18CFF, 0x18CFF, prAL, gcLo},   //         KHITAN SMALL SCRIPT CHARACTER-18CFF
+	{0x18D00, 0x18D1E, prID, gcLo},   //    [31] TANGUT IDEOGRAPH-18D00..TANGUT IDEOGRAPH-18D1E
+	{0x18D80, 0x18DF2, prID, gcLo},   //   [115] TANGUT COMPONENT-769..TANGUT COMPONENT-883
 	{0x1AFF0, 0x1AFF3, prAL, gcLm},   //     [4] KATAKANA LETTER MINNAN TONE-2..KATAKANA LETTER MINNAN TONE-5
 	{0x1AFF5, 0x1AFFB, prAL, gcLm},   //     [7] KATAKANA LETTER MINNAN TONE-7..KATAKANA LETTER MINNAN NASALIZED TONE-5
 	{0x1AFFD, 0x1AFFE, prAL, gcLm},   //     [2] KATAKANA LETTER MINNAN NASALIZED TONE-7..KATAKANA LETTER MINNAN NASALIZED TONE-8
@@ -3181,6 +3271,14 @@ var lineBreakCodePoints = [][4]int{
 	{0x1BC9D, 0x1BC9E, prCM, gcMn},   //     [2] DUPLOYAN THICK LETTER SELECTOR..DUPLOYAN DOUBLE MARK
 	{0x1BC9F, 0x1BC9F, prBA, gcPo},   //         DUPLOYAN PUNCTUATION CHINOOK FULL STOP
 	{0x1BCA0, 0x1BCA3, prCM, gcCf},   //     [4] SHORTHAND FORMAT LETTER OVERLAP..SHORTHAND FORMAT UP STEP
+	{0x1CC00, 0x1CCEF, prAL, gcSo},   //   [240] UP-POINTING GO-KART..OUTLINED LATIN CAPITAL LETTER Z
+	{0x1CCF0, 0x1CCF9, prNU, gcNd},   //    [10] OUTLINED DIGIT ZERO..OUTLINED DIGIT NINE
+	{0x1CCFA, 0x1CCFC, prAL, gcSo},   //     [3] SNAKE SYMBOL..NOSE SYMBOL
+	{0x1CD00, 0x1CEB3, prAL, gcSo},   //   [436] BLOCK OCTANT-3..BLACK RIGHT TRIANGLE CARET
+	{0x1CEBA, 0x1CEBF, prAL, gcSo},   //     [6] FRAGILE SYMBOL..STRAWBERRY SYMBOL
+	{0x1CEC0, 0x1CED0, prAL, gcSo},   //    [17] HEBE..LEUKOTHEA
+	{0x1CEE0, 0x1CEEF, prAL, gcSo},   //    [16] GEOMANTIC FIGURE POPULUS..GEOMANTIC FIGURE VIA
+	{0x1CEF0, 0x1CEF0, prAL, gcSm},   //         MEDIUM SMALL WHITE CIRCLE WITH HORIZONTAL BAR
 	{0x1CF00, 0x1CF2D, prCM, gcMn},   //    [46] ZNAMENNY COMBINING MARK GORAZDO NIZKO S KRYZHEM ON LEFT..ZNAMENNY COMBINING MARK KRYZH ON LEFT
 	{0x1CF30, 0x1CF46, prCM, gcMn},   //    [23] ZNAMENNY COMBINING TONAL RANGE MARK MRACHNO..ZNAMENNY PRIZNAK MODIFIER ROG
 	{0x1CF50, 0x1CFC3, prAL, gcSo},   //   [116] ZNAMENNY NEUME KRYUK..ZNAMENNY NEUME PAUK
@@ -3286,6 +3384,22 @@ var lineBreakCodePoints = [][4]int{
 	{0x1E4EB, 0x1E4EB, prAL, gcLm},   //         NAG MUNDARI SIGN OJOD
 	{0x1E4EC, 0x1E4EF, prCM, gcMn},   //     [4] NAG MUNDARI SIGN MUHOR..NAG MUNDARI SIGN SUTUH
 	{0x1E4F0, 0x1E4F9, prNU, gcNd},   //    [10] NAG MUNDARI DIGIT ZERO..NAG MUNDARI DIGIT NINE
+	{0x1E5D0, 0x1E5ED, prAL, gcLo},   //    [30] OL ONAL LETTER O..OL ONAL LETTER EG
+	{0x1E5EE, 0x1E5EF, prCM, gcMn},   //     [2] OL ONAL SIGN MU..OL ONAL SIGN IKIR
+	{0x1E5F0, 0x1E5F0, prAL, gcLo},   //         OL ONAL SIGN HODDOND
+	{0x1E5F1, 0x1E5FA, prNU, gcNd},   //    [10] OL ONAL DIGIT ZERO..OL ONAL DIGIT NINE
+	{0x1E5FF, 0x1E5FF, prAL, gcPo},   //         OL ONAL ABBREVIATION SIGN
+	{0x1E6C0, 0x1E6DE, prAL, gcLo},   //    [31] TAI YO LETTER LOW KO..TAI YO LETTER HIGH KVO
+	{0x1E6E0, 0x1E6E2, prAL, gcLo},   //     [3] TAI YO LETTER AA..TAI YO LETTER UE
+	{0x1E6E3, 0x1E6E3, prCM, gcMn},   //         TAI YO SIGN UE
+	{0x1E6E4, 0x1E6E5, prAL, gcLo},   //     [2] TAI YO LETTER U..TAI YO LETTER AE
+	{0x1E6E6, 0x1E6E6, prCM, gcMn},   //         TAI YO SIGN AU
+	{0x1E6E7, 0x1E6ED, prAL, gcLo},   //     [7] TAI YO LETTER O..TAI YO LETTER AUE
+	{0x1E6EE, 0x1E6EF, prCM, gcMn},   //     [2] TAI YO SIGN AY..TAI YO SIGN ANG
+	{0x1E6F0, 0x1E6F4, prAL, gcLo},   //     [5] TAI YO LETTER AN..TAI YO LETTER AP
+	{0x1E6F5, 0x1E6F5, prCM, gcMn},   //         TAI YO SIGN OM
+	{0x1E6FE, 0x1E6FE, prAL, gcLo},   //         TAI YO SYMBOL MUEANG
+	{0x1E6FF, 0x1E6FF, prAL, gcLm},   //         TAI YO XAM LAI
 	{0x1E7E0, 0x1E7E6, prAL, gcLo},   //     [7] ETHIOPIC SYLLABLE HHYA..ETHIOPIC SYLLABLE HHYO
 	{0x1E7E8, 0x1E7EB, prAL, gcLo},   //     [4] ETHIOPIC SYLLABLE GURAGE HHWA..ETHIOPIC SYLLABLE HHWE
 	{0x1E7ED, 0x1E7EE, prAL, gcLo},   //     [2] ETHIOPIC SYLLABLE GURAGE MWI..ETHIOPIC SYLLABLE GURAGE MWEE
@@ -3353,14 +3467,13 @@ var lineBreakCodePoints = [][4]int{
 	{0x1F0D1, 0x1F0F5, prID, gcSo},   //    [37] PLAYING CARD ACE OF CLUBS..PLAYING CARD TRUMP-21
 	{0x1F0F6, 0x1F0FF, prID, gcCn},   //    [10] <reserved-1F0F6>..<reserved-1F0FF>
 	{0x1F100, 0x1F10C, prAI, gcNo},   //    [13] DIGIT ZERO FULL STOP..DINGBAT NEGATIVE CIRCLED SANS-SERIF DIGIT ZERO
-	{0x1F10D, 0x1F10F, prID, gcSo},   //     [3] CIRCLED ZERO WITH SLASH..CIRCLED DOLLAR SIGN WITH OVERLAID BACKSLASH
+	{0x1F10D, 0x1F10F, prAL, gcSo},   //     [3] CIRCLED ZERO WITH SLASH..CIRCLED DOLLAR SIGN WITH OVERLAID BACKSLASH
 	{0x1F110, 0x1F12D, prAI, gcSo},   //    [30] PARENTHESIZED LATIN CAPITAL LETTER A..CIRCLED CD
 	{0x1F12E, 0x1F12F, prAL, gcSo},   //     [2] CIRCLED WZ..COPYLEFT SYMBOL
 	{0x1F130, 0x1F169, prAI, gcSo},   //    [58] SQUARED LATIN CAPITAL LETTER A..NEGATIVE CIRCLED LATIN CAPITAL LETTER Z
-	{0x1F16A, 0x1F16C, prAL, gcSo},   //     [3] RAISED MC SIGN..RAISED MR SIGN
-	{0x1F16D, 0x1F16F, prID, gcSo},   //     [3] CIRCLED CC..CIRCLED HUMAN FIGURE
+	{0x1F16A, 0x1F16F, prAL, gcSo},   //     [6] RAISED MC SIGN..CIRCLED HUMAN FIGURE
 	{0x1F170, 0x1F1AC, prAI, gcSo},   //    [61] NEGATIVE SQUARED LATIN CAPITAL LETTER A..SQUARED VOD
-	{0x1F1AD, 0x1F1AD, prID, gcSo},   //         MASK WORK SYMBOL
+	{0x1F1AD, 0x1F1AD, prAL, gcSo},   //         MASK WORK SYMBOL
 	{0x1F1AE, 0x1F1E5, prID, gcCn},   //    [56] <reserved-1F1AE>..<reserved-1F1E5>
 	{0x1F1E6, 0x1F1FF, prRI, gcSo},   //    [26] REGIONAL INDICATOR SYMBOL LETTER A..REGIONAL INDICATOR SYMBOL LETTER Z
 	{0x1F200, 0x1F202, prID, gcSo},   //     [3] SQUARE HIRAGANA HOKA..SQUARED KATAKANA SA
@@ -3452,15 +3565,15 @@ var lineBreakCodePoints = [][4]int{
 	{0x1F6C0, 0x1F6C0, prEB, gcSo},   //         BATH
 	{0x1F6C1, 0x1F6CB, prID, gcSo},   //    [11] BATHTUB..COUCH AND LAMP
 	{0x1F6CC, 0x1F6CC, prEB, gcSo},   //         SLEEPING ACCOMMODATION
-	{0x1F6CD, 0x1F6D7, prID, gcSo},   //    [11] SHOPPING BAGS..ELEVATOR
-	{0x1F6D8, 0x1F6DB, prID, gcCn},   //     [4] <reserved-1F6D8>..<reserved-1F6DB>
+	{0x1F6CD, 0x1F6D8, prID, gcSo},   //    [12] SHOPPING BAGS..LANDSLIDE
+	{0x1F6D9, 0x1F6DB, prID, gcCn},   //     [3] <reserved-1F6D9>..<reserved-1F6DB>
 	{0x1F6DC, 0x1F6EC, prID, gcSo},   //    [17] WIRELESS..AIRPLANE ARRIVING
 	{0x1F6ED, 0x1F6EF, prID, gcCn},   //     [3] <reserved-1F6ED>..<reserved-1F6EF>
 	{0x1F6F0, 0x1F6FC, prID, gcSo},   //    [13] SATELLITE..ROLLER SKATE
 	{0x1F6FD, 0x1F6FF, prID, gcCn},   //     [3] <reserved-1F6FD>..<reserved-1F6FF>
 	{0x1F700, 0x1F773, prAL, gcSo},   //   [116] ALCHEMICAL SYMBOL FOR QUINTESSENCE..ALCHEMICAL SYMBOL FOR HALF OUNCE
 	{0x1F774, 0x1F776, prID, gcSo},   //     [3] LOT OF FORTUNE..LUNAR ECLIPSE
-	{0x1F777, 0x1F77A, prID, gcCn},   //     [4] <reserved-1F777>..<reserved-1F77A>
+	{0x1F777, 0x1F77A, prAL, gcSo},   //     [4] VESTA FORM TWO..PARTHENOPE FORM TWO
 	{0x1F77B, 0x1F77F, prID, gcSo},   //     [5] HAUMEA..ORCUS
 	{0x1F780, 0x1F7D4, prAL, gcSo},   //    [85] BLACK LEFT-POINTING ISOSCELES RIGHT TRIANGLE..HEAVY TWELVE POINTED PINWHEEL STAR
 	{0x1F7D5, 0x1F7D9, prID, gcSo},   //     [5] CIRCLED TRIANGLE..NINE POINTED WHITE STAR
@@ -3470,17 +3583,13 @@ var lineBreakCodePoints = [][4]int{
 	{0x1F7F0, 0x1F7F0, prID, gcSo},   //         HEAVY EQUALS SIGN
 	{0x1F7F1, 0x1F7FF, prID, gcCn},   //    [15] <reserved-1F7F1>..<reserved-1F7FF>
 	{0x1F800, 0x1F80B, prAL, gcSo},   //    [12] LEFTWARDS ARROW WITH SMALL TRIANGLE ARROWHEAD..DOWNWARDS ARROW WITH LARGE TRIANGLE ARROWHEAD
-	{0x1F80C, 0x1F80F, prID, gcCn},   //     [4] <reserved-1F80C>..<reserved-1F80F>
 	{0x1F810, 0x1F847, prAL, gcSo},   //    [56] LEFTWARDS ARROW WITH SMALL EQUILATERAL ARROWHEAD..DOWNWARDS HEAVY ARROW
-	{0x1F848, 0x1F84F, prID, gcCn},   //     [8] <reserved-1F848>..<reserved-1F84F>
 	{0x1F850, 0x1F859, prAL, gcSo},   //    [10] LEFTWARDS SANS-SERIF ARROW..UP DOWN SANS-SERIF ARROW
-	{0x1F85A, 0x1F85F, prID, gcCn},   //     [6] <reserved-1F85A>..<reserved-1F85F>
 	{0x1F860, 0x1F887, prAL, gcSo},   //    [40] WIDE-HEADED LEFTWARDS LIGHT BARB ARROW..WIDE-HEADED SOUTH WEST VERY HEAVY BARB ARROW
-	{0x1F888, 0x1F88F, prID, gcCn},   //     [8] <reserved-1F888>..<reserved-1F88F>
 	{0x1F890, 0x1F8AD, prAL, gcSo},   //    [30] LEFTWARDS TRIANGLE ARROWHEAD..WHITE ARROW SHAFT WIDTH TWO THIRDS
-	{0x1F8AE, 0x1F8AF, prID, gcCn},   //     [2] <reserved-1F8AE>..<reserved-1F8AF>
-	{0x1F8B0, 0x1F8B1, prID, gcSo},   //     [2] ARROW POINTING UPWARDS THEN NORTH WEST..ARROW POINTING RIGHTWARDS THEN CURVING SOUTH WEST
-	{0x1F8B2, 0x1F8FF, prID, gcCn},   //    [78] <reserved-1F8B2>..<reserved-1F8FF>
+	{0x1F8B0, 0x1F8BB, prAL, gcSo},   //    [12] ARROW POINTING UPWARDS THEN NORTH WEST..SOUTH WEST ARROW FROM BAR
+	{0x1F8C0, 0x1F8C1, prAL, gcSo},   //     [2] LEFTWARDS ARROW FROM DOWNWARDS ARROW..RIGHTWARDS ARROW FROM DOWNWARDS ARROW
+	{0x1F8D0, 0x1F8D8, prAL, gcSm},   //     [9] LONG RIGHTWARDS ARROW OVER LONG LEFTWARDS ARROW..LONG LEFT RIGHT ARROW WITH DEPENDENT LOBE
 	{0x1F900, 0x1F90B, prAL, gcSo},   //    [12] CIRCLED CROSS FORMEE WITH FOUR DOTS..DOWNWARD FACING NOTCHED HOOK WITH DOT
 	{0x1F90C, 0x1F90C, prEB, gcSo},   //         PINCHED FINGERS
 	{0x1F90D, 0x1F90E, prID, gcSo},   //     [2] WHITE HEART..BROWN HEART
@@ -3506,46 +3615,53 @@ var lineBreakCodePoints = [][4]int{
 	{0x1F9D0, 0x1F9D0, prID, gcSo},   //         FACE WITH MONOCLE
 	{0x1F9D1, 0x1F9DD, prEB, gcSo},   //    [13] ADULT..ELF
 	{0x1F9DE, 0x1F9FF, prID, gcSo},   //    [34] GENIE..NAZAR AMULET
-	{0x1FA00, 0x1FA53, prAL, gcSo},   //    [84] NEUTRAL CHESS KING..BLACK CHESS KNIGHT-BISHOP
-	{0x1FA54, 0x1FA5F, prID, gcCn},   //    [12] <reserved-1FA54>..<reserved-1FA5F>
+	{0x1FA00, 0x1FA57, prAL, gcSo},   //    [88] NEUTRAL CHESS KING..BLACK CHESS ALFIL
+	{0x1FA58, 0x1FA5F, prID, gcCn},   //     [8] <reserved-1FA58>..<reserved-1FA5F>
 	{0x1FA60, 0x1FA6D, prID, gcSo},   //    [14] XIANGQI RED GENERAL..XIANGQI BLACK SOLDIER
 	{0x1FA6E, 0x1FA6F, prID, gcCn},   //     [2] <reserved-1FA6E>..<reserved-1FA6F>
 	{0x1FA70, 0x1FA7C, prID, gcSo},   //    [13] BALLET SHOES..CRUTCH
 	{0x1FA7D, 0x1FA7F, prID, gcCn},   //     [3] <reserved-1FA7D>..<reserved-1FA7F>
-	{0x1FA80, 0x1FA88, prID, gcSo},   //     [9] YO-YO..FLUTE
-	{0x1FA89, 0x1FA8F, prID, gcCn},   //     [7] <reserved-1FA89>..<reserved-1FA8F>
-	{0x1FA90, 0x1FABD, prID, gcSo},   //    [46] RINGED PLANET..WING
-	{0x1FABE, 0x1FABE, prID, gcCn},   //         <reserved-1FABE>
-	{0x1FABF, 0x1FAC2, prID, gcSo},   //     [4] GOOSE..PEOPLE HUGGING
+	{0x1FA80, 0x1FA8A, prID, gcSo},   //    [11] YO-YO..TROMBONE
+	{0x1FA8B, 0x1FA8D, prID, gcCn},   //     [3] <reserved-1FA8B>..<reserved-1FA8D>
+	{0x1FA8E, 0x1FAC2, prID, gcSo},   //    [53] TREASURE CHEST..PEOPLE HUGGING
 	{0x1FAC3, 0x1FAC5, prEB, gcSo},   //     [3] PREGNANT MAN..PERSON WITH CROWN
-	{0x1FAC6, 0x1FACD, prID, gcCn},   //     [8] <reserved-1FAC6>..<reserved-1FACD>
-	{0x1FACE, 0x1FADB, prID, gcSo},   //    [14] MOOSE..PEA POD
-	{0x1FADC, 0x1FADF, prID, gcCn},   //     [4] <reserved-1FADC>..<reserved-1FADF>
-	{0x1FAE0, 0x1FAE8, prID, gcSo},   //     [9] MELTING FACE..SHAKING FACE
-	{0x1FAE9, 0x1FAEF, prID, gcCn},   //     [7] <reserved-1FAE9>..<reserved-1FAEF>
+	{0x1FAC6, 0x1FAC6, prID, gcSo},   //         FINGERPRINT
+	{0x1FAC7, 0x1FAC7, prID, gcCn},   //         <reserved-1FAC7>
+	{0x1FAC8, 0x1FAC8, prID, gcSo},   //         HAIRY CREATURE
+	{0x1FAC9, 0x1FACC, prID, gcCn},   //     [4] <reserved-1FAC9>..<reserved-1FACC>
+	{0x1FACD, 0x1FADC, prID, gcSo},   //    [16] ORCA..ROOT VEGETABLE
+	{0x1FADD, 0x1FADE, prID, gcCn},   //     [2] <reserved-1FADD>..<reserved-1FADE>
+	{0x1FADF, 0x1FAEA, prID, gcSo},   //    [12] SPLATTER..DISTORTED FACE
+	{0x1FAEB, 0x1FAEE, prID, gcCn},   //     [4] <reserved-1FAEB>..<reserved-1FAEE>
+	{0x1FAEF, 0x1FAEF, prID, gcSo},   //         FIGHT CLOUD
 	{0x1FAF0, 0x1FAF8, prEB, gcSo},   //     [9] HAND WITH INDEX FINGER AND THUMB CROSSED..RIGHTWARDS PUSHING HAND
 	{0x1FAF9, 0x1FAFF, prID, gcCn},   //     [7] <reserved-1FAF9>..<reserved-1FAFF>
 	{0x1FB00, 0x1FB92, prAL, gcSo},   //   [147] BLOCK SEXTANT-1..UPPER HALF INVERSE MEDIUM SHADE AND LOWER HALF BLOCK
-	{0x1FB94, 0x1FBCA, prAL, gcSo},   //    [55] LEFT HALF INVERSE MEDIUM SHADE AND RIGHT HALF BLOCK..WHITE UP-POINTING CHEVRON
+	{0x1FB94, 0x1FBEF, prAL, gcSo},   //    [92] LEFT HALF INVERSE MEDIUM SHADE AND RIGHT HALF BLOCK..TOP LEFT JUSTIFIED LOWER RIGHT QUARTER BLACK CIRCLE
 	{0x1FBF0, 0x1FBF9, prNU, gcNd},   //    [10] SEGMENTED DIGIT ZERO..SEGMENTED DIGIT NINE
+	{0x1FBFA, 0x1FBFA, prAL, gcSo},   //         ALARM BELL SYMBOL
 	{0x1FC00, 0x1FFFD, prID, gcCn},   //  [1022] <reserved-1FC00>..<reserved-1FFFD>
 	{0x20000, 0x2A6DF, prID, gcLo},   // [42720] CJK UNIFIED IDEOGRAPH-20000..CJK UNIFIED IDEOGRAPH-2A6DF
 	{0x2A6E0, 0x2A6FF, prID, gcCn},   //    [32] <reserved-2A6E0>..<reserved-2A6FF>
-	{0x2A700, 0x2B739, prID, gcLo},   //  [4154] CJK UNIFIED IDEOGRAPH-2A700..CJK UNIFIED IDEOGRAPH-2B739
-	{0x2B73A, 0x2B73F, prID, gcCn},   //     [6] <reserved-2B73A>..<reserved-2B73F>
+	{0x2A700, 0x2B73F, prID, gcLo},   //  [4160] CJK UNIFIED IDEOGRAPH-2A700..CJK UNIFIED IDEOGRAPH-2B73F
 	{0x2B740, 0x2B81D, prID, gcLo},   //   [222] CJK UNIFIED IDEOGRAPH-2B740..CJK UNIFIED IDEOGRAPH-2B81D
 	{0x2B81E, 0x2B81F, prID, gcCn},   //     [2] <reserved-2B81E>..<reserved-2B81F>
-	{0x2B820, 0x2CEA1, prID, gcLo},   //  [5762] CJK UNIFIED IDEOGRAPH-2B820..CJK UNIFIED IDEOGRAPH-2CEA1
-	{0x2CEA2, 0x2CEAF, prID, gcCn},   //    [14] <reserved-2CEA2>..<reserved-2CEAF>
+	{0x2B820, 0x2CEAD, prID, gcLo},   //  [5774] CJK UNIFIED IDEOGRAPH-2B820..CJK UNIFIED IDEOGRAPH-2CEAD
+	{0x2CEAE, 0x2CEAF, prID, gcCn},   //     [2] <reserved-2CEAE>..<reserved-2CEAF>
 	{0x2CEB0, 0x2EBE0, prID, gcLo},   //  [7473] CJK UNIFIED IDEOGRAPH-2CEB0..CJK UNIFIED IDEOGRAPH-2EBE0
-	{0x2EBE1, 0x2F7FF, prID, gcCn},   //  [3103] <reserved-2EBE1>..<reserved-2F7FF>
+	{0x2EBE1, 0x2EBEF, prID, gcCn},   //    [15] <reserved-2EBE1>..<reserved-2EBEF>
+	{0x2EBF0, 0x2EE5D, prID, gcLo},   //   [622] CJK UNIFIED IDEOGRAPH-2EBF0..CJK UNIFIED IDEOGRAPH-2EE5D
+	{0x2EE5E, 0x2EE5F, prID, gcCn},   //     [2] <reserved-2EE5E>..<reserved-2EE5F>
+	{0x2EE60, 0x2F7FF, prID, gcCn},   //  [2464] <reserved-2EE60>..<reserved-2F7FF>
 	{0x2F800, 0x2FA1D, prID, gcLo},   //   [542] CJK COMPATIBILITY IDEOGRAPH-2F800..CJK COMPATIBILITY IDEOGRAPH-2FA1D
 	{0x2FA1E, 0x2FA1F, prID, gcCn},   //     [2] <reserved-2FA1E>..<reserved-2FA1F>
 	{0x2FA20, 0x2FFFD, prID, gcCn},   //  [1502] <reserved-2FA20>..<reserved-2FFFD>
 	{0x30000, 0x3134A, prID, gcLo},   //  [4939] CJK UNIFIED IDEOGRAPH-30000..CJK UNIFIED IDEOGRAPH-3134A
 	{0x3134B, 0x3134F, prID, gcCn},   //     [5] <reserved-3134B>..<reserved-3134F>
 	{0x31350, 0x323AF, prID, gcLo},   //  [4192] CJK UNIFIED IDEOGRAPH-31350..CJK UNIFIED IDEOGRAPH-323AF
-	{0x323B0, 0x3FFFD, prID, gcCn},   // [56398] <reserved-323B0>..<reserved-3FFFD>
+	{0x323B0, 0x33479, prID, gcLo},   //  [4298] CJK UNIFIED IDEOGRAPH-323B0..CJK UNIFIED IDEOGRAPH-33479
+	{0x3347A, 0x3347F, prID, gcCn},   //     [6] <reserved-3347A>..<reserved-3347F>
+	{0x33480, 0x3FFFD, prID, gcCn},   // [52094] <reserved-33480>..<reserved-3FFFD>
 	{0xE0001, 0xE0001, prCM, gcCf},   //         LANGUAGE TAG
 	{0xE0020, 0xE007F, prCM, gcCf},   //    [96] TAG SPACE..CANCEL TAG
 	{0xE0100, 0xE01EF, prCM, gcMn},   //   [240] VARIATION SELECTOR-17..VARIATION SELECTOR-256
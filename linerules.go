@@ -21,14 +21,21 @@ const (
 	lbIS
 	lbSY
 	lbOP
+	lbOPSP
 	lbQU
 	lbQUSP
+	lbQUPi
+	lbQUPiSP
+	lbQUPf
+	lbQUPfEA
 	lbNS
 	lbCLCPSP
 	lbB2
 	lbB2SP
 	lbCB
 	lbBB
+	lbLB20aHY
+	lbLB20aHH
 	lbLB21a
 	lbHL
 	lbAL
@@ -50,8 +57,12 @@ const (
 	lbOddRI
 	lbEvenRI
 	lbExtPicCn
-	lbZWJBit     = 64
-	lbCPeaFWHBit = 128
+	lbAP
+	lbAK
+	lbAKVI
+	lbDottedCircle
+	lbZWJBit       = 64
+	lbEastAsianBit = 128
 )
 
 // These constants define whether a given text may be broken into the next line.
@@ -67,8 +78,42 @@ const (
 // lbTransitions implements the line break parser's state transitions. It's
 // anologous to [grTransitions], see comments there for details.
 //
-// Unicode version 15.0.0.
+// Unicode version 17.0.0. Rules LB15a to LB15c, LB19a, LB20a, LB28a, as well
+// as the East Asian conditions of LB30 are implemented in
+// [transitionLineBreakState] as they depend on neighboring characters or on
+// properties other than Line_Break. If [unicode15] is true, the transitions of
+// Unicode version 15.0.0 are used instead.
 func lbTransitions(state, prop int) (newState, lineBreak, rule int) {
+	if unicode15 {
+		switch uint64(state) | uint64(prop)<<32 {
+		// LB13 (IS).
+		case lbAny | prIS<<32:
+			return lbIS, LineCanBreak, 310
+
+		// LB14.
+		case lbOP | prSP<<32:
+			return lbOP, LineDontBreak, 70
+
+		// LB15.
+		case lbQU | prSP<<32:
+			return lbQUSP, LineDontBreak, 70
+		case lbQU | prOP<<32:
+			return lbOP, LineDontBreak, 150
+		case lbQUSP | prOP<<32:
+			return lbOP, LineDontBreak, 150
+		case lbQUSP | prAny<<32:
+			return lbAny, LineCanBreak, 180
+
+		// LB21a.
+		case lbHL | prBA<<32:
+			return lbLB21a, LineDontBreak, 210
+
+		// Transitions introduced with later versions.
+		case lbLB21a | prHL<<32, lbIS | prNU<<32:
+			return -1, -1, -1
+		}
+	}
+
 	switch uint64(state) | uint64(prop)<<32 {
 	// LB4.
 	case lbBK | prAny<<32:
@@ -125,8 +170,6 @@ func lbTransitions(state, prop int) (newState, lineBreak, rule int) {
 		return lbCP, LineCanBreak, 310
 	case lbAny | prEX<<32:
 		return lbEX, LineDontBreak, 130
-	case lbAny | prIS<<32:
-		return lbIS, LineCanBreak, 310
 	case lbAny | prSY<<32:
 		return lbSY, LineCanBreak, 310
 
@@ -134,17 +177,27 @@ func lbTransitions(state, prop int) (newState, lineBreak, rule int) {
 	case lbAny | prOP<<32:
 		return lbOP, LineCanBreak, 310
 	case lbOP | prSP<<32:
-		return lbOP, LineDontBreak, 70
+		return lbOPSP, LineDontBreak, 70
+	case lbOPSP | prSP<<32:
+		return lbOPSP, LineDontBreak, 70
 	case lbOP | prAny<<32:
 		return lbAny, LineDontBreak, 140
+	case lbOPSP | prAny<<32:
+		return lbAny, LineDontBreak, 140
 
-	// LB15.
-	case lbQU | prSP<<32:
-		return lbQUSP, LineDontBreak, 70
-	case lbQU | prOP<<32:
-		return lbOP, LineDontBreak, 150
-	case lbQUSP | prOP<<32:
-		return lbOP, LineDontBreak, 150
+	// LB15a.
+	case lbQUPi | prSP<<32:
+		return lbQUPiSP, LineDontBreak, 70
+	case lbQUPiSP | prSP<<32:
+		return lbQUPiSP, LineDontBreak, 70
+	case lbQUPi | prAny<<32:
+		return lbAny, LineDontBreak, 151
+	case lbQUPiSP | prAny<<32:
+		return lbAny, LineDontBreak, 151
+
+	// LB15d.
+	case lbAny | prIS<<32:
+		return lbIS, LineDontBreak, 154
 
 	// LB16.
 	case lbCL | prSP<<32:
@@ -155,6 +208,8 @@ func lbTransitions(state, prop int) (newState, lineBreak, rule int) {
 		return lbCLCPSP, LineDontBreak, 70
 	case lbNUCP | prSP<<32:
 		return lbCLCPSP, LineDontBreak, 70
+	case lbCLCPSP | prSP<<32:
+		return lbCLCPSP, LineDontBreak, 70
 	case lbCL | prNS<<32:
 		return lbNS, LineDontBreak, 160
 	case lbNUCL | prNS<<32:
@@ -171,6 +226,8 @@ func lbTransitions(state, prop int) (newState, lineBreak, rule int) {
 		return lbB2, LineCanBreak, 310
 	case lbB2 | prSP<<32:
 		return lbB2SP, LineDontBreak, 70
+	case lbB2SP | prSP<<32:
+		return lbB2SP, LineDontBreak, 70
 	case lbB2 | prB2<<32:
 		return lbB2, LineDontBreak, 170
 	case lbB2SP | prB2<<32:
@@ -179,8 +236,6 @@ func lbTransitions(state, prop int) (newState, lineBreak, rule int) {
 	// LB18.
 	case lbSP | prAny<<32:
 		return lbAny, LineCanBreak, 180
-	case lbQUSP | prAny<<32:
-		return lbAny, LineCanBreak, 180
 	case lbCLCPSP | prAny<<32:
 		return lbAny, LineCanBreak, 180
 	case lbB2SP | prAny<<32:
@@ -192,17 +247,33 @@ func lbTransitions(state, prop int) (newState, lineBreak, rule int) {
 	case lbQU | prAny<<32:
 		return lbAny, LineDontBreak, 190
 
+	// LB19a.
+	case lbQUPf | prAny<<32:
+		return lbAny, LineDontBreak, 191
+
 	// LB20.
 	case lbAny | prCB<<32:
 		return lbCB, LineCanBreak, 200
 	case lbCB | prAny<<32:
 		return lbAny, LineCanBreak, 200
 
+	// LB20a.
+	case lbLB20aHY | prAL<<32:
+		return lbAL, LineDontBreak, 201
+	case lbLB20aHY | prHL<<32:
+		return lbHL, LineDontBreak, 201
+	case lbLB20aHH | prAL<<32:
+		return lbAL, LineDontBreak, 201
+	case lbLB20aHH | prHL<<32:
+		return lbHL, LineDontBreak, 201
+
 	// LB21.
 	case lbAny | prBA<<32:
 		return lbBA, LineDontBreak, 210
 	case lbAny | prHY<<32:
 		return lbHY, LineDontBreak, 210
+	case lbAny | prHH<<32:
+		return lbBA, LineDontBreak, 210 // HH acts like BA from here on.
 	case lbAny | prNS<<32:
 		return lbNS, LineDontBreak, 210
 	case lbAny | prBB<<32:
@@ -215,8 +286,10 @@ func lbTransitions(state, prop int) (newState, lineBreak, rule int) {
 		return lbHL, LineCanBreak, 310
 	case lbHL | prHY<<32:
 		return lbLB21a, LineDontBreak, 210
-	case lbHL | prBA<<32:
+	case lbHL | prHH<<32:
 		return lbLB21a, LineDontBreak, 210
+	case lbLB21a | prHL<<32:
+		return lbHL, LineCanBreak, 310
 	case lbLB21a | prAny<<32:
 		return lbAny, LineDontBreak, 211
 
@@ -267,6 +340,8 @@ func lbTransitions(state, prop int) (newState, lineBreak, rule int) {
 		return lbPO, LineDontBreak, 231
 	case lbEB | prPO<<32:
 		return lbPO, LineDontBreak, 231
+	case lbExtPicCn | prPO<<32:
+		return lbPO, LineDontBreak, 231
 
 	// LB24.
 	case lbAny | prPO<<32:
@@ -297,6 +372,10 @@ func lbTransitions(state, prop int) (newState, lineBreak, rule int) {
 		return lbNU, LineDontBreak, 250
 	case lbHY | prNU<<32:
 		return lbNU, LineDontBreak, 250
+	case lbIS | prNU<<32:
+		return lbNU, LineDontBreak, 250
+	case lbLB20aHY | prNU<<32:
+		return lbNU, LineDontBreak, 250
 	case lbNU | prNU<<32:
 		return lbNUNU, LineDontBreak, 250
 	case lbNU | prSY<<32:
@@ -426,6 +505,24 @@ func lbTransitions(state, prop int) (newState, lineBreak, rule int) {
 	case lbHL | prHL<<32:
 		return lbHL, LineDontBreak, 280
 
+	// LB28a (U+25CC DOTTED CIRCLE is handled in transitionLineBreakState).
+	case lbAny | prAP<<32:
+		return lbAP, LineCanBreak, 310
+	case lbAny | prAK<<32:
+		return lbAK, LineCanBreak, 310
+	case lbAny | prAS<<32:
+		return lbAK, LineCanBreak, 310
+	case lbAP | prAK<<32:
+		return lbAK, LineDontBreak, 281
+	case lbAP | prAS<<32:
+		return lbAK, LineDontBreak, 281
+	case lbAK | prVF<<32:
+		return lbAny, LineDontBreak, 281
+	case lbAK | prVI<<32:
+		return lbAKVI, LineDontBreak, 281
+	case lbAKVI | prAK<<32:
+		return lbAK, LineDontBreak, 281
+
 	// LB29.
 	case lbIS | prAL<<32:
 		return lbAL, LineDontBreak, 290
@@ -444,18 +541,17 @@ func lbTransitions(state, prop int) (newState, lineBreak, rule int) {
 // transitionLineBreakState determines the new state of the line break parser
 // given the current state and the next code point. It also returns the type of
 // line break: LineDontBreak, LineCanBreak, or LineMustBreak. If more than one
-// code point is needed to determine the new state, the byte slice or the string
-// starting after rune "r" can be used (whichever is not nil or empty) for
-// further lookups.
-func transitionLineBreakState(state int, r rune, b []byte, str string) (newState int, lineBreak int) {
+// code point is needed to determine the new state, the text starting after rune
+// "r" can be used for further lookups.
+func transitionLineBreakState[T text](state int, r rune, rest T) (newState int, lineBreak int) {
 	// Determine the property of the next character.
 	nextProperty, generalCategory := propertyLineBreak(r)
 
 	// Prepare.
-	var forceNoBreak, isCPeaFWH bool
-	if state >= 0 && state&lbCPeaFWHBit != 0 {
-		isCPeaFWH = true // LB30: CP but ea is not F, W, or H.
-		state = state &^ lbCPeaFWHBit
+	var forceNoBreak, isEastAsian, isDottedCircle, eastAsian bool
+	if state >= 0 && state&lbEastAsianBit != 0 {
+		isEastAsian = true // LB19a, LB30: The last character's ea is F, W, or H.
+		state = state &^ lbEastAsianBit
 	}
 	if state >= 0 && state&lbZWJBit != 0 {
 		state = state &^ lbZWJBit // Extract zero-width joiner bit.
@@ -463,12 +559,14 @@ func transitionLineBreakState(state int, r rune, b []byte, str string) (newState
 	}
 
 	defer func() {
-		// Transition into LB30.
-		if newState == lbCP || newState == lbNUCP {
-			ea := propertyEastAsianWidth(r)
-			if ea != prF && ea != prW && ea != prH {
-				newState |= lbCPeaFWHBit
-			}
+		// LB28a: U+25CC DOTTED CIRCLE is an AL which also acts as an aksara base.
+		if !unicode15 && newState == lbAL && r == dottedCircle {
+			newState = lbDottedCircle
+		}
+
+		// Remember East Asian characters for LB19a and LB30.
+		if eastAsian {
+			newState |= lbEastAsianBit
 		}
 
 		// Override break.
@@ -497,18 +595,30 @@ func transitionLineBreakState(state int, r rune, b []byte, str string) (newState
 			bit = lbZWJBit
 		}
 		mustBreakState := state < 0 || state == lbBK || state == lbCR || state == lbLF || state == lbNL
-		if !mustBreakState && state != lbSP && state != lbZW && state != lbQUSP && state != lbCLCPSP && state != lbB2SP {
+		if !mustBreakState && state != lbSP && state != lbZW && state != lbOPSP && state != lbQUSP && state != lbQUPiSP && state != lbCLCPSP && state != lbB2SP {
 			// LB9.
+			eastAsian = isEastAsian
 			return state | bit, LineDontBreak
 		} else {
 			// LB10.
 			if mustBreakState {
 				return lbAL | bit, LineMustBreak
 			}
+			if state == lbOPSP || state == lbQUPiSP {
+				return lbAL | bit, LineDontBreak // LB14, LB15a.
+			}
 			return lbAL | bit, LineCanBreak
 		}
 	}
 
+	eastAsian = isEastAsianRune(r)
+
+	// LB28a: U+25CC DOTTED CIRCLE is otherwise treated as AL.
+	if state == lbDottedCircle {
+		isDottedCircle = true
+		state = lbAL
+	}
+
 	// Find the applicable transition in the table.
 	var rule int
 	newState, lineBreak, rule = lbTransitions(state, nextProperty)
@@ -541,38 +651,138 @@ func transitionLineBreakState(state int, r rune, b []byte, str string) (newState
 	// LB12a.
 	if rule > 121 &&
 		nextProperty == prGL &&
-		(state != lbSP && state != lbBA && state != lbHY && state != lbLB21a && state != lbQUSP && state != lbCLCPSP && state != lbB2SP) {
+		(state != lbSP && state != lbBA && state != lbHY && state != lbLB20aHY && state != lbLB20aHH && state != lbLB21a && state != lbOPSP && state != lbQUSP && state != lbQUPiSP && state != lbCLCPSP && state != lbB2SP) {
 		return lbGL, LineDontBreak
 	}
 
 	// LB13.
-	if rule > 130 && state != lbNU && state != lbNUNU {
+	if rule > 130 && lineBreak != LineDontBreak {
 		switch nextProperty {
 		case prCL:
 			return lbCL, LineDontBreak
 		case prCP:
 			return lbCP, LineDontBreak
 		case prIS:
-			return lbIS, LineDontBreak
+			if unicode15 {
+				return lbIS, LineDontBreak // Replaced by LB15c and LB15d.
+			}
 		case prSY:
 			return lbSY, LineDontBreak
 		}
 	}
 
+	afterSpace := state == lbSP || state == lbOPSP || state == lbQUPiSP || state == lbCLCPSP || state == lbB2SP
+
+	// LB15c.
+	if !unicode15 && rule == 154 && afterSpace {
+		if _, prop, _ := lbLookahead(rest); prop == prNU {
+			return lbIS, LineCanBreak
+		}
+	}
+
+	// Quotation marks.
+	if !unicode15 && nextProperty == prQU {
+		switch generalCategory {
+		case gcPi:
+			// LB19a: Only break before an initial quotation mark if it is
+			// surrounded by East Asian characters.
+			if rule == 190 && isEastAsian && state != lbBB && state != lbLB21a {
+				if r, _, _ := lbLookahead(rest); r != utf8.RuneError && isEastAsianRune(r) {
+					lineBreak = LineCanBreak
+				}
+			}
+
+			// LB15a.
+			if state < 0 ||
+				afterSpace ||
+				state == lbBK ||
+				state == lbCR ||
+				state == lbLF ||
+				state == lbNL ||
+				state == lbOP ||
+				state == lbQU ||
+				state == lbQUPi ||
+				state == lbQUPf ||
+				state == lbQUPfEA ||
+				state == lbGL ||
+				state == lbZW {
+				newState = lbQUPi
+			}
+		case gcPf:
+			// LB15b.
+			if afterSpace && lineBreak != LineDontBreak {
+				switch r, prop, _ := lbLookahead(rest); prop {
+				case prSP, prGL, prWJ, prCL, prQU, prCP, prEX, prIS, prSY, prBK, prCR, prLF, prNL, prZW:
+					lineBreak = LineDontBreak
+				default:
+					if r == utf8.RuneError {
+						lineBreak = LineDontBreak
+					}
+				}
+			}
+
+			// LB19a: Only break after a final quotation mark if it is
+			// surrounded by East Asian characters.
+			if isEastAsian {
+				newState = lbQUPfEA
+			} else {
+				newState = lbQUPf
+			}
+		}
+	}
+
+	// LB19a.
+	if !unicode15 && state == lbQUPfEA && lineBreak == LineCanBreak && !eastAsian {
+		lineBreak = LineDontBreak
+	}
+
+	// LB20a.
+	if !unicode15 && (nextProperty == prHY || nextProperty == prHH) &&
+		(state < 0 ||
+			afterSpace ||
+			state == lbBK ||
+			state == lbCR ||
+			state == lbLF ||
+			state == lbNL ||
+			state == lbZW ||
+			state == lbCB ||
+			state == lbGL) {
+		if nextProperty == prHY {
+			newState = lbLB20aHY
+		} else {
+			newState = lbLB20aHH
+		}
+	}
+
 	// LB25 (look ahead).
 	if rule > 250 &&
 		(state == lbPR || state == lbPO) &&
-		nextProperty == prOP || nextProperty == prHY {
-		var r rune
-		if b != nil { // Byte slice version.
-			r, _ = utf8.DecodeRune(b)
-		} else { // String version.
-			r, _ = utf8.DecodeRuneInString(str)
+		(nextProperty == prOP || nextProperty == prHY) {
+		r, prop, rest := lbLookahead(rest)
+		if !unicode15 && prop == prIS {
+			r, prop, _ = lbLookahead(rest)
 		}
-		if r != utf8.RuneError {
-			pr, _ := propertyLineBreak(r)
-			if pr == prNU {
-				return lbNU, LineDontBreak
+		if r != utf8.RuneError && prop == prNU {
+			return lbNU, LineDontBreak
+		}
+	}
+
+	// LB28a.
+	if !unicode15 && rule > 281 {
+		if nextProperty == prAK || nextProperty == prAS || r == dottedCircle {
+			if state == lbAP || state == lbAKVI && nextProperty != prAS {
+				return newState, LineDontBreak
+			}
+			if state == lbAK || isDottedCircle {
+				if _, prop, _ := lbLookahead(rest); prop == prVF {
+					return newState, LineDontBreak
+				}
+			}
+		} else if isDottedCircle {
+			if nextProperty == prVF {
+				return lbAny, LineDontBreak
+			} else if nextProperty == prVI {
+				return lbAKVI, LineDontBreak
 			}
 		}
 	}
@@ -584,7 +794,7 @@ func transitionLineBreakState(state int, r rune, b []byte, str string) (newState
 			if ea != prF && ea != prW && ea != prH {
 				return lbOP, LineDontBreak
 			}
-		} else if isCPeaFWH {
+		} else if (state == lbCP || state == lbNUCP) && !isEastAsian {
 			switch nextProperty {
 			case prAL:
 				return lbAL, LineDontBreak
@@ -610,17 +820,50 @@ func transitionLineBreakState(state int, r rune, b []byte, str string) (newState
 	}
 
 	// LB30b.
-	if rule > 302 {
-		if nextProperty == prEM {
-			if state == lbEB || state == lbExtPicCn {
-				return prAny, LineDontBreak
+	if rule > 302 && nextProperty == prEM && (state == lbEB || state == lbExtPicCn) {
+		return lbIDEM, LineDontBreak
+	}
+	unassigned := generalCategory == gcCn || generalCategory == gcNone // Unlisted code points are unassigned.
+	if unassigned && propertyGraphemes(r) == prExtendedPictographic {
+		if newState == lbIDEM {
+			newState = lbExtPicCn
+		} else if newState == lbAL {
+			// Most unassigned pictographs are AL since Unicode 17.0. Only an
+			// emoji modifier following them is treated differently.
+			if _, prop, _ := lbLookahead(rest); prop == prEM {
+				newState = lbExtPicCn
 			}
 		}
-		graphemeProperty := propertyGraphemes(r)
-		if graphemeProperty == prExtendedPictographic && generalCategory == gcCn {
-			return lbExtPicCn, LineCanBreak
-		}
 	}
 
 	return
 }
+
+// lbLookahead returns the first code point in the given text which is not a
+// combining mark or a zero-width joiner (see LB9), along with its line break
+// property and the text following it. If there is no such code point,
+// [utf8.RuneError] is returned.
+func lbLookahead[T text](text T) (r rune, prop int, rest T) {
+	rest = text
+	for {
+		r, rest = decodeText(rest)
+		if r == utf8.RuneError {
+			return
+		}
+		prop, _ = propertyLineBreak(r)
+		if prop != prCM && prop != prZWJ {
+			return
+		}
+	}
+}
+
+// isEastAsianRune returns true if the East_Asian_Width property of the given
+// code point is F, W, or H, i.e. if it belongs to the $EastAsian set of UAX
+// #14.
+func isEastAsianRune(r rune) bool {
+	if r < 0x1100 {
+		return false // Fast track, there are no such code points below U+1100.
+	}
+	ea := propertyEastAsianWidth(r)
+	return ea == prF || ea == prW || ea == prH
+}
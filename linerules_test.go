@@ -0,0 +1,71 @@
+//go:build !uniseg_unicode15
+
+package uniseg
+
+import "testing"
+
+// Test the rules which were added in Unicode 16.0 with cases which are not
+// covered by the official test cases.
+func TestLineCasesUnicode16(t *testing.T) {
+	for testNum, testCase := range []struct {
+		original string
+		expected []string
+	}{
+		{"-a", []string{"-a"}},                                                 // LB20a.
+		{"a -b", []string{"a ", "-b"}},                                         // LB20a.
+		{"a « b»", []string{"a ", "« b»"}},                                     // LB15a.
+		{"a .5", []string{"a ", ".5"}},                                         // LB15c.
+		{"a .b", []string{"a .b"}},                                             // LB15d.
+		{"中“文”字", []string{"中", "“文”", "字"}},                                   // LB19a.
+		{"a“b”c", []string{"a“b”c"}},                                           // LB19.
+		{"\u1b13\u1b44\u1b13", []string{"\u1b13\u1b44\u1b13"}},                 // LB28a: AK VI × AK.
+		{"\u1b13\u1b13", []string{"\u1b13", "\u1b13"}},                         // LB999.
+		{"\U00011003\u1b13", []string{"\U00011003\u1b13"}},                     // LB28a: AP × AK.
+		{"\u1bc0\u1bc0\u1bf2", []string{"\u1bc0\u1bc0\u1bf2"}},                 // LB28a: AK × AK VF.
+		{"\u1bc0\u1bf2\u1bc0", []string{"\u1bc0\u1bf2", "\u1bc0"}},             // LB28a: AK × VF.
+		{"\u25cc\u1b44\u1b13", []string{"\u25cc\u1b44\u1b13"}},                 // LB28a: ◌ VI × AK.
+		{"\u1b13\u25cc", []string{"\u1b13", "\u25cc"}},                         // LB999.
+		{"( a", []string{"( a"}},                                               // LB14: OP SP* ×.
+		{"a ( b", []string{"a ", "( b"}},                                       // LB14.
+		{"« a", []string{"« a"}},                                               // LB15a: sot Pi SP* ×.
+		{"x «  a", []string{"x ", "«  a"}},                                     // LB15a.
+		{"(« a»)", []string{"(« a»)"}},                                         // LB15a and LB15b.
+		{"a »b", []string{"a ", "»b"}},                                         // LB15b.
+		{"a » b", []string{"a » ", "b"}},                                       // LB15b: SP × Pf.
+		{"中”文", []string{"中”", "文"}},                                           // LB19a.
+		{"a”中", []string{"a”中"}},                                               // LB19a.
+		{"中”a", []string{"中”a"}},                                               // LB19a.
+		{"\u2010a", []string{"\u2010a"}},                                       // LB20a.
+		{"a \u2010b", []string{"a ", "\u2010b"}},                               // LB20a.
+		{"a -1", []string{"a ", "-1"}},                                         // LB20a and LB25.
+		{"\u05d0-中", []string{"\u05d0-中"}},                                     // LB21a.
+		{"\u05d0\u2010a", []string{"\u05d0\u2010a"}},                           // LB21a.
+		{"a（b", []string{"a", "（b"}},                                           // LB30: East Asian OP.
+		{"a）b", []string{"a）", "b"}},                                           // LB30: East Asian CP.
+		{"1（", []string{"1", "（"}},                                             // LB30.
+		{"\u1b13\u1b44\u1bc0", []string{"\u1b13\u1b44", "\u1bc0"}},             // LB28a: AK VI ÷ AS.
+		{"\U00011003\u1bc0", []string{"\U00011003\u1bc0"}},                     // LB28a: AP × AS.
+		{"\u1bc0\u1b44\u1b13", []string{"\u1bc0\u1b44\u1b13"}},                 // LB28a: AS VI × AK.
+		{"\u25cc\u1bf2", []string{"\u25cc\u1bf2"}},                             // LB28a: ◌ × VF.
+		{"\u25cc\u1bc0", []string{"\u25cc", "\u1bc0"}},                         // LB999.
+		{"\u1b13\u25cc\u1b44\u1b13", []string{"\u1b13", "\u25cc\u1b44\u1b13"}}, // LB28a: AK ÷ ◌ VI × AK.
+	} {
+		var segments []string
+		str, state := testCase.original, -1
+		for len(str) > 0 {
+			var segment string
+			segment, str, _, state = FirstLineSegmentInString(str, state)
+			segments = append(segments, segment)
+		}
+		if len(segments) != len(testCase.expected) {
+			t.Errorf(`Test case %d %q failed: Expected segments %q, got %q`, testNum, testCase.original, testCase.expected, segments)
+			continue
+		}
+		for index, segment := range segments {
+			if segment != testCase.expected[index] {
+				t.Errorf(`Test case %d %q failed: Expected segments %q, got %q`, testNum, testCase.original, testCase.expected, segments)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,463 @@
+package uniseg
+
+import "strconv"
+
+// This file exposes the Unicode properties which this package uses internally.
+// The exported property values are independent of the internal property
+// constants and are translated by the tables at the end of this file. Their
+// numeric values are stable: new values are only ever added at the end. The
+// properties follow the Unicode versions described in the package
+// documentation.
+
+// GraphemeBreak is the Grapheme_Cluster_Break property of a code point, see
+// https://unicode.org/reports/tr29/#Grapheme_Cluster_Break_Property_Values.
+type GraphemeBreak int
+
+// The Grapheme_Cluster_Break property values.
+const (
+	GraphemeBreakOther GraphemeBreak = iota
+	GraphemeBreakCR
+	GraphemeBreakLF
+	GraphemeBreakControl
+	GraphemeBreakExtend
+	GraphemeBreakZWJ
+	GraphemeBreakRegionalIndicator
+	GraphemeBreakPrepend
+	GraphemeBreakSpacingMark
+	GraphemeBreakL
+	GraphemeBreakV
+	GraphemeBreakT
+	GraphemeBreakLV
+	GraphemeBreakLVT
+)
+
+// WordBreak is the Word_Break property of a code point, see
+// https://unicode.org/reports/tr29/#Word_Boundaries.
+type WordBreak int
+
+// The Word_Break property values.
+const (
+	WordBreakOther WordBreak = iota
+	WordBreakCR
+	WordBreakLF
+	WordBreakNewline
+	WordBreakExtend
+	WordBreakZWJ
+	WordBreakRegionalIndicator
+	WordBreakFormat
+	WordBreakKatakana
+	WordBreakHebrewLetter
+	WordBreakALetter
+	WordBreakSingleQuote
+	WordBreakDoubleQuote
+	WordBreakMidNumLet
+	WordBreakMidLetter
+	WordBreakMidNum
+	WordBreakNumeric
+	WordBreakExtendNumLet
+	WordBreakWSegSpace
+)
+
+// SentenceBreak is the Sentence_Break property of a code point, see
+// https://unicode.org/reports/tr29/#Sentence_Boundaries.
+type SentenceBreak int
+
+// The Sentence_Break property values.
+const (
+	SentenceBreakOther SentenceBreak = iota
+	SentenceBreakCR
+	SentenceBreakLF
+	SentenceBreakExtend
+	SentenceBreakSep
+	SentenceBreakFormat
+	SentenceBreakSp
+	SentenceBreakLower
+	SentenceBreakUpper
+	SentenceBreakOLetter
+	SentenceBreakNumeric
+	SentenceBreakATerm
+	SentenceBreakSContinue
+	SentenceBreakSTerm
+	SentenceBreakClose
+)
+
+// LineBreakClass is the Line_Break property of a code point, see
+// https://unicode.org/reports/tr14/#Table1.
+type LineBreakClass int
+
+// The Line_Break property values. Note that the classes AI, CJ, SA, SG, and XX
+// are resolved to other classes before the line breaking rules are applied
+// (rule LB1). [LineBreakOf] returns them unresolved.
+const (
+	LineBreakXX LineBreakClass = iota
+	LineBreakBK
+	LineBreakCR
+	LineBreakLF
+	LineBreakCM
+	LineBreakNL
+	LineBreakSG
+	LineBreakWJ
+	LineBreakZW
+	LineBreakGL
+	LineBreakSP
+	LineBreakZWJ
+	LineBreakB2
+	LineBreakBA
+	LineBreakBB
+	LineBreakHY
+	LineBreakHH
+	LineBreakCB
+	LineBreakCL
+	LineBreakCP
+	LineBreakEX
+	LineBreakIN
+	LineBreakNS
+	LineBreakOP
+	LineBreakQU
+	LineBreakIS
+	LineBreakNU
+	LineBreakPO
+	LineBreakPR
+	LineBreakSY
+	LineBreakAI
+	LineBreakAK
+	LineBreakAL
+	LineBreakAP
+	LineBreakAS
+	LineBreakCJ
+	LineBreakEB
+	LineBreakEM
+	LineBreakH2
+	LineBreakH3
+	LineBreakHL
+	LineBreakID
+	LineBreakJL
+	LineBreakJV
+	LineBreakJT
+	LineBreakRI
+	LineBreakSA
+	LineBreakVF
+	LineBreakVI
+)
+
+// EastAsianWidth is the East_Asian_Width property of a code point, see
+// https://unicode.org/reports/tr11/#ED1.
+type EastAsianWidth int
+
+// The East_Asian_Width property values.
+const (
+	EastAsianWidthN  EastAsianWidth = iota // Neutral
+	EastAsianWidthNa                       // Narrow
+	EastAsianWidthA                        // Ambiguous
+	EastAsianWidthW                        // Wide
+	EastAsianWidthH                        // Halfwidth
+	EastAsianWidthF                        // Fullwidth
+)
+
+// GraphemeBreakOf returns the Grapheme_Cluster_Break property of the given
+// code point.
+func GraphemeBreakOf(r rune) GraphemeBreak {
+	return graphemeBreakValues[propertyGraphemes(r)] // Defaults to GraphemeBreakOther.
+}
+
+// WordBreakOf returns the Word_Break property of the given code point.
+func WordBreakOf(r rune) WordBreak {
+	return wordBreakValues[propertyWords(r)] // Defaults to WordBreakOther.
+}
+
+// SentenceBreakOf returns the Sentence_Break property of the given code point.
+func SentenceBreakOf(r rune) SentenceBreak {
+	return sentenceBreakValues[propertySentences(r)] // Defaults to SentenceBreakOther.
+}
+
+// LineBreakOf returns the Line_Break property of the given code point.
+func LineBreakOf(r rune) LineBreakClass {
+	prop, _ := propertyLineBreak(r)
+	return lineBreakValues[prop] // Defaults to LineBreakXX.
+}
+
+// EastAsianWidthOf returns the East_Asian_Width property of the given code
+// point. See [EastAsianAmbiguousWidth] and [WidthConfig] for how this property
+// affects the width of a string.
+func EastAsianWidthOf(r rune) EastAsianWidth {
+	return eastAsianWidthValues[propertyEastAsianWidth(r)] // Code points not listed are Neutral.
+}
+
+// IsExtendedPictographic returns whether the given code point has the
+// Extended_Pictographic property, see https://unicode.org/reports/tr51/.
+func IsExtendedPictographic(r rune) bool {
+	return propertyGraphemes(r) == prExtendedPictographic
+}
+
+// IsEmojiPresentation returns whether the given code point has the
+// Emoji_Presentation property, i.e. whether it is displayed as an emoji by
+// default, see https://unicode.org/reports/tr51/.
+func IsEmojiPresentation(r rune) bool {
+	return propertiesOf(r).emojiPresentation == prEmojiPresentation
+}
+
+// String returns the short name of the property value, e.g. "CR".
+func (p GraphemeBreak) String() string {
+	return propertyName(graphemeBreakNames[:], int(p), "GraphemeBreak")
+}
+
+// String returns the short name of the property value, e.g. "ALetter".
+func (p WordBreak) String() string {
+	return propertyName(wordBreakNames[:], int(p), "WordBreak")
+}
+
+// String returns the short name of the property value, e.g. "ATerm".
+func (p SentenceBreak) String() string {
+	return propertyName(sentenceBreakNames[:], int(p), "SentenceBreak")
+}
+
+// String returns the short name of the line break class, e.g. "AL".
+func (p LineBreakClass) String() string {
+	return propertyName(lineBreakNames[:], int(p), "LineBreakClass")
+}
+
+// String returns the short name of the property value, e.g. "W".
+func (p EastAsianWidth) String() string {
+	return propertyName(eastAsianWidthNames[:], int(p), "EastAsianWidth")
+}
+
+// propertyName returns the name of the given property value. Values without a
+// name are returned as "typeName(value)".
+func propertyName(names []string, value int, typeName string) string {
+	if value >= 0 && value < len(names) {
+		return names[value]
+	}
+	return typeName + "(" + strconv.Itoa(value) + ")"
+}
+
+// The short names of the property values, as used in the Unicode Character
+// Database.
+var (
+	graphemeBreakNames = [...]string{
+		GraphemeBreakOther:             "Other",
+		GraphemeBreakCR:                "CR",
+		GraphemeBreakLF:                "LF",
+		GraphemeBreakControl:           "Control",
+		GraphemeBreakExtend:            "Extend",
+		GraphemeBreakZWJ:               "ZWJ",
+		GraphemeBreakRegionalIndicator: "Regional_Indicator",
+		GraphemeBreakPrepend:           "Prepend",
+		GraphemeBreakSpacingMark:       "SpacingMark",
+		GraphemeBreakL:                 "L",
+		GraphemeBreakV:                 "V",
+		GraphemeBreakT:                 "T",
+		GraphemeBreakLV:                "LV",
+		GraphemeBreakLVT:               "LVT",
+	}
+	wordBreakNames = [...]string{
+		WordBreakOther:             "Other",
+		WordBreakCR:                "CR",
+		WordBreakLF:                "LF",
+		WordBreakNewline:           "Newline",
+		WordBreakExtend:            "Extend",
+		WordBreakZWJ:               "ZWJ",
+		WordBreakRegionalIndicator: "Regional_Indicator",
+		WordBreakFormat:            "Format",
+		WordBreakKatakana:          "Katakana",
+		WordBreakHebrewLetter:      "Hebrew_Letter",
+		WordBreakALetter:           "ALetter",
+		WordBreakSingleQuote:       "Single_Quote",
+		WordBreakDoubleQuote:       "Double_Quote",
+		WordBreakMidNumLet:         "MidNumLet",
+		WordBreakMidLetter:         "MidLetter",
+		WordBreakMidNum:            "MidNum",
+		WordBreakNumeric:           "Numeric",
+		WordBreakExtendNumLet:      "ExtendNumLet",
+		WordBreakWSegSpace:         "WSegSpace",
+	}
+	sentenceBreakNames = [...]string{
+		SentenceBreakOther:     "Other",
+		SentenceBreakCR:        "CR",
+		SentenceBreakLF:        "LF",
+		SentenceBreakExtend:    "Extend",
+		SentenceBreakSep:       "Sep",
+		SentenceBreakFormat:    "Format",
+		SentenceBreakSp:        "Sp",
+		SentenceBreakLower:     "Lower",
+		SentenceBreakUpper:     "Upper",
+		SentenceBreakOLetter:   "OLetter",
+		SentenceBreakNumeric:   "Numeric",
+		SentenceBreakATerm:     "ATerm",
+		SentenceBreakSContinue: "SContinue",
+		SentenceBreakSTerm:     "STerm",
+		SentenceBreakClose:     "Close",
+	}
+	lineBreakNames = [...]string{
+		LineBreakXX:  "XX",
+		LineBreakBK:  "BK",
+		LineBreakCR:  "CR",
+		LineBreakLF:  "LF",
+		LineBreakCM:  "CM",
+		LineBreakNL:  "NL",
+		LineBreakSG:  "SG",
+		LineBreakWJ:  "WJ",
+		LineBreakZW:  "ZW",
+		LineBreakGL:  "GL",
+		LineBreakSP:  "SP",
+		LineBreakZWJ: "ZWJ",
+		LineBreakB2:  "B2",
+		LineBreakBA:  "BA",
+		LineBreakBB:  "BB",
+		LineBreakHY:  "HY",
+		LineBreakHH:  "HH",
+		LineBreakCB:  "CB",
+		LineBreakCL:  "CL",
+		LineBreakCP:  "CP",
+		LineBreakEX:  "EX",
+		LineBreakIN:  "IN",
+		LineBreakNS:  "NS",
+		LineBreakOP:  "OP",
+		LineBreakQU:  "QU",
+		LineBreakIS:  "IS",
+		LineBreakNU:  "NU",
+		LineBreakPO:  "PO",
+		LineBreakPR:  "PR",
+		LineBreakSY:  "SY",
+		LineBreakAI:  "AI",
+		LineBreakAK:  "AK",
+		LineBreakAL:  "AL",
+		LineBreakAP:  "AP",
+		LineBreakAS:  "AS",
+		LineBreakCJ:  "CJ",
+		LineBreakEB:  "EB",
+		LineBreakEM:  "EM",
+		LineBreakH2:  "H2",
+		LineBreakH3:  "H3",
+		LineBreakHL:  "HL",
+		LineBreakID:  "ID",
+		LineBreakJL:  "JL",
+		LineBreakJV:  "JV",
+		LineBreakJT:  "JT",
+		LineBreakRI:  "RI",
+		LineBreakSA:  "SA",
+		LineBreakVF:  "VF",
+		LineBreakVI:  "VI",
+	}
+	eastAsianWidthNames = [...]string{
+		EastAsianWidthN:  "N",
+		EastAsianWidthNa: "Na",
+		EastAsianWidthA:  "A",
+		EastAsianWidthW:  "W",
+		EastAsianWidthH:  "H",
+		EastAsianWidthF:  "F",
+	}
+)
+
+// The exported property values of the internal property constants. Internal
+// values which are not listed map to the zero value, i.e. "Other", "XX", or
+// "N".
+var (
+	graphemeBreakValues = map[int]GraphemeBreak{
+		prCR:                GraphemeBreakCR,
+		prLF:                GraphemeBreakLF,
+		prControl:           GraphemeBreakControl,
+		prExtend:            GraphemeBreakExtend,
+		prZWJ:               GraphemeBreakZWJ,
+		prRegionalIndicator: GraphemeBreakRegionalIndicator,
+		prPrepend:           GraphemeBreakPrepend,
+		prSpacingMark:       GraphemeBreakSpacingMark,
+		prL:                 GraphemeBreakL,
+		prV:                 GraphemeBreakV,
+		prT:                 GraphemeBreakT,
+		prLV:                GraphemeBreakLV,
+		prLVT:               GraphemeBreakLVT,
+	}
+	wordBreakValues = map[int]WordBreak{
+		prCR:                WordBreakCR,
+		prLF:                WordBreakLF,
+		prNewline:           WordBreakNewline,
+		prExtend:            WordBreakExtend,
+		prZWJ:               WordBreakZWJ,
+		prRegionalIndicator: WordBreakRegionalIndicator,
+		prFormat:            WordBreakFormat,
+		prKatakana:          WordBreakKatakana,
+		prHebrewLetter:      WordBreakHebrewLetter,
+		prALetter:           WordBreakALetter,
+		prSingleQuote:       WordBreakSingleQuote,
+		prDoubleQuote:       WordBreakDoubleQuote,
+		prMidNumLet:         WordBreakMidNumLet,
+		prMidLetter:         WordBreakMidLetter,
+		prMidNum:            WordBreakMidNum,
+		prNumeric:           WordBreakNumeric,
+		prExtendNumLet:      WordBreakExtendNumLet,
+		prWSegSpace:         WordBreakWSegSpace,
+	}
+	sentenceBreakValues = map[int]SentenceBreak{
+		prCR:        SentenceBreakCR,
+		prLF:        SentenceBreakLF,
+		prExtend:    SentenceBreakExtend,
+		prSep:       SentenceBreakSep,
+		prFormat:    SentenceBreakFormat,
+		prSp:        SentenceBreakSp,
+		prLower:     SentenceBreakLower,
+		prUpper:     SentenceBreakUpper,
+		prOLetter:   SentenceBreakOLetter,
+		prNumeric:   SentenceBreakNumeric,
+		prATerm:     SentenceBreakATerm,
+		prSContinue: SentenceBreakSContinue,
+		prSTerm:     SentenceBreakSTerm,
+		prClose:     SentenceBreakClose,
+	}
+	lineBreakValues = map[int]LineBreakClass{
+		prBK:  LineBreakBK,
+		prCR:  LineBreakCR,
+		prLF:  LineBreakLF,
+		prCM:  LineBreakCM,
+		prNL:  LineBreakNL,
+		prSG:  LineBreakSG,
+		prWJ:  LineBreakWJ,
+		prZW:  LineBreakZW,
+		prGL:  LineBreakGL,
+		prSP:  LineBreakSP,
+		prZWJ: LineBreakZWJ,
+		prB2:  LineBreakB2,
+		prBA:  LineBreakBA,
+		prBB:  LineBreakBB,
+		prHY:  LineBreakHY,
+		prHH:  LineBreakHH,
+		prCB:  LineBreakCB,
+		prCL:  LineBreakCL,
+		prCP:  LineBreakCP,
+		prEX:  LineBreakEX,
+		prIN:  LineBreakIN,
+		prNS:  LineBreakNS,
+		prOP:  LineBreakOP,
+		prQU:  LineBreakQU,
+		prIS:  LineBreakIS,
+		prNU:  LineBreakNU,
+		prPO:  LineBreakPO,
+		prPR:  LineBreakPR,
+		prSY:  LineBreakSY,
+		prAI:  LineBreakAI,
+		prAK:  LineBreakAK,
+		prAL:  LineBreakAL,
+		prAP:  LineBreakAP,
+		prAS:  LineBreakAS,
+		prCJ:  LineBreakCJ,
+		prEB:  LineBreakEB,
+		prEM:  LineBreakEM,
+		prH2:  LineBreakH2,
+		prH3:  LineBreakH3,
+		prHL:  LineBreakHL,
+		prID:  LineBreakID,
+		prJL:  LineBreakJL,
+		prJV:  LineBreakJV,
+		prJT:  LineBreakJT,
+		prRI:  LineBreakRI,
+		prSA:  LineBreakSA,
+		prVF:  LineBreakVF,
+		prVI:  LineBreakVI,
+	}
+	eastAsianWidthValues = map[int]EastAsianWidth{
+		prNa: EastAsianWidthNa,
+		prA:  EastAsianWidthA,
+		prW:  EastAsianWidthW,
+		prH:  EastAsianWidthH,
+		prF:  EastAsianWidthF,
+	}
+)
@@ -0,0 +1,114 @@
+package uniseg
+
+import (
+	"fmt"
+	"testing"
+)
+
+// Test the property lookup functions.
+func TestPropertyLookup(t *testing.T) {
+	for index, testCase := range []struct {
+		r            rune
+		grapheme     GraphemeBreak
+		word         WordBreak
+		sentence     SentenceBreak
+		line         LineBreakClass
+		eastAsian    EastAsianWidth
+		pictographic bool
+		presentation bool
+	}{
+		{'a', GraphemeBreakOther, WordBreakALetter, SentenceBreakLower, LineBreakAL, EastAsianWidthNa, false, false},
+		{'7', GraphemeBreakOther, WordBreakNumeric, SentenceBreakNumeric, LineBreakNU, EastAsianWidthNa, false, false},
+		{'.', GraphemeBreakOther, WordBreakMidNumLet, SentenceBreakATerm, LineBreakIS, EastAsianWidthNa, false, false},
+		{'\r', GraphemeBreakCR, WordBreakCR, SentenceBreakCR, LineBreakCR, EastAsianWidthN, false, false},
+		{'\u0301', GraphemeBreakExtend, WordBreakExtend, SentenceBreakExtend, LineBreakCM, EastAsianWidthA, false, false},
+		{'\u200d', GraphemeBreakZWJ, WordBreakZWJ, SentenceBreakExtend, LineBreakZWJ, EastAsianWidthN, false, false},
+		{'\u1100', GraphemeBreakL, WordBreakALetter, SentenceBreakOLetter, LineBreakJL, EastAsianWidthW, false, false},
+		{'가', GraphemeBreakLV, WordBreakALetter, SentenceBreakOLetter, LineBreakH2, EastAsianWidthW, false, false},
+		{'ア', GraphemeBreakOther, WordBreakKatakana, SentenceBreakOLetter, LineBreakID, EastAsianWidthW, false, false},
+		{'Ａ', GraphemeBreakOther, WordBreakALetter, SentenceBreakUpper, LineBreakID, EastAsianWidthF, false, false},
+		{'ｶ', GraphemeBreakOther, WordBreakKatakana, SentenceBreakOLetter, LineBreakID, EastAsianWidthH, false, false},
+		{'©', GraphemeBreakOther, WordBreakOther, SentenceBreakOther, LineBreakAL, EastAsianWidthN, true, false},
+		{'\U0001f600', GraphemeBreakOther, WordBreakOther, SentenceBreakOther, LineBreakID, EastAsianWidthW, true, true},
+		{'\U0001f1e9', GraphemeBreakRegionalIndicator, WordBreakRegionalIndicator, SentenceBreakOther, LineBreakRI, EastAsianWidthN, false, true},
+		{'ก', GraphemeBreakOther, WordBreakOther, SentenceBreakOLetter, LineBreakSA, EastAsianWidthN, false, false},
+	} {
+		if g := GraphemeBreakOf(testCase.r); g != testCase.grapheme {
+			t.Errorf(`Test case %d %U failed: Expected grapheme break property %s, got %s`, index, testCase.r, testCase.grapheme, g)
+		}
+		if w := WordBreakOf(testCase.r); w != testCase.word {
+			t.Errorf(`Test case %d %U failed: Expected word break property %s, got %s`, index, testCase.r, testCase.word, w)
+		}
+		if s := SentenceBreakOf(testCase.r); s != testCase.sentence {
+			t.Errorf(`Test case %d %U failed: Expected sentence break property %s, got %s`, index, testCase.r, testCase.sentence, s)
+		}
+		if l := LineBreakOf(testCase.r); l != testCase.line {
+			t.Errorf(`Test case %d %U failed: Expected line break class %s, got %s`, index, testCase.r, testCase.line, l)
+		}
+		if e := EastAsianWidthOf(testCase.r); e != testCase.eastAsian {
+			t.Errorf(`Test case %d %U failed: Expected East Asian width %s, got %s`, index, testCase.r, testCase.eastAsian, e)
+		}
+		if p := IsExtendedPictographic(testCase.r); p != testCase.pictographic {
+			t.Errorf(`Test case %d %U failed: Expected Extended_Pictographic %t, got %t`, index, testCase.r, testCase.pictographic, p)
+		}
+		if p := IsEmojiPresentation(testCase.r); p != testCase.presentation {
+			t.Errorf(`Test case %d %U failed: Expected Emoji_Presentation %t, got %t`, index, testCase.r, testCase.presentation, p)
+		}
+	}
+}
+
+// Test that all property values found in the tables map to an exported value.
+func TestPropertyValues(t *testing.T) {
+	for _, entry := range graphemeCodePoints {
+		if _, ok := graphemeBreakValues[entry[2]]; !ok && entry[2] != prExtendedPictographic {
+			t.Errorf(`Grapheme break property of %U has no exported value`, entry[0])
+		}
+	}
+	for _, entry := range workBreakCodePoints {
+		if _, ok := wordBreakValues[entry[2]]; !ok && entry[2] != prExtendedPictographic {
+			t.Errorf(`Word break property of %U has no exported value`, entry[0])
+		}
+	}
+	for _, entry := range sentenceBreakCodePoints {
+		if _, ok := sentenceBreakValues[entry[2]]; !ok {
+			t.Errorf(`Sentence break property of %U has no exported value`, entry[0])
+		}
+	}
+	for _, entry := range lineBreakCodePoints {
+		if _, ok := lineBreakValues[entry[2]]; !ok && entry[2] != prXX {
+			t.Errorf(`Line break class of %U has no exported value`, entry[0])
+		}
+	}
+	for _, entry := range eastAsianWidth {
+		if _, ok := eastAsianWidthValues[entry[2]]; !ok && entry[2] != prN {
+			t.Errorf(`East Asian width of %U has no exported value`, entry[0])
+		}
+	}
+}
+
+// Test the names of the property values.
+func TestPropertyNames(t *testing.T) {
+	for index, testCase := range []struct {
+		value    fmt.Stringer
+		expected string
+	}{
+		{GraphemeBreakOther, "Other"},
+		{GraphemeBreakLVT, "LVT"},
+		{WordBreakWSegSpace, "WSegSpace"},
+		{SentenceBreakClose, "Close"},
+		{LineBreakXX, "XX"},
+		{LineBreakHH, "HH"},
+		{LineBreakVI, "VI"},
+		{EastAsianWidthN, "N"},
+		{EastAsianWidthF, "F"},
+		{GraphemeBreak(-1), "GraphemeBreak(-1)"},
+		{WordBreak(100), "WordBreak(100)"},
+		{SentenceBreak(100), "SentenceBreak(100)"},
+		{LineBreakClass(100), "LineBreakClass(100)"},
+		{EastAsianWidth(100), "EastAsianWidth(100)"},
+	} {
+		if name := testCase.value.String(); name != testCase.expected {
+			t.Errorf(`Test case %d failed: Expected name %q, got %q`, index, testCase.expected, name)
+		}
+	}
+}
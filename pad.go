@@ -0,0 +1,94 @@
+package uniseg
+
+import "strings"
+
+// PadRight appends spaces to the given string until its monospace width, as
+// calculated by [StringWidth], equals "width". Strings which are already as
+// wide or wider are returned unchanged. Use [FitWidth] to also shorten strings
+// which are too wide.
+func PadRight(str string, width int) string {
+	return padRight(str, width, nil)
+}
+
+// PadLeft is like [PadRight] but prepends the spaces, i.e. the string is
+// aligned to the right.
+func PadLeft(str string, width int) string {
+	return padLeft(str, width, nil)
+}
+
+// Center is like [PadRight] but distributes the spaces evenly on both sides of
+// the string. If the number of spaces is odd, the right side receives the
+// extra space.
+func Center(str string, width int) string {
+	return center(str, width, nil)
+}
+
+// FitWidth returns a string whose monospace width, as calculated by
+// [StringWidth], is exactly "width". Narrower strings are padded with spaces on
+// the right (see [PadRight]), wider strings are shortened (see
+// [TruncateString], no tail is appended). If a wide character at the cut
+// position does not fit into the remaining space, it is removed and replaced
+// with a space. A width of 0 or less results in an empty string (but see
+// [WidthConfig.FitWidth]).
+func FitWidth(str string, width int) string {
+	return fitWidth(str, width, nil)
+}
+
+// PadRight is like the package-level function [PadRight] but uses this
+// configuration.
+func (c *WidthConfig) PadRight(str string, width int) string {
+	return padRight(str, width, c)
+}
+
+// PadLeft is like the package-level function [PadLeft] but uses this
+// configuration.
+func (c *WidthConfig) PadLeft(str string, width int) string {
+	return padLeft(str, width, c)
+}
+
+// Center is like the package-level function [Center] but uses this
+// configuration.
+func (c *WidthConfig) Center(str string, width int) string {
+	return center(str, width, c)
+}
+
+// FitWidth is like the package-level function [FitWidth] but uses this
+// configuration. If [WidthConfig.EscapeSequences] is true, escape sequences of
+// removed text are kept (see [WidthConfig.TruncateString]). A width of 0 or
+// less then results in a string consisting only of these escape sequences.
+func (c *WidthConfig) FitWidth(str string, width int) string {
+	return fitWidth(str, width, c)
+}
+
+// padRight implements [PadRight] using the given width configuration, which may
+// be nil.
+func padRight(str string, width int, config *WidthConfig) string {
+	if gap := width - stringWidth(str, config); gap > 0 {
+		return str + strings.Repeat(" ", gap)
+	}
+	return str
+}
+
+// padLeft implements [PadLeft] using the given width configuration, which may be
+// nil.
+func padLeft(str string, width int, config *WidthConfig) string {
+	if gap := width - stringWidth(str, config); gap > 0 {
+		return strings.Repeat(" ", gap) + str
+	}
+	return str
+}
+
+// center implements [Center] using the given width configuration, which may be
+// nil.
+func center(str string, width int, config *WidthConfig) string {
+	if gap := width - stringWidth(str, config); gap > 0 {
+		return strings.Repeat(" ", gap/2) + str + strings.Repeat(" ", gap-gap/2)
+	}
+	return str
+}
+
+// fitWidth implements [FitWidth] using the given width configuration, which may
+// be nil.
+func fitWidth(str string, width int, config *WidthConfig) string {
+	return padRight(truncateString(str, width, "", config), width, config)
+}
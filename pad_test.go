@@ -0,0 +1,75 @@
+package uniseg
+
+import "testing"
+
+// Test cases for padding functions.
+var padTestCases = []struct {
+	original string
+	width    int
+	right    string
+	left     string
+	center   string
+	fit      string
+}{
+	{"", 0, "", "", "", ""},
+	{"", 3, "   ", "   ", "   ", "   "},
+	{"abc", -1, "abc", "abc", "abc", ""},
+	{"abc", 3, "abc", "abc", "abc", "abc"},
+	{"abc", 2, "abc", "abc", "abc", "ab"},
+	{"abc", 6, "abc   ", "   abc", " abc  ", "abc   "},
+	{"世界", 5, "世界 ", " 世界", "世界 ", "世界 "},
+	{"世界", 6, "世界  ", "  世界", " 世界 ", "世界  "},
+	{"世界", 3, "世界", "世界", "世界", "世 "},
+	{"世界", 1, "世界", "世界", "世界", " "},
+	{"a世界", 4, "a世界", "a世界", "a世界", "a世 "},
+	{"🇩🇪!", 5, "🇩🇪!  ", "  🇩🇪!", " 🇩🇪! ", "🇩🇪!  "},
+	{"Käse", 6, "Käse  ", "  Käse", " Käse ", "Käse  "},
+}
+
+// Test the padding functions.
+func TestPad(t *testing.T) {
+	for index, testCase := range padTestCases {
+		if actual := PadRight(testCase.original, testCase.width); actual != testCase.right {
+			t.Errorf(`Test case %d %q failed: PadRight(%d) returned %q, expected %q`, index, testCase.original, testCase.width, actual, testCase.right)
+		}
+		if actual := PadLeft(testCase.original, testCase.width); actual != testCase.left {
+			t.Errorf(`Test case %d %q failed: PadLeft(%d) returned %q, expected %q`, index, testCase.original, testCase.width, actual, testCase.left)
+		}
+		if actual := Center(testCase.original, testCase.width); actual != testCase.center {
+			t.Errorf(`Test case %d %q failed: Center(%d) returned %q, expected %q`, index, testCase.original, testCase.width, actual, testCase.center)
+		}
+		if actual := FitWidth(testCase.original, testCase.width); actual != testCase.fit {
+			t.Errorf(`Test case %d %q failed: FitWidth(%d) returned %q, expected %q`, index, testCase.original, testCase.width, actual, testCase.fit)
+		}
+		if testCase.width >= 0 {
+			if width := StringWidth(FitWidth(testCase.original, testCase.width)); width != testCase.width {
+				t.Errorf(`Test case %d %q failed: FitWidth(%d) result has width %d`, index, testCase.original, testCase.width, width)
+			}
+		}
+	}
+}
+
+// Test the padding functions with a width configuration.
+func TestPadConfig(t *testing.T) {
+	config := NewWidthConfig()
+	config.EscapeSequences = true
+	config.EastAsianAmbiguousWidth = 2
+	str := "\x1b[1m±1\x1b[0m"
+	if actual, expected := config.PadRight(str, 5), str+"  "; actual != expected {
+		t.Errorf(`PadRight returned %q, expected %q`, actual, expected)
+	}
+	if actual, expected := config.PadLeft(str, 5), "  "+str; actual != expected {
+		t.Errorf(`PadLeft returned %q, expected %q`, actual, expected)
+	}
+	if actual, expected := config.Center(str, 6), " "+str+"  "; actual != expected {
+		t.Errorf(`Center returned %q, expected %q`, actual, expected)
+	}
+	if actual, expected := config.FitWidth(str, 1), "\x1b[1m\x1b[0m "; actual != expected {
+		t.Errorf(`FitWidth returned %q, expected %q`, actual, expected)
+	}
+	for _, width := range []int{0, -1} {
+		if actual, expected := config.FitWidth(str, width), "\x1b[1m\x1b[0m"; actual != expected {
+			t.Errorf(`FitWidth(%d) returned %q, expected %q`, width, actual, expected)
+		}
+	}
+}
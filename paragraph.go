@@ -0,0 +1,257 @@
+package uniseg
+
+import "unicode/utf8"
+
+// The default penalties used by [WrapStringOptimal].
+const (
+	DefaultHyphenPenalty   = 50
+	DefaultOverfullPenalty = 1000
+)
+
+// OptimalWrapOptions specifies how [WrapStringOptimal] breaks text into lines.
+// Use [NewOptimalWrapOptions] to get the default penalties. A nil pointer
+// results in the default behaviour.
+type OptimalWrapOptions struct {
+	// The options shared with [WrapString].
+	WrapOptions
+
+	// The cost added to lines which end after a hyphen. Higher values make it
+	// less likely that lines are broken after hyphens. Negative values make it
+	// more likely. The default is [DefaultHyphenPenalty].
+	HyphenPenalty int
+
+	// The cost of each cell by which a line exceeds the line width, squared.
+	// Lines only exceed the line width if [WrapOptions.AllowOverflow] is set or
+	// if a single grapheme cluster is wider than the line. The default is
+	// [DefaultOverfullPenalty].
+	OverfullPenalty int
+}
+
+// NewOptimalWrapOptions returns new options for [WrapStringOptimal] with the
+// default penalties. These match the behaviour of a nil pointer.
+func NewOptimalWrapOptions() *OptimalWrapOptions {
+	return &OptimalWrapOptions{
+		HyphenPenalty:   DefaultHyphenPenalty,
+		OverfullPenalty: DefaultOverfullPenalty,
+	}
+}
+
+// lineItem is a line segment which is placed on a line as a whole, as used by
+// [WrapStringOptimal]. Content excludes trailing whitespace.
+type lineItem struct {
+	start, contentEnd, spaceEnd int
+	width, spaceWidth           int
+	hyphen                      bool // Whether the segment ends with a hyphen.
+	mustBreak                   bool // Whether there is a mandatory line break after the segment.
+	tab                         bool // Whether the segment contains horizontal tabs whose width depends on the column.
+}
+
+// WrapStringOptimal is like [WrapString] but instead of filling each line as
+// much as possible (greedy algorithm), it chooses the line breaks such that
+// the lines of each paragraph (the text between mandatory line breaks) are as
+// evenly filled as possible (total-fit algorithm, as introduced by Knuth and
+// Plass). This results in a less ragged right edge.
+//
+// The cost of a line is the square of the number of unused cells at its end,
+// plus any penalties (see [OptimalWrapOptions]). The last line of each
+// paragraph has no cost unless it is overfull. The sum of all costs is
+// minimized. The options may be nil.
+func WrapStringOptimal(str string, width int, options *OptimalWrapOptions) []string {
+	positions := WrapStringOptimalPositions(str, width, options)
+	lines := make([]string, 0, len(positions))
+	for _, position := range positions {
+		lines = append(lines, str[position[0]:position[1]])
+	}
+	return lines
+}
+
+// WrapStringOptimalPositions is like [WrapStringOptimal] but returns the lines
+// as byte positions into the original string. Each element contains the index
+// of the first byte of the line and the index of the first byte not included
+// in the line anymore.
+func WrapStringOptimalPositions(str string, width int, options *OptimalWrapOptions) (lines [][2]int) {
+	if options == nil {
+		options = NewOptimalWrapOptions()
+	}
+	if width <= 0 {
+		return WrapStringPositions(str, width, &options.WrapOptions)
+	}
+	hyphenPenalty, overfullPenalty := options.HyphenPenalty, options.OverfullPenalty
+
+	// Break each paragraph.
+	items := lineItems(str, width, options.AllowOverflow, options.WidthConfig)
+	for len(items) > 0 {
+		var paragraph []lineItem
+		for index, item := range items {
+			if item.mustBreak {
+				paragraph, items = items[:index+1], items[index+1:]
+				break
+			}
+		}
+
+		// Find the cheapest way to break this paragraph (dynamic programming).
+		// costs[j] is the minimum cost of the lines up to and including item j-1
+		// and breaks[j] is the index of the first item on the last such line.
+		costs := make([]int, len(paragraph)+1)
+		breaks := make([]int, len(paragraph)+1)
+		for j := range paragraph {
+			breaks[j+1] = -1
+			var (
+				lineWidth int
+				tab       bool
+			)
+			for i := j; i >= 0; i-- {
+				lineWidth += paragraph[i].width
+				if i < j {
+					lineWidth += paragraph[i].spaceWidth
+				}
+				if tab = tab || paragraph[i].tab; tab {
+					// Tabs depend on the column, measure the whole line.
+					lineWidth = options.WidthConfig.StringWidth(str[paragraph[i].start:paragraph[j].contentEnd])
+				}
+				if i < j && lineWidth > 2*width {
+					break // Too wide, this won't be better.
+				}
+				var cost int
+				if lineWidth > width {
+					cost = overfullPenalty * (lineWidth - width) * (lineWidth - width)
+				} else if j < len(paragraph)-1 {
+					cost = (width - lineWidth) * (width - lineWidth)
+				}
+				if j < len(paragraph)-1 && paragraph[j].hyphen {
+					cost += hyphenPenalty
+				}
+				cost += costs[i]
+				if breaks[j+1] < 0 || cost < costs[j+1] {
+					costs[j+1], breaks[j+1] = cost, i
+				}
+			}
+		}
+
+		// Extract the lines.
+		var paragraphLines [][2]int
+		for j := len(paragraph); j > 0; j = breaks[j] {
+			first, last := paragraph[breaks[j]], paragraph[j-1]
+			end := last.contentEnd
+			if options.KeepTrailingSpace {
+				end = last.spaceEnd
+			}
+			paragraphLines = append(paragraphLines, [2]int{first.start, end})
+		}
+		for index := len(paragraphLines) - 1; index >= 0; index-- {
+			lines = append(lines, paragraphLines[index])
+		}
+	}
+
+	return
+}
+
+// lineItems splits the given string into line segments between break
+// opportunities, as determined by [Step]. Segments consisting only of
+// whitespace are merged into the following segment. Unless "allowOverflow" is
+// true, segments wider than the given width are split between grapheme
+// clusters. The width configuration may be nil.
+func lineItems(str string, width int, allowOverflow bool, config *WidthConfig) (items []lineItem) {
+	var (
+		item     lineItem
+		clusters [][2]int // End positions and widths of the clusters in the current item's content.
+		leading  int      // The width of whitespace-only segments preceding the current item.
+	)
+
+	var (
+		cluster    string
+		boundaries int
+	)
+	state, pos, remaining := -1, 0, str
+	for len(remaining) > 0 {
+		cluster, remaining, boundaries, state = step(remaining, state, config)
+		end := pos + len(cluster)
+		clusterWidth := boundaries >> ShiftWidth
+
+		r, _ := utf8.DecodeRuneInString(cluster)
+		switch prop, _ := propertyLineBreak(r); prop {
+		case prBK, prCR, prLF, prNL:
+			// Mandatory line breaks are not part of the line.
+		case prSP:
+			item.spaceWidth += clusterWidth
+			item.spaceEnd = end
+		default:
+			// Whitespace followed by other characters in the same segment is
+			// part of the content.
+			item.width += item.spaceWidth
+			item.spaceWidth = 0
+			if config != nil && config.TabWidth > 0 && cluster == "\t" {
+				clusterWidth, item.tab = tabWidth(config.TabWidth, leading+item.width), true
+			}
+			item.width += clusterWidth
+			item.contentEnd, item.spaceEnd = end, end
+			item.hyphen = prop == prHY || r == 0x2010 || r == 0xad
+			clusters = append(clusters, [2]int{end, item.width})
+		}
+		pos = end
+
+		lineBreak := boundaries & MaskLine
+		if lineBreak == LineDontBreak {
+			continue
+		}
+		if item.contentEnd <= item.start && lineBreak == LineCanBreak {
+			// Whitespace only. Add it to the next item.
+			leading += item.spaceWidth
+			item.spaceWidth = 0
+			continue
+		}
+		if item.contentEnd <= item.start {
+			item.contentEnd = item.start
+			if item.spaceEnd < item.start {
+				item.spaceEnd = item.start
+			}
+		} else {
+			item.width += leading
+			for index := range clusters {
+				clusters[index][1] += leading
+			}
+		}
+		leading = 0
+		item.mustBreak = lineBreak == LineMustBreak
+
+		// Split items which are too wide.
+		for !allowOverflow && item.width > width && len(clusters) > 1 {
+			var index int
+			for index < len(clusters)-1 && clusters[index+1][1] <= width {
+				index++
+			}
+			chunkEnd, chunkWidth := clusters[index][0], clusters[index][1]
+			items = append(items, lineItem{
+				start:      item.start,
+				contentEnd: chunkEnd,
+				spaceEnd:   chunkEnd,
+				width:      chunkWidth,
+			})
+			clusters = clusters[index+1:]
+			for index := range clusters {
+				clusters[index][1] -= chunkWidth
+			}
+			item.start = chunkEnd
+			item.width -= chunkWidth
+			if item.tab {
+				// The rest starts a new line, so the tabs need to be measured again.
+				var column int
+				for index := range clusters {
+					start := item.start
+					if index > 0 {
+						start = clusters[index-1][0]
+					}
+					column += config.StringWidthAt(str[start:clusters[index][0]], column)
+					clusters[index][1] = column
+				}
+				item.width = column
+			}
+		}
+
+		items = append(items, item)
+		item = lineItem{start: end, contentEnd: end, spaceEnd: end}
+		clusters = clusters[:0]
+	}
+
+	return
+}
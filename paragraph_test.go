@@ -0,0 +1,72 @@
+package uniseg
+
+import (
+	"fmt"
+	"testing"
+)
+
+// The test cases for the WrapStringOptimal function.
+var optimalWrapTestCases = []struct {
+	original string
+	width    int
+	options  *OptimalWrapOptions
+	expected []string
+}{
+	{"", 10, nil, []string{}},
+	{"\n", 10, nil, []string{""}},
+	{"a\n\nb\n", 10, nil, []string{"a", "", "b"}},
+	{"aaa bb cc ddddd", 6, nil, []string{"aaa", "bb cc", "ddddd"}},
+	{"aaa bb cc ddddd", 6, &OptimalWrapOptions{WrapOptions: WrapOptions{KeepTrailingSpace: true}, OverfullPenalty: DefaultOverfullPenalty}, []string{"aaa ", "bb cc ", "ddddd"}},
+	{"aaa bb cc ddddd\naaa bb cc ddddd", 6, nil, []string{"aaa", "bb cc", "ddddd", "aaa", "bb cc", "ddddd"}},
+	{"aaa bb cc ddddd", 0, nil, []string{"aaa bb cc ddddd"}},
+	{"  indented text here", 6, nil, []string{"  inde", "nted", "text", "here"}},
+	{"Supercalifragilistic word", 6, nil, []string{"Superc", "alifra", "gilist", "ic", "word"}},
+	{"Supercalifragilistic word", 6, &OptimalWrapOptions{WrapOptions: WrapOptions{AllowOverflow: true}, OverfullPenalty: DefaultOverfullPenalty}, []string{"Supercalifragilistic", "word"}},
+	{"aa-bb cc", 8, nil, []string{"aa-bb cc"}},
+	{"aa-bb cc", 8, &OptimalWrapOptions{HyphenPenalty: -1000}, []string{"aa-", "bb cc"}},
+	{"x aaaaaaa", 6, &OptimalWrapOptions{WrapOptions: WrapOptions{AllowOverflow: true}, OverfullPenalty: 1}, []string{"x aaaaaaa"}},
+	{"x aaaaaaa", 6, &OptimalWrapOptions{WrapOptions: WrapOptions{AllowOverflow: true}, OverfullPenalty: DefaultOverfullPenalty}, []string{"x", "aaaaaaa"}},
+	{"xx aa-bbbb cc", 7, nil, []string{"xx", "aa-bbbb", "cc"}},
+	{"xx aa-bbbb cc", 7, NewOptimalWrapOptions(), []string{"xx", "aa-bbbb", "cc"}},
+	{"xx aa-bbbb cc", 7, &OptimalWrapOptions{HyphenPenalty: 0, OverfullPenalty: DefaultOverfullPenalty}, []string{"xx aa-", "bbbb cc"}},
+	{"世界世界世界 abc", 5, nil, []string{"世界", "世界", "世界", "abc"}},
+	{"🏳️‍🌈🏳️‍🌈", 1, nil, []string{"🏳️‍🌈", "🏳️‍🌈"}},
+	{"abc de\tf", 6, &OptimalWrapOptions{WrapOptions: WrapOptions{WidthConfig: &WidthConfig{TabWidth: 4}}, OverfullPenalty: DefaultOverfullPenalty}, []string{"abc", "de\tf"}},
+	{"abcdefg\th", 6, &OptimalWrapOptions{WrapOptions: WrapOptions{WidthConfig: &WidthConfig{TabWidth: 4}}, OverfullPenalty: DefaultOverfullPenalty}, []string{"abcdef", "g\th"}},
+}
+
+// Test the WrapStringOptimal function.
+func TestWrapStringOptimal(t *testing.T) {
+	for index, testCase := range optimalWrapTestCases {
+		lines := WrapStringOptimal(testCase.original, testCase.width, testCase.options)
+		if fmt.Sprintf("%q", lines) != fmt.Sprintf("%q", testCase.expected) {
+			t.Errorf(`Test case %d %q (width %d) failed: Expected %q, got %q`, index, testCase.original, testCase.width, testCase.expected, lines)
+		}
+	}
+}
+
+// Test that WrapStringOptimal never results in more raggedness than
+// WrapString.
+func TestWrapStringOptimalRaggedness(t *testing.T) {
+	str := "In olden times when wishing still helped one, there lived a king whose daughters were all beautiful, but the youngest was so beautiful that the sun itself, which has seen so much, was astonished whenever it shone in her face."
+	raggedness := func(lines []string, width int) (cost int) {
+		for _, line := range lines[:len(lines)-1] {
+			cost += (width - StringWidth(line)) * (width - StringWidth(line))
+		}
+		return
+	}
+	for width := 10; width <= 40; width++ {
+		greedy := raggedness(WrapString(str, width, nil), width)
+		optimal := raggedness(WrapStringOptimal(str, width, nil), width)
+		if optimal > greedy {
+			t.Errorf(`Width %d: Optimal raggedness %d is worse than greedy raggedness %d`, width, optimal, greedy)
+		}
+	}
+}
+
+// Benchmark the WrapStringOptimal function.
+func BenchmarkWrapStringOptimal(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		WrapStringOptimal(benchmarkStr, 20, nil)
+	}
+}
@@ -80,6 +80,12 @@ const (
 	prCB
 	prRI
 	prEM
+	prAK
+	prAP
+	prAS
+	prVF
+	prVI
+	prHH
 	prN
 	prNa
 	prA
@@ -87,6 +93,9 @@ const (
 	prH
 	prF
 	prEmojiPresentation
+	prInCBConsonant
+	prInCBExtend
+	prInCBLinker
 )
 
 // Unicode General Categories. Only the ones needed in the context of this
@@ -129,35 +138,36 @@ const (
 const (
 	vs15 = 0xfe0e // Variation Selector-15 (text presentation)
 	vs16 = 0xfe0f // Variation Selector-16 (emoji presentation)
+
+	emojiModifierFirst = 0x1f3fb // EMOJI MODIFIER FITZPATRICK TYPE-1-2
+	emojiModifierLast  = 0x1f3ff // EMOJI MODIFIER FITZPATRICK TYPE-6
+	keycap             = 0x20e3  // COMBINING ENCLOSING KEYCAP
+	dottedCircle       = 0x25cc  // DOTTED CIRCLE
 )
 
-// propertySearch performs a binary search on a property slice and returns the
-// entry whose range (start = first array element, end = second array element)
-// includes r, or an array of 0's if no such entry was found.
-func propertySearch[E interface{ [3]int | [4]int }](dictionary []E, r rune) (result E) {
-	// Run a binary search.
-	from := 0
-	to := len(dictionary)
-	for to > from {
-		middle := (from + to) / 2
-		cpRange := dictionary[middle]
-		if int(r) < cpRange[0] {
-			to = middle
-			continue
-		}
-		if int(r) > cpRange[1] {
-			from = middle + 1
-			continue
-		}
-		return cpRange
-	}
-	return
+// codePointProperties holds all properties of a code point which are needed by
+// the parsers in this package. Code points are mapped to their properties with
+// a three-stage lookup table generated from the individual property tables,
+// see [propertiesOf].
+type codePointProperties struct {
+	grapheme           uint8 // Grapheme cluster break property, see graphemeCodePoints.
+	word               uint8 // Word break property, see workBreakCodePoints.
+	sentence           uint8 // Sentence break property, see sentenceBreakCodePoints.
+	lineBreak          uint8 // Line break property, see lineBreakCodePoints.
+	generalCategory    uint8 // General Category, see lineBreakCodePoints.
+	eastAsianWidth     uint8 // East Asian Width property, see eastAsianWidth.
+	emojiPresentation  uint8 // Emoji_Presentation property, see emojiPresentation.
+	indicConjunctBreak uint8 // Indic_Conjunct_Break property, see indicConjunctBreakCodePoints.
 }
 
-// property returns the Unicode property value (see constants above) of the
-// given code point.
-func property(dictionary [][3]int, r rune) int {
-	return propertySearch(dictionary, r)[2]
+// propertiesOf returns the properties of the given code point. Invalid code
+// points have no properties.
+func propertiesOf(r rune) codePointProperties {
+	if uint32(r) >= 0x110000 {
+		return codePointProperties{}
+	}
+	block := int(propertyTrieIndex[r>>propertyTrieShift]) << propertyTrieShift
+	return propertyTrieValues[propertyTrieBlocks[block|int(r)&(1<<propertyTrieShift-1)]]
 }
 
 // propertyLineBreak returns the Unicode property value and General Category
@@ -173,8 +183,8 @@ func propertyLineBreak(r rune) (property, generalCategory int) {
 	if r >= '0' && r <= '9' {
 		return prNU, gcNd
 	}
-	entry := propertySearch(lineBreakCodePoints, r)
-	return entry[2], entry[3]
+	properties := propertiesOf(r)
+	return int(properties.lineBreak), int(properties.generalCategory)
 }
 
 // propertyGraphemes returns the Unicode grapheme cluster property value of the
@@ -192,7 +202,19 @@ func propertyGraphemes(r rune) int {
 	if r >= 0 && r <= 0x1f || r == 0x7f {
 		return prControl
 	}
-	return property(graphemeCodePoints, r)
+	return int(propertiesOf(r).grapheme)
+}
+
+// propertyWords returns the Unicode word break property value of the given
+// code point.
+func propertyWords(r rune) int {
+	return int(propertiesOf(r).word)
+}
+
+// propertySentences returns the Unicode sentence break property value of the
+// given code point.
+func propertySentences(r rune) int {
+	return int(propertiesOf(r).sentence)
 }
 
 // propertyEastAsianWidth returns the Unicode East Asian Width property value of
@@ -204,5 +226,5 @@ func propertyEastAsianWidth(r rune) int {
 	if r >= 0 && r <= 0x1f || r == 0x7f {
 		return prN
 	}
-	return property(eastAsianWidth, r)
+	return int(propertiesOf(r).eastAsianWidth)
 }
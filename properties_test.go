@@ -0,0 +1,111 @@
+package uniseg
+
+import "testing"
+
+// propertySearch performs a binary search on a property slice and returns the
+// entry whose range (start = first array element, end = second array element)
+// includes r, or an array of 0's if no such entry was found. The parsers use
+// [propertiesOf] instead, which is generated from the same tables. The binary
+// search is only used to verify it.
+func propertySearch[E interface{ [3]int | [4]int }](dictionary []E, r rune) (result E) {
+	// Run a binary search.
+	from := 0
+	to := len(dictionary)
+	for to > from {
+		middle := (from + to) / 2
+		cpRange := dictionary[middle]
+		if int(r) < cpRange[0] {
+			to = middle
+			continue
+		}
+		if int(r) > cpRange[1] {
+			from = middle + 1
+			continue
+		}
+		return cpRange
+	}
+	return
+}
+
+// property returns the Unicode property value (see constants in properties.go)
+// of the given code point, as listed in the given property table.
+func property(dictionary [][3]int, r rune) int {
+	return propertySearch(dictionary, r)[2]
+}
+
+// Test that the three-stage lookup table returns the same properties as the
+// individual property tables, for all code points.
+func TestPropertyTrie(t *testing.T) {
+	for r := rune(0); r < 0x110000; r++ {
+		properties := propertiesOf(r)
+		line := propertySearch(lineBreakCodePoints, r)
+		for _, field := range []struct {
+			name           string
+			trie, expected int
+		}{
+			{"grapheme", int(properties.grapheme), property(graphemeCodePoints, r)},
+			{"word", int(properties.word), property(workBreakCodePoints, r)},
+			{"sentence", int(properties.sentence), property(sentenceBreakCodePoints, r)},
+			{"line break", int(properties.lineBreak), line[2]},
+			{"general category", int(properties.generalCategory), line[3]},
+			{"East Asian width", int(properties.eastAsianWidth), property(eastAsianWidth, r)},
+			{"emoji presentation", int(properties.emojiPresentation), property(emojiPresentation, r)},
+			{"Indic conjunct break", int(properties.indicConjunctBreak), property(indicConjunctBreakCodePoints, r)},
+		} {
+			if field.trie != field.expected {
+				t.Fatalf(`Code point %U failed: Expected %s property %d, got %d`, r, field.name, field.expected, field.trie)
+			}
+		}
+	}
+	for _, r := range []rune{-1, 0x110000, 0x7fffffff} {
+		if properties := propertiesOf(r); properties != (codePointProperties{}) {
+			t.Errorf(`Invalid code point %d failed: Expected no properties, got %v`, r, properties)
+		}
+	}
+}
+
+// The text used to benchmark the property lookups and the step function with
+// non-ASCII text.
+const benchmarkCJKStr = "Unicode（ユニコード）は、世界中の文字を扱うための業界標準です。😀👍🏽🇯🇵 표준 한국어 텍스트도 포함됩니다。"
+
+// Benchmark the binary search in the individual property tables.
+func BenchmarkPropertySearch(b *testing.B) {
+	runes := []rune(benchmarkCJKStr)
+	for i := 0; i < b.N; i++ {
+		for _, r := range runes {
+			resultRunes = append(resultRunes[:0], rune(property(graphemeCodePoints, r)+
+				property(workBreakCodePoints, r)+
+				property(sentenceBreakCodePoints, r)+
+				propertySearch(lineBreakCodePoints, r)[2]+
+				property(eastAsianWidth, r)))
+		}
+	}
+}
+
+// Benchmark the three-stage lookup table.
+func BenchmarkPropertyTrie(b *testing.B) {
+	runes := []rune(benchmarkCJKStr)
+	for i := 0; i < b.N; i++ {
+		for _, r := range runes {
+			properties := propertiesOf(r)
+			resultRunes = append(resultRunes[:0], rune(properties.grapheme+
+				properties.word+
+				properties.sentence+
+				properties.lineBreak+
+				properties.eastAsianWidth))
+		}
+	}
+}
+
+// Benchmark the step function with CJK and emoji text.
+func BenchmarkStepCJK(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var c string
+		state := -1
+		str := benchmarkCJKStr
+		for len(str) > 0 {
+			c, str, _, state = StepString(str, state)
+			resultRunes = []rune(c)
+		}
+	}
+}
@@ -0,0 +1,3300 @@
+//go:build !uniseg_unicode15
+
+// Code generated via go generate from gen_properties.go. DO NOT EDIT.
+
+package uniseg
+
+// propertyTrieShift is the number of lower bits of a code point which are
+// used as an index into a block of propertyTrieBlocks.
+const propertyTrieShift = 7
+
+// propertyTrieIndex, propertyTrieBlocks, and propertyTrieValues form a
+// three-stage lookup table for the properties of all code points, see
+// [codePointProperties]. They were derived on October 18, 2026 from the
+// property tables in the following files:
+//
+//   - graphemeproperties.go
+//   - wordproperties.go
+//   - sentenceproperties.go
+//   - lineproperties.go
+//   - eastasianwidth.go
+//   - emojipresentation.go
+//   - incbproperties.go
+var propertyTrieIndex = [8704]uint16{
+	0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15,
+	16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
+	32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 41, 41, 42, 43, 44, 45,
+	46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
+	62, 63, 64, 65, 66, 67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77,
+	78, 79, 80, 81, 82, 82, 83, 79, 84, 85, 86, 87, 88, 89, 90, 91,
+	92, 93, 94, 95, 96, 97, 98, 90, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 100, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	101, 102, 102, 102, 102, 102, 102, 102, 102, 103, 41, 41, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117, 118, 119, 120, 121, 122, 116,
+	117, 118, 119, 120, 121, 122, 116, 117, 118, 119, 120, 121, 122, 116, 117, 118,
+	119, 120, 121, 122, 116, 117, 118, 119, 120, 121, 122, 116, 117, 118, 119, 120,
+	121, 122, 116, 117, 118, 119, 120, 121, 122, 116, 117, 118, 119, 120, 121, 122,
+	116, 117, 118, 119, 120, 121, 122, 116, 117, 118, 119, 120, 121, 122, 116, 117,
+	118, 119, 120, 121, 122, 116, 117, 118, 119, 120, 121, 122, 116, 117, 118, 123,
+	124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 99, 99, 126, 127, 128, 129, 41, 41, 130, 131, 132, 133, 134, 135,
+	136, 137, 138, 139, 140, 141, 142, 143, 144, 145, 146, 147, 41, 41, 148, 149,
+	150, 151, 152, 153, 154, 155, 156, 157, 158, 159, 160, 161, 162, 163, 164, 165,
+	166, 167, 168, 169, 170, 171, 172, 173, 174, 175, 140, 176, 177, 178, 179, 140,
+	180, 181, 182, 183, 184, 185, 186, 187, 188, 189, 190, 191, 140, 192, 193, 194,
+	41, 41, 41, 41, 41, 41, 41, 195, 196, 41, 197, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 198,
+	41, 41, 41, 41, 199, 200, 201, 41, 202, 41, 41, 41, 41, 41, 41, 41,
+	41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41,
+	41, 41, 41, 41, 41, 41, 41, 137, 41, 41, 41, 203, 204, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 205, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	41, 41, 41, 41, 206, 207, 208, 209, 140, 140, 210, 140, 211, 212, 213, 214,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 215, 215, 215, 216, 217, 218, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 219,
+	220, 99, 221, 99, 99, 222, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 223, 224, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 79, 225, 79, 79, 79, 226, 227, 228,
+	79, 229, 230, 231, 232, 233, 234, 140, 235, 236, 237, 238, 239, 240, 241, 242,
+	79, 79, 79, 79, 243, 244, 140, 140, 140, 140, 140, 140, 140, 140, 245, 140,
+	246, 247, 248, 140, 140, 249, 140, 140, 140, 250, 140, 251, 140, 252, 140, 253,
+	41, 254, 255, 140, 140, 140, 140, 140, 256, 257, 258, 140, 259, 260, 140, 140,
+	261, 262, 263, 264, 265, 266, 267, 268, 269, 270, 271, 272, 273, 274, 275, 276,
+	277, 278, 279, 280, 281, 282, 79, 283, 266, 266, 266, 266, 266, 266, 266, 284,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 285, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	286, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 287, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 288, 99, 99, 99, 99, 289, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	99, 99, 99, 99, 291, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 292,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 293, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 294, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 292,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	295, 296, 297, 298, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 299,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 299,
+}
+
+var propertyTrieBlocks = [38400]uint16{
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 2, 3, 3, 4, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	5, 6, 7, 8, 9, 10, 8, 11, 12, 13, 8, 14, 15, 16, 17, 18,
+	19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 20, 15, 21, 21, 21, 6,
+	8, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22,
+	22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 12, 23, 13, 24, 25,
+	24, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26,
+	26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 12, 27, 28, 21, 0,
+	0, 0, 0, 0, 0, 29, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	30, 31, 32, 9, 33, 9, 34, 35, 36, 37, 38, 39, 21, 40, 41, 24,
+	42, 43, 44, 44, 45, 46, 35, 47, 48, 44, 38, 49, 44, 44, 44, 31,
+	50, 50, 50, 50, 50, 50, 51, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	51, 50, 50, 50, 50, 50, 50, 52, 53, 54, 54, 54, 54, 54, 53, 55,
+	55, 55, 56, 56, 56, 56, 55, 56, 55, 55, 55, 56, 55, 55, 56, 56,
+	55, 56, 55, 55, 56, 56, 56, 52, 57, 57, 57, 46, 57, 46, 57, 46,
+	54, 55, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 55, 54, 55, 54, 56, 54, 56, 54, 56, 54, 55, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 53, 55, 54, 56, 54, 55, 54, 56, 54, 56,
+	54, 55, 53, 55, 54, 56, 54, 56, 55, 54, 56, 54, 56, 54, 56, 53,
+	55, 53, 55, 54, 55, 54, 56, 54, 55, 55, 53, 55, 54, 55, 54, 56,
+	54, 56, 53, 55, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 53, 55, 54, 56, 54, 55, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 54, 56, 54, 56, 54, 56, 56,
+	56, 54, 54, 56, 54, 56, 54, 54, 56, 54, 54, 54, 56, 56, 54, 54,
+	54, 54, 56, 54, 54, 56, 54, 54, 54, 56, 56, 56, 54, 54, 56, 54,
+	54, 56, 54, 56, 54, 56, 54, 54, 56, 54, 56, 56, 54, 56, 54, 54,
+	56, 54, 54, 54, 56, 54, 56, 54, 54, 56, 56, 58, 54, 56, 56, 56,
+	58, 58, 58, 58, 54, 54, 56, 54, 54, 56, 54, 54, 56, 54, 55, 54,
+	55, 54, 55, 54, 55, 54, 55, 54, 55, 54, 55, 54, 55, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	56, 54, 54, 56, 54, 56, 54, 54, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 56, 56, 56, 56, 56, 56, 54, 54, 56, 54, 54, 56,
+	56, 54, 56, 54, 54, 54, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	46, 57, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 57, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 58, 58, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 60, 60, 60, 60, 60, 60, 60,
+	59, 59, 61, 61, 62, 61, 60, 63, 64, 63, 63, 63, 64, 63, 60, 60,
+	63, 60, 61, 61, 61, 61, 61, 61, 36, 36, 36, 36, 65, 36, 61, 66,
+	59, 59, 59, 59, 59, 61, 61, 61, 61, 61, 61, 61, 60, 61, 60, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 68, 68, 68, 68,
+	68, 68, 68, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	54, 56, 54, 56, 60, 65, 54, 56, 69, 69, 59, 46, 46, 46, 70, 50,
+	69, 69, 69, 69, 65, 65, 50, 71, 50, 50, 50, 69, 50, 69, 54, 54,
+	56, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53,
+	53, 53, 69, 53, 53, 53, 53, 53, 53, 53, 54, 54, 56, 56, 56, 56,
+	56, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55,
+	55, 55, 56, 55, 55, 55, 55, 55, 55, 55, 56, 56, 56, 56, 56, 54,
+	56, 56, 54, 54, 54, 56, 56, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	56, 56, 56, 56, 54, 56, 72, 54, 56, 54, 54, 56, 56, 54, 54, 54,
+	54, 53, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53,
+	53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53,
+	55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55,
+	55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55,
+	56, 55, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 73, 74, 74, 74, 74, 74, 75, 75, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	69, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 50, 69, 69, 60, 76, 76, 76, 77, 76, 71,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 78, 79, 69, 69, 73, 73, 80,
+	69, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 81, 74,
+	82, 74, 74, 82, 74, 74, 83, 74, 69, 69, 69, 69, 69, 69, 69, 69,
+	84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84,
+	84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 69, 69, 69, 69, 84,
+	84, 84, 84, 85, 71, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	86, 86, 86, 86, 86, 86, 72, 72, 72, 87, 87, 88, 70, 70, 73, 73,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 83, 89, 90, 90, 90,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	60, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 87, 92, 93, 82, 58, 58,
+	74, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 90, 58, 74, 74, 74, 74, 74, 74, 74, 86, 73, 74,
+	74, 74, 74, 74, 74, 60, 60, 74, 74, 73, 74, 74, 74, 74, 58, 58,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 58, 58, 58, 73, 73, 58,
+	94, 94, 94, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 69, 95,
+	58, 74, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 69, 69, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 60, 60, 73, 82, 70, 90, 60, 69, 69, 74, 80, 80,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 74, 74, 74, 74, 60, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 60, 74, 74, 74, 60, 74, 74, 74, 74, 74, 69, 69,
+	82, 82, 82, 82, 82, 82, 82, 94, 82, 94, 82, 82, 82, 94, 94, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 74, 74, 74, 69, 69, 82, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 65, 58, 58, 58, 58, 58, 58, 58,
+	86, 86, 69, 69, 69, 69, 69, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 60, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 86, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 96, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 74, 96, 74, 58, 96, 96,
+	96, 74, 74, 74, 74, 74, 74, 74, 74, 96, 96, 96, 96, 98, 96, 96,
+	58, 74, 74, 74, 74, 74, 74, 74, 97, 97, 97, 97, 97, 97, 97, 97,
+	58, 58, 74, 74, 99, 99, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91,
+	82, 60, 58, 58, 58, 58, 58, 58, 97, 97, 97, 97, 97, 97, 97, 97,
+	58, 74, 96, 96, 69, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 58,
+	58, 69, 69, 58, 58, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 97, 97, 97, 69, 97, 97, 97, 97, 97, 97,
+	97, 69, 97, 69, 69, 69, 97, 97, 97, 97, 69, 69, 74, 58, 100, 96,
+	96, 74, 74, 74, 74, 69, 69, 96, 96, 69, 69, 96, 96, 98, 58, 69,
+	69, 69, 69, 69, 69, 69, 69, 100, 69, 69, 69, 69, 97, 97, 69, 97,
+	58, 58, 74, 74, 69, 69, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91,
+	97, 97, 88, 88, 101, 101, 101, 101, 101, 102, 73, 80, 58, 82, 74, 69,
+	69, 74, 74, 96, 69, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 58,
+	58, 69, 69, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 58,
+	58, 69, 58, 58, 69, 58, 58, 69, 58, 58, 69, 69, 74, 69, 96, 96,
+	96, 74, 74, 69, 69, 69, 69, 74, 74, 69, 69, 74, 74, 74, 69, 69,
+	69, 74, 69, 69, 69, 69, 69, 69, 69, 58, 58, 58, 58, 69, 58, 69,
+	69, 69, 69, 69, 69, 69, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91,
+	74, 74, 58, 58, 58, 74, 82, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 74, 74, 96, 69, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58,
+	58, 58, 69, 58, 58, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 97, 97, 97, 69, 97, 97, 97, 97, 97, 97,
+	97, 69, 97, 97, 69, 97, 97, 97, 97, 97, 69, 69, 74, 58, 96, 96,
+	96, 74, 74, 74, 74, 74, 69, 74, 74, 96, 69, 96, 96, 98, 69, 69,
+	58, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 74, 74, 69, 69, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91,
+	82, 80, 69, 69, 69, 69, 69, 69, 69, 97, 74, 74, 74, 74, 74, 74,
+	69, 74, 96, 96, 69, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 58,
+	58, 69, 69, 58, 58, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 97, 97, 97, 69, 97, 97, 97, 97, 97, 97,
+	97, 69, 97, 97, 69, 97, 97, 97, 97, 97, 69, 69, 74, 58, 100, 74,
+	96, 74, 74, 74, 74, 69, 69, 96, 96, 69, 69, 96, 96, 98, 69, 69,
+	69, 69, 69, 69, 69, 74, 74, 100, 69, 69, 69, 69, 97, 97, 69, 97,
+	58, 58, 74, 74, 69, 69, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91,
+	73, 97, 101, 101, 101, 101, 101, 101, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 74, 58, 69, 58, 58, 58, 58, 58, 58, 69, 69, 69, 58, 58,
+	58, 69, 58, 58, 58, 58, 69, 69, 69, 58, 58, 69, 58, 69, 58, 58,
+	69, 69, 69, 58, 58, 69, 69, 69, 58, 58, 58, 69, 69, 69, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 100, 96,
+	74, 96, 96, 69, 69, 69, 96, 96, 96, 69, 96, 96, 96, 74, 69, 69,
+	58, 69, 69, 69, 69, 69, 69, 100, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91,
+	101, 101, 101, 73, 73, 73, 73, 73, 73, 80, 73, 69, 69, 69, 69, 69,
+	74, 96, 96, 96, 74, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 58,
+	58, 69, 58, 58, 58, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 97, 97, 97, 69, 97, 97, 97, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 69, 69, 74, 58, 74, 74,
+	74, 96, 96, 96, 96, 69, 74, 74, 74, 69, 74, 74, 74, 98, 69, 69,
+	69, 69, 69, 69, 69, 74, 74, 69, 97, 97, 97, 69, 58, 58, 69, 69,
+	58, 58, 74, 74, 69, 69, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91,
+	69, 69, 69, 69, 69, 69, 69, 103, 101, 101, 101, 101, 101, 101, 101, 73,
+	58, 74, 96, 96, 103, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 58,
+	58, 69, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 69, 69, 74, 58, 96, 74,
+	100, 96, 100, 96, 96, 69, 74, 100, 100, 69, 100, 100, 74, 74, 69, 69,
+	69, 69, 69, 69, 69, 100, 100, 69, 69, 69, 69, 69, 58, 58, 58, 69,
+	58, 58, 74, 74, 69, 69, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91,
+	69, 58, 58, 96, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	74, 74, 96, 96, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 58,
+	58, 69, 58, 58, 58, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 74, 74, 58, 100, 96,
+	96, 74, 74, 74, 74, 69, 96, 96, 96, 69, 96, 96, 96, 98, 104, 73,
+	69, 69, 69, 69, 58, 58, 58, 100, 101, 101, 101, 101, 101, 101, 101, 58,
+	58, 58, 74, 74, 69, 69, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91,
+	101, 101, 101, 101, 101, 101, 101, 101, 101, 105, 58, 58, 58, 58, 58, 58,
+	69, 74, 96, 96, 69, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 69, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 74, 69, 69, 69, 69, 100,
+	96, 96, 74, 74, 74, 69, 74, 69, 96, 96, 96, 96, 96, 96, 96, 100,
+	69, 69, 69, 69, 69, 69, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91,
+	69, 69, 96, 96, 82, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
+	106, 107, 106, 108, 107, 107, 107, 107, 107, 107, 107, 69, 69, 69, 69, 80,
+	106, 106, 106, 106, 106, 106, 109, 107, 107, 107, 107, 107, 107, 107, 107, 82,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 110, 110, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 106, 106, 69, 106, 69, 106, 106, 106, 106, 106, 69, 106, 106, 106, 106,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
+	106, 106, 106, 106, 69, 106, 69, 106, 106, 106, 106, 106, 106, 106, 106, 106,
+	106, 107, 106, 108, 107, 107, 107, 107, 107, 107, 107, 107, 107, 106, 69, 69,
+	106, 106, 106, 106, 106, 69, 109, 69, 107, 107, 107, 107, 107, 107, 107, 69,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 69, 106, 106, 106, 106,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 111, 111, 111, 103, 82, 103, 103, 112, 103, 103, 110, 112, 83, 83, 83,
+	83, 83, 112, 73, 83, 73, 73, 73, 74, 74, 73, 73, 73, 73, 73, 73,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 101, 101, 101, 101, 101, 101,
+	101, 101, 101, 101, 113, 74, 73, 74, 73, 74, 114, 115, 114, 115, 96, 96,
+	58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69,
+	69, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 116,
+	74, 74, 74, 74, 74, 110, 74, 74, 58, 58, 58, 58, 58, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 69, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 69, 113, 113,
+	73, 73, 73, 73, 73, 73, 74, 73, 73, 73, 73, 73, 73, 69, 73, 73,
+	103, 103, 110, 103, 82, 73, 73, 73, 73, 112, 112, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+	117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+	117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 118, 118, 107, 107, 107,
+	107, 119, 107, 107, 107, 107, 107, 107, 118, 120, 107, 119, 119, 107, 107, 117,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 99, 99, 82, 82, 82, 82,
+	117, 117, 117, 117, 117, 117, 119, 119, 107, 107, 117, 117, 117, 117, 107, 107,
+	107, 117, 118, 118, 118, 117, 117, 118, 118, 118, 118, 118, 118, 118, 117, 117,
+	117, 107, 107, 107, 107, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+	117, 117, 107, 118, 119, 107, 107, 118, 118, 118, 118, 118, 118, 107, 117, 118,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 118, 118, 118, 107, 121, 121,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 69, 50, 69, 69, 69, 69, 69, 50, 69, 69,
+	122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
+	122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
+	122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 82, 59, 122, 122, 122,
+	123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
+	123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
+	123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
+	123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
+	123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
+	123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
+	124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124,
+	124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124,
+	124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124,
+	124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124,
+	124, 124, 124, 124, 124, 124, 124, 124, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 69, 58, 69, 58, 58, 58, 58, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 69, 58, 58, 58, 58, 69, 69, 58, 58, 58, 58, 58, 58, 58, 69,
+	58, 69, 58, 58, 58, 58, 69, 69, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 69, 58, 58, 58, 58, 69, 69, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 74, 74, 74,
+	82, 110, 94, 82, 82, 82, 82, 94, 94, 101, 101, 101, 101, 101, 101, 101,
+	101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 69, 69, 69, 69, 69, 69,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 69, 69, 46, 46, 46, 46, 46, 46, 69, 69,
+	81, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 73, 94, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	126, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 114, 115, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 110, 110, 110, 127, 127,
+	127, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 74, 74, 74, 100, 69, 69, 69, 69, 69, 69, 69, 69, 69, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 74, 74, 100, 99, 99, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 74, 74, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 58,
+	58, 69, 74, 74, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+	117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+	117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+	117, 117, 117, 117, 107, 107, 119, 107, 107, 107, 107, 107, 107, 107, 119, 119,
+	119, 119, 119, 119, 119, 119, 107, 119, 119, 107, 107, 107, 107, 107, 107, 107,
+	107, 107, 120, 107, 99, 99, 128, 109, 110, 82, 110, 80, 106, 107, 69, 69,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 69, 69, 69, 69, 69,
+	101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 69, 69, 69, 69, 69, 69,
+	82, 82, 129, 90, 110, 110, 130, 82, 129, 90, 82, 74, 74, 74, 131, 74,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 60, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 74, 74, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 74, 58, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69,
+	74, 74, 74, 96, 96, 96, 96, 74, 74, 96, 96, 96, 69, 69, 69, 69,
+	96, 96, 74, 96, 96, 96, 96, 96, 96, 74, 74, 74, 69, 69, 69, 69,
+	73, 69, 69, 69, 90, 90, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 69, 69,
+	106, 106, 106, 106, 106, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 69, 69, 69, 69,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 69, 69, 69, 69, 69, 69,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 132, 69, 69, 69, 121, 121,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 74, 74, 96, 96, 74, 69, 69, 82, 82,
+	117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+	117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+	117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+	117, 117, 117, 117, 117, 119, 107, 119, 107, 107, 107, 107, 107, 107, 107, 69,
+	120, 118, 107, 118, 118, 107, 107, 107, 107, 107, 107, 107, 107, 119, 119, 119,
+	119, 119, 119, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 69, 69, 74,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 69, 69, 69, 69, 69,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 69, 69, 69, 69, 69,
+	133, 133, 133, 133, 133, 133, 133, 109, 134, 134, 134, 134, 133, 133, 69, 69,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 75, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 69, 69,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 135, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	74, 74, 74, 74, 96, 136, 136, 136, 136, 136, 136, 137, 137, 136, 136, 136,
+	136, 136, 136, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
+	137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
+	137, 137, 137, 137, 74, 100, 74, 74, 74, 74, 74, 100, 74, 100, 96, 96,
+	96, 96, 74, 100, 138, 137, 137, 137, 137, 137, 137, 137, 137, 69, 99, 99,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 99, 99, 140, 110, 99, 99,
+	110, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 141, 141, 141, 141, 141, 141, 141, 141, 141, 99, 99, 99,
+	74, 74, 96, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
+	97, 96, 74, 74, 74, 74, 96, 96, 74, 74, 100, 98, 74, 74, 97, 97,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 58, 97, 97, 97, 58, 58,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+	142, 142, 142, 142, 142, 142, 74, 96, 74, 74, 96, 96, 96, 74, 96, 74,
+	74, 74, 143, 143, 69, 69, 69, 69, 69, 69, 69, 69, 82, 82, 82, 82,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 96, 96, 96, 96, 96, 96, 96, 96, 74, 74, 74, 74,
+	74, 74, 74, 74, 96, 96, 74, 74, 69, 69, 69, 99, 99, 110, 110, 110,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 69, 69, 58, 58, 58,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 60, 60, 60, 60, 60, 60, 99, 99,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 54, 56, 69, 69, 69, 69, 69,
+	144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
+	144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
+	144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 69, 69, 144, 144, 144,
+	82, 82, 82, 82, 82, 82, 82, 82, 69, 69, 69, 69, 69, 69, 69, 69,
+	74, 74, 74, 82, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 96, 74, 74, 74, 74, 74, 74, 74, 58, 58, 58, 58, 74, 58, 58,
+	58, 58, 58, 58, 74, 58, 58, 96, 74, 74, 58, 69, 69, 69, 69, 69,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 59, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 135, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 135, 74, 74, 74,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 56, 56, 56, 56, 56, 56, 56, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 54, 54, 54, 54, 54, 54, 54, 54,
+	56, 56, 56, 56, 56, 56, 69, 69, 50, 50, 50, 50, 50, 50, 69, 69,
+	56, 56, 56, 56, 56, 56, 56, 56, 54, 54, 54, 54, 54, 54, 54, 54,
+	56, 56, 56, 56, 56, 56, 56, 56, 54, 54, 54, 54, 54, 54, 54, 54,
+	56, 56, 56, 56, 56, 56, 69, 69, 50, 50, 50, 50, 50, 50, 69, 69,
+	46, 46, 46, 46, 46, 46, 46, 46, 69, 50, 69, 50, 69, 50, 69, 54,
+	56, 56, 56, 56, 56, 56, 56, 56, 54, 54, 54, 54, 54, 54, 54, 54,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 69, 69,
+	56, 56, 56, 56, 56, 56, 56, 56, 54, 54, 54, 54, 54, 54, 54, 54,
+	56, 56, 56, 56, 56, 56, 56, 56, 54, 54, 54, 54, 54, 54, 54, 54,
+	56, 56, 56, 56, 56, 56, 56, 56, 54, 54, 54, 54, 54, 54, 54, 54,
+	56, 56, 56, 56, 56, 69, 56, 56, 54, 54, 54, 54, 54, 65, 46, 65,
+	65, 65, 46, 46, 46, 69, 56, 56, 54, 54, 54, 54, 54, 65, 65, 65,
+	46, 46, 46, 46, 69, 69, 56, 56, 54, 54, 54, 54, 69, 65, 65, 65,
+	56, 56, 56, 56, 56, 56, 56, 56, 54, 54, 54, 54, 54, 65, 65, 65,
+	69, 69, 46, 46, 46, 69, 56, 56, 54, 54, 54, 54, 54, 145, 65, 69,
+	126, 126, 126, 126, 126, 126, 126, 30, 126, 126, 126, 146, 147, 148, 89, 89,
+	149, 150, 81, 151, 152, 153, 35, 82, 154, 155, 114, 39, 156, 157, 114, 39,
+	35, 35, 158, 82, 159, 160, 160, 161, 162, 163, 89, 89, 89, 89, 89, 164,
+	165, 87, 165, 165, 87, 165, 87, 87, 82, 39, 49, 35, 166, 167, 158, 168,
+	168, 82, 82, 82, 169, 114, 115, 167, 167, 166, 82, 82, 82, 82, 82, 82,
+	82, 82, 72, 82, 168, 82, 110, 87, 110, 110, 110, 110, 82, 110, 110, 126,
+	170, 171, 171, 171, 171, 172, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89,
+	101, 59, 69, 69, 44, 101, 101, 101, 101, 101, 72, 72, 72, 114, 115, 173,
+	101, 44, 44, 44, 44, 101, 101, 101, 101, 101, 72, 72, 72, 114, 115, 69,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 69, 69, 69,
+	80, 80, 80, 80, 80, 80, 80, 88, 80, 174, 80, 80, 33, 80, 80, 80,
+	80, 80, 80, 80, 80, 80, 88, 80, 80, 80, 80, 88, 80, 80, 88, 80,
+	88, 80, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 75, 75, 75,
+	75, 74, 75, 75, 75, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	73, 73, 50, 42, 73, 176, 73, 50, 73, 42, 56, 54, 54, 54, 56, 56,
+	54, 54, 54, 177, 73, 50, 178, 73, 72, 50, 50, 50, 50, 50, 73, 73,
+	73, 176, 179, 73, 50, 73, 51, 73, 50, 73, 50, 180, 50, 50, 73, 56,
+	54, 54, 54, 54, 56, 58, 58, 58, 58, 181, 73, 73, 56, 56, 54, 54,
+	72, 72, 72, 72, 72, 54, 56, 56, 56, 56, 73, 72, 73, 73, 46, 73,
+	182, 182, 182, 44, 44, 182, 182, 182, 182, 182, 182, 44, 44, 44, 44, 101,
+	183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 184, 184, 184, 184,
+	185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 186, 186, 186, 186, 186, 186,
+	127, 127, 127, 54, 56, 127, 127, 127, 127, 44, 73, 73, 69, 69, 69, 69,
+	52, 52, 52, 52, 187, 179, 179, 179, 179, 179, 72, 72, 73, 73, 73, 73,
+	72, 73, 73, 72, 73, 73, 72, 73, 73, 37, 37, 73, 73, 73, 72, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 188, 188, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 72, 72,
+	73, 73, 52, 73, 52, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 188, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	52, 72, 52, 52, 72, 72, 72, 52, 52, 72, 72, 52, 72, 72, 72, 52,
+	72, 52, 189, 189, 72, 52, 72, 72, 72, 72, 52, 72, 72, 52, 52, 52,
+	52, 72, 72, 52, 72, 52, 72, 52, 52, 52, 52, 52, 52, 72, 52, 72,
+	72, 72, 72, 72, 52, 52, 52, 52, 72, 72, 72, 72, 52, 52, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 52, 72, 72, 72, 52, 72, 72, 72,
+	72, 72, 52, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	52, 52, 72, 72, 52, 52, 52, 52, 72, 72, 52, 52, 72, 72, 52, 52,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 52, 52, 72, 72, 52, 52, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 52, 72, 72, 72, 52, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 52, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 52,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 190,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	73, 73, 73, 73, 73, 73, 73, 73, 114, 115, 114, 115, 73, 73, 73, 73,
+	73, 73, 176, 73, 73, 73, 73, 73, 73, 73, 191, 191, 73, 73, 73, 73,
+	72, 72, 73, 73, 73, 73, 73, 73, 37, 192, 193, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 72, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 37,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 72, 72, 72, 72,
+	72, 72, 73, 73, 73, 73, 73, 73, 73, 194, 194, 194, 194, 37, 37, 37,
+	191, 195, 195, 191, 73, 73, 73, 73, 37, 37, 37, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44,
+	44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44,
+	44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44,
+	44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 176, 176, 176, 176,
+	176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176,
+	176, 176, 176, 176, 176, 176, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
+	196, 196, 197, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 182, 44, 44, 44, 44, 44,
+	44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 199,
+	176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176,
+	176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176,
+	176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176,
+	176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176,
+	176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 73, 73, 73, 73,
+	176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176,
+	176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176,
+	176, 176, 176, 176, 200, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176,
+	73, 73, 176, 176, 176, 176, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	176, 176, 73, 176, 176, 176, 176, 176, 176, 176, 37, 37, 73, 73, 73, 73,
+	73, 73, 176, 176, 73, 73, 179, 52, 73, 73, 73, 73, 176, 176, 73, 73,
+	179, 52, 73, 73, 73, 73, 176, 176, 176, 73, 73, 176, 73, 73, 176, 176,
+	176, 176, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 176, 176, 176, 176, 73, 73, 73, 73, 73, 73, 73, 73, 73, 176,
+	73, 73, 73, 73, 73, 73, 73, 73, 72, 72, 72, 201, 201, 202, 202, 72,
+	195, 195, 195, 195, 37, 176, 176, 73, 73, 176, 73, 73, 73, 73, 179, 176,
+	73, 37, 73, 73, 191, 191, 200, 200, 195, 73, 141, 141, 203, 204, 203, 141,
+	37, 73, 37, 37, 73, 73, 37, 73, 73, 73, 37, 73, 73, 73, 37, 37,
+	205, 205, 205, 205, 205, 205, 205, 205, 37, 195, 195, 141, 73, 73, 73, 73,
+	179, 73, 179, 73, 73, 73, 73, 73, 194, 194, 194, 194, 194, 194, 194, 194,
+	194, 194, 194, 194, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 37,
+	179, 176, 73, 179, 176, 179, 37, 176, 206, 176, 176, 73, 176, 176, 73, 52,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 37, 73, 73, 37, 191,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 205, 205, 205, 205, 205, 205,
+	73, 73, 37, 194, 37, 37, 37, 37, 73, 37, 73, 37, 37, 73, 176, 176,
+	37, 194, 73, 73, 73, 73, 73, 37, 73, 73, 194, 194, 73, 73, 73, 73,
+	37, 37, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 191, 191, 203,
+	141, 141, 141, 141, 191, 191, 203, 203, 206, 176, 176, 176, 176, 203, 194, 206,
+	203, 206, 176, 206, 191, 176, 176, 176, 203, 203, 176, 176, 203, 176, 176, 203,
+	203, 203, 73, 176, 73, 73, 73, 73, 176, 179, 191, 176, 176, 176, 176, 176,
+	179, 206, 191, 191, 206, 191, 176, 206, 206, 207, 191, 176, 176, 191, 203, 203,
+	141, 141, 195, 141, 141, 194, 73, 73, 195, 195, 208, 208, 204, 204, 73, 37,
+	73, 73, 37, 73, 37, 73, 37, 73, 73, 73, 73, 73, 73, 37, 73, 73,
+	73, 37, 73, 73, 73, 73, 73, 73, 194, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 37, 37, 73, 73, 73, 73, 73, 73, 73, 73, 188, 73, 73,
+	73, 73, 73, 73, 37, 73, 73, 37, 73, 73, 73, 73, 194, 73, 194, 73,
+	73, 73, 73, 194, 194, 194, 73, 209, 73, 73, 73, 210, 210, 210, 210, 210,
+	210, 73, 211, 212, 195, 73, 73, 73, 114, 115, 114, 115, 114, 115, 114, 115,
+	114, 115, 114, 115, 114, 115, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44,
+	182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182,
+	182, 182, 182, 182, 73, 194, 194, 194, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 37, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	194, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 194,
+	72, 72, 72, 72, 72, 114, 115, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 12, 28, 12, 28, 12, 28, 12, 28, 114, 115,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	113, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 201, 201, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 114, 115, 12, 28, 114, 115, 114, 115, 114, 115, 114, 115, 114,
+	115, 114, 115, 114, 115, 114, 115, 114, 115, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 114, 115, 114, 115, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 114, 115, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	73, 73, 73, 73, 73, 37, 37, 37, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 194, 194, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 73, 73, 72, 72, 72, 72, 72, 72, 73, 73, 73,
+	194, 73, 73, 73, 73, 209, 176, 176, 176, 176, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 69, 69, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	54, 56, 54, 54, 54, 56, 56, 54, 56, 54, 56, 54, 56, 54, 54, 54,
+	54, 56, 54, 56, 56, 54, 56, 56, 56, 56, 56, 56, 59, 59, 50, 50,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 56, 73, 73, 73, 73, 73, 73, 54, 56, 54, 56, 74,
+	74, 74, 54, 56, 69, 69, 69, 69, 69, 90, 99, 99, 110, 101, 83, 110,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 69, 46, 69, 69, 69, 69, 69, 46, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69, 60,
+	110, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 74,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 58, 58, 69,
+	58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 58, 58, 69,
+	58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 58, 58, 69,
+	58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 58, 58, 69,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	213, 213, 39, 49, 39, 49, 213, 213, 213, 39, 49, 213, 39, 49, 110, 110,
+	110, 110, 110, 110, 110, 110, 82, 81, 214, 110, 215, 82, 39, 49, 82, 82,
+	39, 49, 114, 115, 114, 115, 114, 115, 114, 115, 110, 110, 110, 110, 90, 60,
+	110, 110, 82, 110, 110, 82, 82, 82, 82, 82, 216, 216, 99, 110, 110, 82,
+	81, 110, 114, 110, 110, 110, 110, 110, 110, 110, 110, 82, 110, 82, 110, 110,
+	73, 73, 82, 90, 90, 114, 217, 114, 217, 114, 217, 114, 217, 81, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 69, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	219, 220, 221, 222, 218, 223, 224, 225, 192, 193, 192, 193, 192, 193, 192, 193,
+	192, 193, 218, 218, 192, 193, 192, 193, 192, 193, 192, 193, 226, 192, 193, 193,
+	218, 225, 225, 225, 225, 225, 225, 225, 225, 225, 227, 227, 227, 227, 228, 228,
+	229, 230, 230, 230, 230, 231, 218, 218, 225, 225, 225, 223, 232, 233, 218, 141,
+	69, 234, 224, 234, 224, 234, 224, 234, 224, 234, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 234, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 234, 224, 234, 224, 234, 224, 224, 224, 224, 224, 224, 234, 224,
+	224, 224, 224, 224, 224, 234, 234, 69, 69, 227, 227, 235, 235, 236, 236, 224,
+	237, 238, 239, 238, 239, 238, 239, 238, 239, 238, 239, 239, 239, 239, 239, 239,
+	239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239,
+	239, 239, 239, 238, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239,
+	239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239,
+	239, 239, 239, 238, 239, 238, 239, 238, 239, 239, 239, 239, 239, 239, 238, 239,
+	239, 239, 239, 239, 239, 238, 238, 239, 239, 239, 239, 240, 241, 242, 242, 239,
+	69, 69, 69, 69, 69, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	69, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 69,
+	218, 218, 244, 244, 244, 244, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 69, 69, 69, 69, 69, 69, 69, 69, 69, 218,
+	238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 69,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 44, 44, 44, 44, 44, 44, 44, 44,
+	218, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 245, 218, 245, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246,
+	246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246,
+	246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 218,
+	246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246,
+	246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246,
+	246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246,
+	246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246,
+	246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246,
+	246, 246, 246, 246, 246, 246, 246, 246, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205,
+	205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205,
+	205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205,
+	205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 223, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 69, 69, 69,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 60, 60, 60, 60, 60, 60, 110, 99,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 60, 110, 90, 99,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 58, 58, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 58, 74,
+	75, 75, 75, 82, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 82, 60,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 59, 59, 74, 74,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	74, 74, 82, 99, 110, 110, 110, 99, 69, 69, 69, 69, 69, 69, 69, 69,
+	65, 65, 65, 65, 65, 65, 65, 65, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 60, 60, 60, 60, 60, 60, 60, 60, 60,
+	61, 61, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	56, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	59, 56, 56, 56, 56, 56, 56, 56, 56, 54, 56, 54, 56, 54, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 60, 61, 61, 54, 56, 54, 56, 58,
+	54, 56, 54, 56, 56, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 54, 54, 54, 54, 56,
+	54, 54, 54, 54, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 54, 54, 54, 56, 54, 56, 54, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 59, 59, 59, 59, 54, 56, 58, 59, 59, 46, 58, 58, 58, 58, 58,
+	58, 58, 74, 58, 58, 58, 74, 58, 58, 58, 58, 74, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 96, 96, 74, 74, 96, 73, 73, 73, 73, 74, 69, 69, 69,
+	101, 101, 101, 101, 101, 101, 73, 73, 88, 73, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 103, 103, 90, 90, 69, 69, 69, 69, 69, 69, 69, 69,
+	96, 96, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96,
+	96, 96, 96, 96, 74, 74, 69, 69, 69, 69, 69, 69, 69, 69, 99, 99,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 69, 69, 69, 69, 69,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 58, 58, 58, 58, 58, 58, 82, 82, 82, 58, 103, 58, 58, 74,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 74, 74, 74, 74, 74, 74, 74, 74, 110, 99,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 96, 100, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 82,
+	123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
+	123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 69, 69, 69,
+	74, 74, 74, 96, 136, 136, 136, 136, 136, 137, 137, 137, 136, 136, 136, 137,
+	137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
+	137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
+	137, 137, 137, 74, 96, 96, 74, 74, 74, 74, 96, 96, 74, 74, 96, 96,
+	138, 140, 140, 140, 140, 140, 140, 110, 99, 99, 140, 140, 140, 140, 69, 247,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 69, 69, 69, 69, 140, 140,
+	117, 117, 117, 117, 117, 107, 109, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 117, 117, 117, 117, 117, 69,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 74, 74, 74, 74, 74, 74, 96,
+	96, 74, 74, 96, 96, 74, 74, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	248, 248, 248, 74, 248, 248, 248, 248, 248, 248, 248, 248, 74, 96, 69, 69,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 69, 69, 140, 99, 99, 99,
+	117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+	109, 117, 117, 117, 106, 106, 106, 121, 121, 121, 117, 118, 107, 118, 117, 117,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
+	107, 106, 107, 107, 107, 106, 106, 107, 107, 106, 106, 106, 106, 106, 107, 107,
+	106, 107, 106, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 106, 106, 109, 133, 133,
+	97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 96, 74, 74, 96, 96,
+	99, 99, 58, 60, 60, 96, 98, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 58, 58, 58, 58, 58, 58, 69, 69, 58, 58, 58, 58, 58, 58, 69,
+	69, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 58, 58, 69,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 61, 59, 59, 59, 59,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 59, 65, 65, 69, 69, 69, 69,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 58, 58, 58, 58, 58,
+	58, 58, 58, 96, 96, 74, 96, 96, 74, 96, 96, 99, 96, 74, 69, 69,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 69, 69, 69, 69, 69,
+	249, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 249, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 249, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 249, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	249, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 249, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 249, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 249, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	249, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 249, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 249, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 249, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	249, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 249, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 249, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 249, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	249, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 249, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 249, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 249, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	249, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 249, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 249, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 249, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	249, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 249, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 249, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 249, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	249, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 249, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 249, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 249, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 249, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124,
+	124, 124, 124, 124, 124, 124, 124, 69, 69, 69, 69, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 69, 69, 69, 69,
+	251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251,
+	251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251,
+	251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251,
+	251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251,
+	251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251,
+	251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251,
+	251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251,
+	251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251,
+	252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252,
+	252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252,
+	252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252,
+	252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252,
+	252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252,
+	252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252,
+	252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252,
+	252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 253, 253,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	46, 46, 46, 46, 46, 46, 46, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 46, 46, 46, 46, 46, 69, 69, 69, 69, 69, 84, 74, 84,
+	84, 84, 84, 84, 84, 84, 84, 84, 84, 72, 84, 84, 84, 84, 84, 84,
+	84, 84, 84, 84, 84, 84, 84, 69, 84, 84, 84, 84, 84, 69, 84, 69,
+	84, 84, 69, 84, 84, 69, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65,
+	65, 65, 65, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 115, 114,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	73, 73, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 73, 73, 73, 73, 73, 73, 73, 73,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 88, 73, 73, 73,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	220, 220, 221, 254, 255, 256, 256, 192, 193, 257, 69, 69, 69, 69, 69, 69,
+	135, 74, 135, 74, 135, 74, 135, 135, 74, 135, 74, 135, 74, 135, 135, 74,
+	222, 258, 258, 259, 259, 192, 193, 192, 193, 192, 193, 192, 193, 192, 193, 192,
+	193, 192, 193, 192, 193, 222, 222, 192, 193, 222, 222, 222, 222, 259, 259, 259,
+	260, 261, 262, 69, 263, 254, 256, 256, 258, 192, 193, 192, 193, 192, 193, 222,
+	222, 222, 264, 258, 264, 264, 264, 69, 222, 265, 266, 222, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 170,
+	69, 267, 268, 268, 269, 270, 268, 271, 272, 273, 268, 274, 275, 276, 277, 268,
+	278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 279, 280, 274, 274, 274, 267,
+	268, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281,
+	281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 272, 268, 273, 282, 283,
+	282, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284,
+	284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 272, 274, 273, 274, 272,
+	273, 285, 286, 287, 288, 289, 290, 291, 291, 291, 291, 291, 291, 291, 291, 291,
+	292, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 293, 293,
+	294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294,
+	294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 69,
+	69, 69, 294, 294, 294, 294, 294, 294, 69, 69, 294, 294, 294, 294, 294, 294,
+	69, 69, 294, 294, 294, 294, 294, 294, 69, 69, 294, 294, 294, 69, 69, 69,
+	295, 269, 274, 282, 296, 269, 269, 69, 297, 298, 298, 298, 298, 297, 297, 69,
+	172, 172, 172, 172, 172, 172, 172, 172, 172, 89, 89, 89, 299, 176, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 69, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69,
+	110, 110, 110, 69, 69, 69, 69, 101, 101, 101, 101, 101, 101, 101, 101, 101,
+	101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
+	101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
+	101, 101, 101, 101, 69, 69, 69, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 101, 101, 101, 101, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 101, 101, 73, 73, 73, 69,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 69, 69, 69,
+	73, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 74, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	74, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
+	101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	101, 101, 101, 101, 69, 69, 69, 69, 69, 69, 69, 69, 69, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 127, 58, 58, 58, 58, 58, 58, 58, 58, 127, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 74, 74, 74, 74, 74, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 110,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 69, 69, 69, 69, 58, 58, 58, 58, 58, 58, 58, 58,
+	110, 127, 127, 127, 127, 127, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 69, 69, 69, 69, 69,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 69, 69, 69, 69, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 82,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 69, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 69, 50, 50, 50, 50,
+	50, 50, 50, 69, 50, 50, 69, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 69, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 69, 46, 46, 46, 46, 46, 46, 46, 69, 46, 46, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	59, 60, 60, 59, 59, 59, 69, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 69, 59, 59, 59, 59, 59, 59, 59, 59, 59, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 69, 69, 58, 69, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 69, 58, 58, 69, 69, 69, 58, 69, 69, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 69, 110, 101, 101, 101, 101, 101, 101, 101, 101,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 73, 73, 101, 101, 101, 101, 101, 101, 101,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69,
+	69, 69, 69, 69, 69, 69, 69, 101, 101, 101, 101, 101, 101, 101, 101, 101,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 69, 58, 58, 69, 69, 69, 69, 69, 101, 101, 101, 101, 101,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 101, 101, 101, 101, 101, 101, 69, 69, 69, 110,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 82,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 101, 101, 58, 58,
+	101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
+	69, 69, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
+	101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
+	101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
+	97, 74, 74, 74, 69, 74, 74, 69, 69, 69, 69, 69, 74, 74, 74, 74,
+	97, 97, 97, 97, 69, 97, 97, 97, 69, 97, 97, 97, 97, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 69, 69, 74, 74, 74, 69, 69, 69, 69, 98,
+	101, 101, 101, 101, 101, 101, 101, 101, 101, 69, 69, 69, 69, 69, 69, 69,
+	110, 110, 110, 110, 110, 110, 99, 99, 82, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 101, 101, 82,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 101, 101, 101,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 73, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 74, 74, 69, 69, 69, 69, 101, 101, 101, 101, 101,
+	110, 110, 110, 110, 110, 110, 300, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 69, 69, 69, 110, 110, 110, 110, 110, 110, 110,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 69, 69, 101, 101, 101, 101, 101, 101, 101, 101,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 69, 69, 69, 69, 69, 101, 101, 101, 101, 101, 101, 101, 101,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 69, 69, 69, 69, 69, 69, 69, 82, 82, 82, 82, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 101, 101, 101, 101, 101, 101, 101,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 69, 69, 69, 69, 69, 69, 69, 101, 101, 101, 101, 101, 101,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 74, 74, 74, 74, 69, 69, 69, 69, 69, 69, 69, 69,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 69, 69, 69, 69, 69,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 58, 58, 58, 58, 60, 58,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 69, 69, 69, 74, 74, 74, 74, 74, 81, 60,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 69, 69, 69, 69, 69, 69, 69, 69, 72, 72,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
+	101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 74, 74, 81, 69, 69,
+	58, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 58, 58, 58, 60, 58, 58, 69, 69, 69, 69, 69, 69, 69, 69,
+	110, 73, 73, 73, 73, 73, 73, 73, 73, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 74, 74, 74, 74, 74, 74,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 101, 101, 101,
+	101, 101, 101, 101, 101, 101, 101, 58, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 101, 101, 101, 101, 94, 94, 94, 94, 94, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 74, 74, 74, 74, 94, 94, 94, 94, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 101, 101, 101, 101, 101, 101, 101, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	96, 74, 96, 301, 301, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136,
+	136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136,
+	136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136,
+	136, 136, 136, 136, 136, 136, 136, 136, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 302, 99, 99, 140, 140, 140, 140, 140, 69, 69,
+	69, 69, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303,
+	303, 303, 303, 303, 303, 303, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	74, 136, 136, 74, 74, 136, 69, 69, 69, 69, 69, 69, 69, 69, 69, 135,
+	74, 74, 96, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	96, 96, 96, 74, 74, 74, 74, 96, 96, 74, 74, 82, 82, 86, 99, 99,
+	99, 99, 74, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 86, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 69, 69, 69, 69, 69,
+	74, 74, 74, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 97, 74, 74, 74, 74, 74, 96, 74, 74, 74,
+	74, 74, 74, 98, 74, 69, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91,
+	110, 99, 99, 99, 97, 96, 96, 97, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 74, 82, 103, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	74, 74, 96, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 96, 96, 96, 74, 74, 74, 74, 74, 74, 74, 74, 74, 96,
+	100, 58, 104, 104, 58, 99, 99, 82, 110, 74, 74, 74, 74, 94, 96, 74,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 58, 103, 58, 110, 99, 99,
+	69, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
+	101, 101, 101, 101, 101, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 69, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 96, 96, 96, 74,
+	74, 74, 96, 96, 74, 100, 74, 74, 99, 99, 82, 99, 99, 82, 74, 58,
+	58, 74, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 69, 58, 69, 58, 58, 58, 58, 69, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 99, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 74,
+	96, 96, 96, 74, 74, 74, 74, 74, 74, 74, 74, 69, 69, 69, 69, 69,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 69, 69, 69, 69, 69,
+	74, 74, 96, 96, 69, 136, 136, 136, 136, 136, 136, 136, 136, 69, 69, 136,
+	136, 69, 69, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136,
+	136, 136, 136, 136, 136, 136, 136, 136, 136, 69, 136, 136, 136, 136, 136, 136,
+	136, 69, 136, 136, 69, 136, 136, 136, 136, 136, 69, 74, 74, 248, 100, 96,
+	74, 96, 96, 96, 96, 69, 69, 96, 96, 69, 69, 96, 96, 304, 69, 69,
+	142, 69, 69, 69, 69, 69, 69, 100, 69, 69, 69, 69, 69, 248, 142, 142,
+	136, 136, 96, 96, 69, 69, 74, 74, 74, 74, 74, 74, 74, 69, 69, 69,
+	74, 74, 74, 74, 74, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 69, 305, 69, 69, 305, 69,
+	305, 305, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
+	137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
+	137, 137, 137, 137, 137, 137, 69, 306, 100, 96, 96, 74, 74, 74, 74, 74,
+	74, 69, 100, 69, 69, 100, 69, 100, 100, 100, 96, 69, 96, 96, 74, 100,
+	307, 308, 74, 306, 309, 309, 69, 140, 140, 69, 69, 69, 69, 69, 69, 69,
+	69, 74, 74, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 96, 96, 96, 74, 74, 74, 74, 74, 74, 74, 74,
+	96, 96, 74, 74, 74, 96, 74, 58, 58, 58, 58, 99, 99, 110, 110, 82,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 110, 110, 69, 82, 74, 58,
+	58, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	100, 96, 96, 74, 74, 74, 74, 74, 74, 96, 74, 96, 96, 100, 96, 74,
+	74, 96, 74, 74, 58, 58, 82, 58, 69, 69, 69, 69, 69, 69, 69, 69,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 100,
+	96, 96, 74, 74, 74, 74, 69, 69, 96, 96, 96, 96, 74, 74, 96, 74,
+	74, 103, 99, 99, 83, 83, 82, 82, 82, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 58, 58, 58, 58, 74, 74, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	96, 96, 96, 74, 74, 74, 74, 74, 74, 74, 74, 96, 96, 74, 96, 74,
+	74, 99, 99, 82, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 69, 69, 69, 69, 69,
+	103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 74, 96, 74, 96, 96,
+	74, 74, 74, 74, 74, 74, 100, 74, 58, 82, 69, 69, 69, 69, 69, 69,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 69, 69, 69, 69, 69,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91,
+	91, 91, 91, 91, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 69, 69, 107, 119, 107,
+	118, 118, 107, 107, 107, 107, 119, 107, 107, 107, 107, 107, 69, 69, 69, 69,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 310, 310, 99, 99, 99, 121,
+	106, 106, 106, 106, 106, 106, 106, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 96, 96, 96, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 96, 74, 74, 82, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 101, 101, 101, 101, 101, 101,
+	101, 101, 101, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 58,
+	137, 137, 137, 137, 137, 137, 137, 69, 69, 137, 69, 69, 137, 137, 137, 137,
+	137, 137, 137, 137, 69, 137, 137, 69, 137, 137, 137, 137, 137, 137, 137, 137,
+	137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
+	100, 96, 96, 96, 96, 96, 69, 96, 96, 69, 69, 74, 74, 100, 307, 308,
+	96, 308, 96, 74, 99, 110, 99, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 96, 96, 96, 74, 74, 74, 74, 69, 69, 74, 74, 96, 96, 96, 96,
+	74, 58, 103, 58, 96, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	97, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 97, 97, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
+	97, 97, 97, 74, 74, 74, 74, 74, 74, 96, 58, 74, 74, 74, 74, 103,
+	82, 110, 99, 99, 110, 103, 82, 98, 69, 69, 69, 69, 69, 69, 69, 69,
+	97, 74, 74, 74, 74, 74, 74, 96, 96, 74, 74, 74, 97, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
+	97, 97, 97, 97, 104, 104, 104, 104, 104, 104, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 96, 74, 98, 110, 99, 99, 58, 103, 103,
+	103, 110, 110, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69,
+	103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	74, 96, 74, 74, 74, 96, 74, 96, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 82, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 96,
+	74, 74, 74, 74, 74, 74, 74, 69, 74, 74, 74, 74, 74, 74, 96, 74,
+	58, 99, 99, 110, 110, 110, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 101, 101, 101, 101, 101, 101,
+	101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 69, 69, 69,
+	103, 83, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	69, 69, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 69, 96, 74, 74, 74, 74, 74, 74,
+	74, 96, 74, 74, 96, 74, 74, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 69, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 74, 74, 74, 74, 74, 74, 69, 69, 69, 74, 69, 74, 74, 69, 74,
+	74, 74, 74, 74, 74, 74, 104, 74, 69, 69, 69, 69, 69, 69, 69, 69,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 69, 58, 58, 69, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 96, 96, 96, 96, 96, 69,
+	74, 74, 69, 96, 96, 74, 96, 74, 58, 69, 69, 69, 69, 69, 69, 69,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 60, 58, 58, 69, 69, 69, 69,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+	142, 142, 248, 74, 74, 96, 96, 99, 99, 69, 69, 69, 69, 69, 69, 69,
+	74, 74, 308, 96, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
+	137, 69, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
+	137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
+	137, 137, 137, 137, 96, 96, 74, 74, 74, 74, 74, 69, 69, 69, 96, 96,
+	74, 100, 307, 99, 99, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 74, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
+	101, 101, 101, 101, 101, 73, 73, 73, 73, 73, 73, 73, 73, 88, 88, 88,
+	88, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 110,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 69,
+	110, 110, 110, 110, 110, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 82, 82, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 311, 311, 311, 312, 312, 312, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 312, 58, 58, 58, 311, 312, 311, 312, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 311, 312, 312, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 311,
+	131, 131, 131, 131, 131, 131, 131, 313, 314, 131, 131, 131, 313, 314, 313, 314,
+	74, 58, 58, 58, 58, 58, 58, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 311, 312,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 96, 96, 96, 74, 74, 74,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 69, 69, 69, 99, 99,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69,
+	74, 74, 74, 74, 74, 99, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	74, 74, 74, 74, 74, 74, 74, 99, 99, 110, 82, 82, 73, 73, 73, 73,
+	60, 60, 60, 60, 99, 73, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 101, 101, 101, 101, 101,
+	101, 101, 69, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	60, 60, 60, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 315, 58, 58, 58, 315, 315, 315, 315, 60, 60, 82, 99, 99,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
+	101, 101, 101, 101, 101, 101, 101, 110, 99, 82, 82, 69, 69, 69, 69, 69,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 69, 69, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 74,
+	58, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96,
+	96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96,
+	96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96,
+	96, 96, 96, 96, 96, 96, 96, 96, 69, 69, 69, 69, 69, 69, 69, 74,
+	74, 74, 74, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	223, 223, 240, 223, 316, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	228, 228, 236, 236, 225, 225, 225, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317,
+	317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317,
+	317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317,
+	317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317,
+	317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317,
+	317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317,
+	317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317,
+	317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317,
+	317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317,
+	317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317,
+	317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317,
+	317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317,
+	317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317,
+	317, 317, 317, 317, 317, 317, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 317,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	318, 318, 318, 318, 69, 318, 318, 318, 318, 318, 318, 318, 69, 318, 318, 69,
+	239, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	239, 239, 239, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 234, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	234, 234, 234, 69, 69, 238, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 238, 238, 238, 238, 69, 69, 69, 69, 69, 69, 69, 69,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 73, 74, 74, 99,
+	89, 89, 89, 89, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 73, 73, 73, 69, 69, 69,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 69, 69, 69, 69, 69, 69, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	72, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 69, 69,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 69, 69, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 100, 100, 74, 74, 74, 73, 73, 73, 100, 100, 100,
+	100, 100, 100, 89, 89, 89, 89, 89, 89, 89, 89, 74, 74, 74, 74, 74,
+	74, 74, 74, 73, 73, 74, 74, 74, 74, 74, 74, 74, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 74, 74, 74, 74, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 74, 74, 74, 73, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
+	101, 101, 101, 101, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
+	101, 101, 101, 101, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205,
+	205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205,
+	205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205,
+	205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205,
+	205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205,
+	205, 205, 205, 205, 205, 205, 205, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319,
+	319, 319, 319, 319, 319, 319, 319, 101, 101, 69, 69, 69, 69, 69, 69, 69,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 56, 56,
+	56, 56, 56, 56, 56, 69, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 54, 69, 50, 50,
+	69, 69, 50, 69, 69, 50, 50, 69, 69, 50, 50, 50, 50, 69, 54, 54,
+	54, 54, 54, 54, 54, 54, 56, 56, 56, 56, 69, 46, 69, 46, 46, 46,
+	46, 46, 46, 46, 69, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 54, 54, 69, 50, 50, 50, 50, 69, 69, 50, 50, 50,
+	50, 50, 50, 50, 50, 69, 50, 50, 50, 50, 50, 50, 50, 69, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 54, 54, 69, 50, 50, 50, 50, 69,
+	50, 50, 50, 50, 50, 69, 50, 69, 69, 69, 50, 50, 50, 50, 50, 50,
+	50, 69, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 69, 69, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 72, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 72, 56, 56, 56, 56,
+	56, 56, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 72, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 72, 56, 56, 56, 56, 56, 56, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 72, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 72,
+	56, 56, 56, 56, 56, 56, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 72,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 72, 56, 56, 56, 56, 56, 56,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 72, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 46, 72, 56, 56, 56, 56, 56, 56, 54, 56, 69, 69, 91, 91,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 73, 73, 73, 73, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 73, 73, 73,
+	73, 73, 73, 73, 73, 74, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 74, 73, 73, 110, 99, 110, 110, 82, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 74, 74, 74, 74, 74,
+	69, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 58, 46, 46, 46, 46, 46,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 69,
+	69, 69, 69, 69, 69, 46, 46, 46, 46, 46, 46, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	74, 74, 74, 74, 74, 74, 74, 69, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 69, 69, 74, 74, 74, 74, 74,
+	74, 74, 69, 74, 74, 69, 74, 74, 74, 74, 74, 69, 69, 69, 69, 69,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 74,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69,
+	74, 74, 74, 74, 74, 74, 74, 60, 60, 60, 60, 60, 60, 60, 69, 69,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 69, 69, 69, 58, 73,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 74, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 74, 74, 74, 74,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 69, 69, 69, 69, 80,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 60, 74, 74, 74, 74,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 74, 74,
+	58, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 69, 69, 69, 82,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69,
+	58, 58, 58, 74, 58, 58, 74, 58, 58, 58, 58, 58, 58, 58, 74, 74,
+	58, 58, 58, 58, 58, 74, 69, 69, 69, 69, 69, 69, 69, 69, 58, 60,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 69, 58, 58, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 69, 69, 101, 101, 101, 101, 101, 101, 101, 101, 101,
+	74, 74, 74, 74, 74, 74, 74, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 74, 74, 74, 74, 74, 74, 74, 60, 69, 69, 69, 69,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 69, 69, 69, 69, 214, 214,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
+	101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
+	101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
+	101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 105, 101, 101, 101,
+	88, 101, 101, 101, 101, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
+	101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
+	101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 73, 101,
+	101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	69, 58, 58, 69, 58, 69, 69, 58, 69, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 69, 58, 58, 58, 58, 69, 58, 69, 58, 69, 69, 69, 69,
+	69, 69, 58, 69, 69, 69, 69, 58, 69, 58, 69, 58, 69, 58, 58, 58,
+	69, 58, 58, 69, 58, 69, 69, 58, 69, 58, 69, 58, 69, 58, 69, 58,
+	69, 58, 58, 69, 58, 69, 69, 58, 58, 58, 58, 69, 58, 58, 58, 58,
+	58, 58, 58, 69, 58, 58, 58, 58, 69, 58, 58, 58, 58, 69, 58, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69,
+	69, 58, 58, 58, 69, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	72, 72, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	141, 141, 141, 141, 191, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 320, 320, 320, 320,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 320,
+	320, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	320, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 191,
+	320, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+	44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 182, 182, 73, 73, 73,
+	176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176,
+	176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 73, 73,
+	196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
+	196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 176, 176, 176, 176, 176, 176,
+	196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
+	196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 73, 73, 73, 73, 73, 73,
+	197, 197, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 197, 197,
+	196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 176, 176, 176, 176, 209, 176,
+	176, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 176, 176, 176, 176, 176,
+	176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 73, 320, 320,
+	320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+	320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+	320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+	320, 320, 320, 320, 320, 320, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321,
+	321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321,
+	218, 191, 245, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 191, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 191,
+	218, 218, 191, 191, 191, 191, 191, 245, 191, 191, 191, 218, 320, 320, 320, 320,
+	218, 218, 218, 218, 218, 218, 218, 218, 218, 320, 320, 320, 320, 320, 320, 320,
+	191, 191, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+	218, 218, 218, 218, 218, 218, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+	320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+	320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+	320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+	320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+	320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+	320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+	320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+	320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+	320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 195, 141, 141, 195, 195, 195, 195, 195, 195, 195, 195, 195, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 195, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 195, 191, 191,
+	191, 191, 191, 191, 191, 208, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 141, 141, 195, 195, 141, 195, 195, 195, 73, 73, 195, 195,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 194, 194, 191, 191, 191, 191, 191, 194, 191, 191, 191,
+	191, 191, 208, 208, 208, 191, 191, 208, 191, 191, 208, 204, 204, 195, 195, 191,
+	191, 191, 191, 191, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 141, 141, 195, 191, 195, 141, 195, 191, 191, 191, 322, 322, 322, 322, 322,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 195,
+	191, 195, 208, 208, 191, 191, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208,
+	208, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208,
+	208, 208, 208, 208, 208, 208, 208, 208, 208, 191, 191, 191, 208, 191, 191, 191,
+	191, 208, 208, 208, 191, 208, 208, 208, 191, 191, 191, 191, 191, 191, 191, 208,
+	191, 208, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	194, 191, 194, 191, 194, 191, 191, 191, 191, 191, 208, 191, 191, 191, 191, 194,
+	191, 194, 194, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 195, 141, 191,
+	194, 194, 194, 194, 194, 194, 194, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 194, 194, 194, 194, 194, 194, 194, 194, 194,
+	194, 194, 194, 194, 194, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 37, 195, 191, 191, 191, 191, 141,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 141, 141, 141, 141, 141, 141, 141, 195,
+	195, 141, 141, 195, 204, 204, 195, 195, 195, 195, 208, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 195, 141, 141, 195, 195, 195, 195, 141, 141,
+	204, 141, 141, 141, 141, 208, 208, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 191, 195, 141, 141, 195, 141, 141, 141, 141, 141, 141, 141,
+	141, 195, 195, 141, 141, 141, 141, 141, 141, 141, 141, 141, 195, 141, 141, 141,
+	141, 141, 195, 195, 195, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 195, 195, 195, 73, 73, 73, 73, 73, 73, 73, 73, 195, 195, 195, 141,
+	141, 195, 141, 195, 141, 141, 141, 141, 195, 141, 141, 141, 141, 141, 141, 195,
+	141, 141, 141, 195, 73, 73, 73, 73, 73, 73, 195, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 208, 208, 208, 191, 191, 191, 208, 208, 208, 208, 208,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 210, 210, 210, 323, 323, 323, 73, 73, 73, 73,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 208, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 208, 208, 208, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	208, 191, 191, 191, 191, 191, 141, 141, 141, 141, 141, 195, 208, 195, 195, 195,
+	191, 191, 191, 141, 141, 191, 191, 191, 191, 320, 320, 320, 191, 191, 191, 191,
+	195, 195, 195, 195, 195, 195, 141, 141, 141, 195, 141, 191, 191, 320, 320, 320,
+	195, 141, 141, 195, 191, 191, 191, 191, 191, 191, 191, 191, 191, 320, 320, 320,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 141, 141, 141, 73, 73, 73, 73, 141, 141, 141, 141, 141,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 141, 141, 141, 141, 141, 320, 320, 320, 320, 320, 320,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 320, 320, 320, 320,
+	191, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 324, 324, 324, 324,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 324, 324, 324, 324, 324, 324, 324, 324,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 324, 324, 324, 324, 324, 324,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 324, 324, 324, 324, 324, 324, 324, 324,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 324, 324,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 324, 324, 324, 324,
+	73, 73, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 208, 191, 191, 208,
+	191, 191, 191, 191, 191, 191, 191, 191, 208, 208, 208, 208, 208, 208, 208, 208,
+	191, 191, 191, 191, 191, 191, 208, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 191, 141, 208, 208, 208, 191,
+	191, 191, 191, 191, 191, 191, 141, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 208, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 208, 208, 191, 208, 208, 191, 208, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 208, 208, 208,
+	191, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 320, 320, 320, 320, 320, 320, 320, 320,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 320, 320,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 320, 320, 320,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 320, 320, 320, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 208, 208, 208, 191, 320, 191, 320, 320, 320, 320, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 320, 320, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 320, 320, 320, 320, 191,
+	208, 208, 208, 208, 208, 208, 208, 208, 208, 320, 320, 320, 320, 320, 320, 320,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 69, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 73, 69, 69, 69, 69, 69,
+	320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+	320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+	320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+	320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+	320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+	320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+	320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320,
+	320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 69, 69,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 253, 253,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 253, 253,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 69, 69,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 253, 253, 253, 253, 253,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 253, 253, 253, 253, 253, 253,
+	172, 89, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
+	172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
+	325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325,
+	325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325,
+	325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325,
+	325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325,
+	325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325,
+	325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325,
+	172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
+	172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
+	172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
+	172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
+	172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
+	172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
+	172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
+	172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
+	252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252,
+	252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252,
+	252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252,
+	252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252,
+	252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252,
+	252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252,
+	252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252,
+	252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 69, 69,
+}
+
+var propertyTrieValues = [326]codePointProperties{
+	{prControl, prXX, prXX, prCM, gcCc, prN, prXX, prXX},
+	{prControl, prXX, prSp, prBA, gcCc, prN, prXX, prXX},
+	{prLF, prLF, prLF, prLF, gcCc, prN, prXX, prXX},
+	{prControl, prNewline, prSp, prBK, gcCc, prN, prXX, prXX},
+	{prCR, prCR, prCR, prCR, gcCc, prN, prXX, prXX},
+	{prXX, prWSegSpace, prSp, prSP, gcZs, prNa, prXX, prXX},
+	{prXX, prXX, prSTerm, prEX, gcPo, prNa, prXX, prXX},
+	{prXX, prDoubleQuote, prClose, prQU, gcPo, prNa, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcPo, prNa, prXX, prXX},
+	{prXX, prXX, prXX, prPR, gcSc, prNa, prXX, prXX},
+	{prXX, prXX, prXX, prPO, gcPo, prNa, prXX, prXX},
+	{prXX, prSingleQuote, prClose, prQU, gcPo, prNa, prXX, prXX},
+	{prXX, prXX, prClose, prOP, gcPs, prNa, prXX, prXX},
+	{prXX, prXX, prClose, prCP, gcPe, prNa, prXX, prXX},
+	{prXX, prXX, prXX, prPR, gcSm, prNa, prXX, prXX},
+	{prXX, prMidNum, prSContinue, prIS, gcPo, prNa, prXX, prXX},
+	{prXX, prXX, prSContinue, prHY, gcPd, prNa, prXX, prXX},
+	{prXX, prMidNumLet, prATerm, prIS, gcPo, prNa, prXX, prXX},
+	{prXX, prXX, prXX, prSY, gcPo, prNa, prXX, prXX},
+	{prXX, prNumeric, prNumeric, prNU, gcNd, prNa, prXX, prXX},
+	{prXX, prMidLetter, prSContinue, prIS, gcPo, prNa, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcSm, prNa, prXX, prXX},
+	{prXX, prALetter, prUpper, prAL, gcLu, prNa, prXX, prXX},
+	{prXX, prXX, prXX, prPR, gcPo, prNa, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcSk, prNa, prXX, prXX},
+	{prXX, prExtendNumLet, prXX, prAL, gcPc, prNa, prXX, prXX},
+	{prXX, prALetter, prLower, prAL, gcLl, prNa, prXX, prXX},
+	{prXX, prXX, prXX, prBA, gcSm, prNa, prXX, prXX},
+	{prXX, prXX, prClose, prCL, gcPe, prNa, prXX, prXX},
+	{prControl, prNewline, prSep, prNL, gcCc, prN, prXX, prXX},
+	{prXX, prXX, prSp, prGL, gcZs, prN, prXX, prXX},
+	{prXX, prXX, prXX, prOP, gcPo, prA, prXX, prXX},
+	{prXX, prXX, prXX, prPO, gcSc, prNa, prXX, prXX},
+	{prXX, prXX, prXX, prPR, gcSc, prA, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcSo, prNa, prXX, prXX},
+	{prXX, prXX, prXX, prAI, gcPo, prA, prXX, prXX},
+	{prXX, prXX, prXX, prAI, gcSk, prA, prXX, prXX},
+	{prExtendedPictographic, prXX, prXX, prAL, gcSo, prN, prXX, prXX},
+	{prXX, prALetter, prLower, prAI, gcLo, prA, prXX, prXX},
+	{prXX, prXX, prClose, prQU, gcPi, prN, prXX, prXX},
+	{prControl, prFormat, prFormat, prBA, gcCf, prA, prXX, prXX},
+	{prExtendedPictographic, prXX, prXX, prAL, gcSo, prA, prXX, prXX},
+	{prXX, prXX, prXX, prPO, gcSo, prA, prXX, prXX},
+	{prXX, prXX, prXX, prPR, gcSm, prA, prXX, prXX},
+	{prXX, prXX, prXX, prAI, gcNo, prA, prXX, prXX},
+	{prXX, prXX, prXX, prBB, gcSk, prA, prXX, prXX},
+	{prXX, prALetter, prLower, prAL, gcLl, prN, prXX, prXX},
+	{prXX, prMidLetter, prXX, prAI, gcPo, prA, prXX, prXX},
+	{prXX, prALetter, prXX, prAI, gcSk, prA, prXX, prXX},
+	{prXX, prXX, prClose, prQU, gcPf, prN, prXX, prXX},
+	{prXX, prALetter, prUpper, prAL, gcLu, prN, prXX, prXX},
+	{prXX, prALetter, prUpper, prAL, gcLu, prA, prXX, prXX},
+	{prXX, prXX, prXX, prAI, gcSm, prA, prXX, prXX},
+	{prXX, prALetter, prUpper, prAL, gcLC, prA, prXX, prXX},
+	{prXX, prALetter, prUpper, prAL, gcLC, prN, prXX, prXX},
+	{prXX, prALetter, prLower, prAL, gcLC, prA, prXX, prXX},
+	{prXX, prALetter, prLower, prAL, gcLC, prN, prXX, prXX},
+	{prXX, prALetter, prLower, prAL, gcLl, prA, prXX, prXX},
+	{prXX, prALetter, prOLetter, prAL, gcLo, prN, prXX, prXX},
+	{prXX, prALetter, prLower, prAL, gcLm, prN, prXX, prXX},
+	{prXX, prALetter, prOLetter, prAL, gcLm, prN, prXX, prXX},
+	{prXX, prALetter, prXX, prAL, gcSk, prN, prXX, prXX},
+	{prXX, prALetter, prXX, prAL, gcSk, prA, prXX, prXX},
+	{prXX, prALetter, prOLetter, prAI, gcLm, prA, prXX, prXX},
+	{prXX, prALetter, prOLetter, prBB, gcLm, prN, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcSk, prN, prXX, prXX},
+	{prXX, prALetter, prXX, prBB, gcSk, prA, prXX, prXX},
+	{prExtend, prExtend, prExtend, prCM, gcMn, prA, prXX, prInCBExtend},
+	{prExtend, prExtend, prExtend, prGL, gcMn, prA, prXX, prInCBExtend},
+	{prXX, prXX, prXX, prXX, gcNone, prXX, prXX, prXX},
+	{prXX, prMidNum, prSContinue, prIS, gcPo, prN, prXX, prXX},
+	{prXX, prMidLetter, prXX, prAL, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcSm, prN, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcSo, prN, prXX, prXX},
+	{prExtend, prExtend, prExtend, prCM, gcMn, prN, prXX, prInCBExtend},
+	{prExtend, prExtend, prExtend, prCM, gcMe, prN, prXX, prInCBExtend},
+	{prXX, prALetter, prXX, prAL, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prSContinue, prAL, gcPo, prN, prXX, prXX},
+	{prXX, prMidNum, prSTerm, prIS, gcPo, prN, prXX, prXX},
+	{prXX, prALetter, prXX, prHH, gcPd, prN, prXX, prXX},
+	{prXX, prXX, prXX, prPR, gcSc, prN, prXX, prXX},
+	{prXX, prXX, prXX, prHH, gcPd, prN, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prEX, gcPo, prN, prXX, prXX},
+	{prXX, prHebrewLetter, prOLetter, prHL, gcLo, prN, prXX, prXX},
+	{prXX, prALetter, prOLetter, prAL, gcPo, prN, prXX, prXX},
+	{prPrepend, prNumeric, prNumeric, prNU, gcCf, prN, prXX, prXX},
+	{prXX, prXX, prXX, prPO, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prPO, gcSc, prN, prXX, prXX},
+	{prControl, prFormat, prFormat, prCM, gcCf, prN, prXX, prXX},
+	{prXX, prXX, prSTerm, prEX, gcPo, prN, prXX, prXX},
+	{prXX, prNumeric, prNumeric, prNU, gcNd, prN, prXX, prXX},
+	{prXX, prNumeric, prNumeric, prNU, gcPo, prN, prXX, prXX},
+	{prXX, prMidNum, prNumeric, prNU, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prSTerm, prAL, gcPo, prN, prXX, prXX},
+	{prPrepend, prALetter, prFormat, prAL, gcCf, prN, prXX, prXX},
+	{prSpacingMark, prExtend, prExtend, prCM, gcMc, prN, prXX, prXX},
+	{prXX, prALetter, prOLetter, prAL, gcLo, prN, prXX, prInCBConsonant},
+	{prExtend, prExtend, prExtend, prCM, gcMn, prN, prXX, prInCBLinker},
+	{prXX, prXX, prSTerm, prBA, gcPo, prN, prXX, prXX},
+	{prExtend, prExtend, prExtend, prCM, gcMc, prN, prXX, prInCBExtend},
+	{prXX, prXX, prXX, prAL, gcNo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prPO, gcNo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prBB, gcPo, prN, prXX, prXX},
+	{prPrepend, prALetter, prOLetter, prAL, gcLo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prPO, gcSo, prN, prXX, prXX},
+	{prXX, prXX, prOLetter, prSA, gcLo, prN, prXX, prXX},
+	{prExtend, prExtend, prExtend, prSA, gcMn, prN, prXX, prInCBExtend},
+	{prSpacingMark, prXX, prOLetter, prSA, gcLo, prN, prXX, prXX},
+	{prXX, prXX, prOLetter, prSA, gcLm, prN, prXX, prXX},
+	{prXX, prXX, prXX, prBA, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prBB, gcSo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prGL, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prBA, gcSo, prN, prXX, prXX},
+	{prXX, prXX, prClose, prOP, gcPs, prN, prXX, prXX},
+	{prXX, prXX, prClose, prCL, gcPe, prN, prXX, prXX},
+	{prSpacingMark, prExtend, prExtend, prBA, gcMc, prN, prXX, prXX},
+	{prXX, prXX, prOLetter, prSA, gcLo, prN, prXX, prInCBConsonant},
+	{prXX, prExtend, prExtend, prSA, gcMc, prN, prXX, prXX},
+	{prSpacingMark, prExtend, prExtend, prSA, gcMc, prN, prXX, prXX},
+	{prExtend, prExtend, prExtend, prSA, gcMn, prN, prXX, prInCBLinker},
+	{prXX, prXX, prXX, prSA, gcSo, prN, prXX, prXX},
+	{prXX, prALetter, prOLetter, prAL, gcLl, prN, prXX, prXX},
+	{prL, prALetter, prOLetter, prJL, gcLo, prW, prXX, prXX},
+	{prV, prALetter, prOLetter, prJV, gcLo, prN, prXX, prXX},
+	{prT, prALetter, prOLetter, prJT, gcLo, prN, prXX, prXX},
+	{prXX, prWSegSpace, prSp, prBA, gcZs, prN, prXX, prXX},
+	{prXX, prALetter, prOLetter, prAL, gcNl, prN, prXX, prXX},
+	{prXX, prXX, prXX, prNS, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prSContinue, prEX, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prBB, gcPd, prN, prXX, prXX},
+	{prControl, prFormat, prFormat, prGL, gcCf, prN, prXX, prXX},
+	{prXX, prNumeric, prNumeric, prNU, gcNo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prSA, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prSTerm, prSA, gcPo, prN, prXX, prXX},
+	{prExtend, prExtend, prExtend, prGL, gcMn, prN, prXX, prInCBExtend},
+	{prXX, prALetter, prOLetter, prAK, gcLo, prN, prXX, prXX},
+	{prXX, prALetter, prOLetter, prAK, gcLo, prN, prXX, prInCBConsonant},
+	{prExtend, prExtend, prExtend, prVI, gcMc, prN, prXX, prInCBLinker},
+	{prXX, prNumeric, prNumeric, prAS, gcNd, prN, prXX, prXX},
+	{prXX, prXX, prXX, prID, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prID, gcSo, prN, prXX, prXX},
+	{prXX, prALetter, prOLetter, prAS, gcLo, prN, prXX, prXX},
+	{prExtend, prExtend, prExtend, prVF, gcMc, prN, prXX, prInCBExtend},
+	{prXX, prALetter, prOLetter, prAL, gcLu, prN, prXX, prXX},
+	{prXX, prXX, prXX, prBB, gcSk, prN, prXX, prXX},
+	{prControl, prXX, prFormat, prZW, gcCf, prN, prXX, prXX},
+	{prExtend, prExtend, prExtend, prCM, gcCf, prN, prXX, prXX},
+	{prZWJ, prZWJ, prExtend, prZWJ, gcCf, prN, prXX, prInCBExtend},
+	{prXX, prXX, prXX, prHH, gcPd, prA, prXX, prXX},
+	{prXX, prXX, prXX, prGL, gcPd, prN, prXX, prXX},
+	{prXX, prXX, prSContinue, prHH, gcPd, prA, prXX, prXX},
+	{prXX, prXX, prSContinue, prB2, gcPd, prA, prXX, prXX},
+	{prXX, prXX, prXX, prAI, gcPd, prA, prXX, prXX},
+	{prXX, prMidNumLet, prClose, prQU, gcPi, prA, prXX, prXX},
+	{prXX, prMidNumLet, prClose, prQU, gcPf, prA, prXX, prXX},
+	{prXX, prXX, prClose, prQU, gcPi, prA, prXX, prXX},
+	{prXX, prXX, prClose, prQU, gcPf, prA, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcPo, prA, prXX, prXX},
+	{prXX, prMidNumLet, prATerm, prIN, gcPo, prA, prXX, prXX},
+	{prXX, prXX, prXX, prIN, gcPo, prA, prXX, prXX},
+	{prXX, prMidLetter, prXX, prBA, gcPo, prA, prXX, prXX},
+	{prControl, prNewline, prSep, prBK, gcZl, prN, prXX, prXX},
+	{prControl, prNewline, prSep, prBK, gcZp, prN, prXX, prXX},
+	{prXX, prExtendNumLet, prSp, prGL, gcZs, prN, prXX, prXX},
+	{prXX, prXX, prXX, prPO, gcPo, prA, prXX, prXX},
+	{prExtendedPictographic, prXX, prSTerm, prNS, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prSTerm, prNS, gcPo, prN, prXX, prXX},
+	{prXX, prExtendNumLet, prXX, prAL, gcPc, prN, prXX, prXX},
+	{prXX, prMidNum, prXX, prIS, gcSm, prN, prXX, prXX},
+	{prControl, prFormat, prFormat, prWJ, gcCf, prN, prXX, prXX},
+	{prControl, prFormat, prFormat, prAL, gcCf, prN, prXX, prXX},
+	{prControl, prXX, prXX, prXX, gcNone, prXX, prXX, prXX},
+	{prXX, prALetter, prLower, prAI, gcLm, prA, prXX, prXX},
+	{prXX, prXX, prXX, prPR, gcSc, prH, prXX, prXX},
+	{prXX, prXX, prXX, prPR, gcCn, prXX, prXX, prXX},
+	{prXX, prXX, prXX, prAI, gcSo, prA, prXX, prXX},
+	{prXX, prALetter, prLower, prAI, gcLl, prA, prXX, prXX},
+	{prXX, prXX, prXX, prPR, gcSo, prA, prXX, prXX},
+	{prExtendedPictographic, prXX, prXX, prAI, gcSo, prA, prXX, prXX},
+	{prXX, prALetter, prUpper, prAI, gcLu, prA, prXX, prXX},
+	{prExtendedPictographic, prALetter, prLower, prAL, gcLl, prN, prXX, prXX},
+	{prXX, prXX, prXX, prAI, gcNo, prN, prXX, prXX},
+	{prXX, prALetter, prUpper, prAI, gcNl, prA, prXX, prXX},
+	{prXX, prALetter, prUpper, prAL, gcNl, prN, prXX, prXX},
+	{prXX, prALetter, prLower, prAI, gcNl, prA, prXX, prXX},
+	{prXX, prALetter, prLower, prAL, gcNl, prN, prXX, prXX},
+	{prExtendedPictographic, prXX, prXX, prAI, gcSm, prA, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcSo, prA, prXX, prXX},
+	{prXX, prXX, prXX, prPR, gcSm, prN, prXX, prXX},
+	{prXX, prXX, prXX, prIN, gcSm, prN, prXX, prXX},
+	{prExtendedPictographic, prXX, prXX, prID, gcSo, prW, prEmojiPresentation, prXX},
+	{prXX, prXX, prClose, prOP, gcPs, prW, prXX, prXX},
+	{prXX, prXX, prClose, prCL, gcPe, prW, prXX, prXX},
+	{prExtendedPictographic, prXX, prXX, prAL, gcSo, prW, prEmojiPresentation, prXX},
+	{prExtendedPictographic, prXX, prXX, prID, gcSo, prN, prXX, prXX},
+	{prXX, prALetter, prUpper, prAI, gcSo, prA, prXX, prXX},
+	{prExtendedPictographic, prALetter, prUpper, prAI, gcSo, prA, prXX, prXX},
+	{prXX, prALetter, prLower, prAI, gcSo, prA, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcNo, prA, prXX, prXX},
+	{prXX, prXX, prXX, prAI, gcSo, prN, prXX, prXX},
+	{prExtendedPictographic, prXX, prXX, prAL, gcSm, prN, prXX, prXX},
+	{prExtendedPictographic, prXX, prXX, prAL, gcSm, prW, prEmojiPresentation, prXX},
+	{prXX, prXX, prXX, prID, gcSo, prA, prXX, prXX},
+	{prExtendedPictographic, prXX, prXX, prEB, gcSo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcSo, prW, prXX, prXX},
+	{prExtendedPictographic, prXX, prXX, prID, gcSo, prA, prXX, prXX},
+	{prExtendedPictographic, prXX, prXX, prEB, gcSo, prA, prXX, prXX},
+	{prExtendedPictographic, prXX, prXX, prEB, gcSo, prW, prEmojiPresentation, prXX},
+	{prExtendedPictographic, prXX, prXX, prAI, gcSo, prW, prEmojiPresentation, prXX},
+	{prXX, prXX, prClose, prQU, gcSo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prEX, gcSo, prN, prXX, prXX},
+	{prExtendedPictographic, prXX, prXX, prEX, gcSo, prN, prXX, prXX},
+	{prXX, prXX, prClose, prQU, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prOP, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcPd, prN, prXX, prXX},
+	{prXX, prXX, prXX, prB2, gcPd, prN, prXX, prXX},
+	{prXX, prXX, prClose, prCP, gcPe, prN, prXX, prXX},
+	{prXX, prXX, prXX, prID, gcSo, prW, prXX, prXX},
+	{prXX, prWSegSpace, prSp, prBA, gcZs, prF, prXX, prXX},
+	{prXX, prXX, prSContinue, prCL, gcPo, prW, prXX, prXX},
+	{prXX, prXX, prSTerm, prCL, gcPo, prW, prXX, prXX},
+	{prXX, prXX, prXX, prID, gcPo, prW, prXX, prXX},
+	{prXX, prALetter, prOLetter, prNS, gcLm, prW, prXX, prXX},
+	{prXX, prXX, prOLetter, prID, gcLo, prW, prXX, prXX},
+	{prXX, prXX, prOLetter, prID, gcNl, prW, prXX, prXX},
+	{prXX, prXX, prXX, prNS, gcPd, prW, prXX, prXX},
+	{prExtend, prExtend, prExtend, prCM, gcMn, prW, prXX, prInCBExtend},
+	{prExtend, prExtend, prExtend, prCM, gcMc, prW, prXX, prInCBExtend},
+	{prExtendedPictographic, prXX, prXX, prID, gcPd, prW, prXX, prXX},
+	{prXX, prKatakana, prOLetter, prID, gcLm, prW, prXX, prXX},
+	{prXX, prKatakana, prOLetter, prCM, gcLm, prW, prXX, prXX},
+	{prXX, prALetter, prOLetter, prNS, gcLo, prW, prXX, prXX},
+	{prExtendedPictographic, prXX, prXX, prID, gcPo, prW, prXX, prXX},
+	{prXX, prXX, prOLetter, prCJ, gcLo, prW, prXX, prXX},
+	{prXX, prKatakana, prXX, prNS, gcSk, prW, prXX, prXX},
+	{prXX, prXX, prOLetter, prNS, gcLm, prW, prXX, prXX},
+	{prXX, prKatakana, prXX, prNS, gcPd, prW, prXX, prXX},
+	{prXX, prKatakana, prOLetter, prCJ, gcLo, prW, prXX, prXX},
+	{prXX, prKatakana, prOLetter, prID, gcLo, prW, prXX, prXX},
+	{prXX, prXX, prXX, prNS, gcPo, prW, prXX, prXX},
+	{prXX, prKatakana, prOLetter, prCJ, gcLm, prW, prXX, prXX},
+	{prXX, prKatakana, prOLetter, prNS, gcLm, prW, prXX, prXX},
+	{prXX, prALetter, prOLetter, prID, gcLo, prW, prXX, prXX},
+	{prXX, prXX, prXX, prID, gcNo, prW, prXX, prXX},
+	{prExtendedPictographic, prXX, prXX, prID, gcSo, prW, prXX, prXX},
+	{prXX, prKatakana, prXX, prID, gcSo, prW, prXX, prXX},
+	{prXX, prALetter, prOLetter, prBA, gcLm, prN, prXX, prXX},
+	{prXX, prALetter, prOLetter, prBA, gcLo, prN, prXX, prXX},
+	{prLV, prALetter, prOLetter, prH2, gcLo, prW, prXX, prXX},
+	{prLVT, prALetter, prOLetter, prH3, gcLo, prW, prXX, prXX},
+	{prXX, prXX, prXX, prSG, gcCs, prN, prXX, prXX},
+	{prXX, prXX, prXX, prXX, gcCo, prA, prXX, prXX},
+	{prXX, prXX, prXX, prID, gcCn, prW, prXX, prXX},
+	{prXX, prMidLetter, prSContinue, prNS, gcPo, prW, prXX, prXX},
+	{prXX, prXX, prSContinue, prNS, gcPo, prW, prXX, prXX},
+	{prXX, prXX, prSTerm, prEX, gcPo, prW, prXX, prXX},
+	{prXX, prXX, prXX, prIN, gcPo, prW, prXX, prXX},
+	{prXX, prXX, prSContinue, prID, gcPd, prW, prXX, prXX},
+	{prXX, prExtendNumLet, prXX, prID, gcPc, prW, prXX, prXX},
+	{prXX, prMidNum, prSContinue, prCL, gcPo, prW, prXX, prXX},
+	{prXX, prXX, prSContinue, prID, gcPo, prW, prXX, prXX},
+	{prXX, prMidNumLet, prATerm, prCL, gcPo, prW, prXX, prXX},
+	{prXX, prMidNum, prSContinue, prNS, gcPo, prW, prXX, prXX},
+	{prXX, prXX, prXX, prID, gcSm, prW, prXX, prXX},
+	{prXX, prXX, prXX, prPR, gcSc, prW, prXX, prXX},
+	{prXX, prXX, prXX, prPO, gcPo, prW, prXX, prXX},
+	{prXX, prXX, prSTerm, prEX, gcPo, prF, prXX, prXX},
+	{prXX, prXX, prXX, prID, gcPo, prF, prXX, prXX},
+	{prXX, prXX, prXX, prPR, gcSc, prF, prXX, prXX},
+	{prXX, prXX, prXX, prPO, gcPo, prF, prXX, prXX},
+	{prXX, prMidNumLet, prXX, prID, gcPo, prF, prXX, prXX},
+	{prXX, prXX, prClose, prOP, gcPs, prF, prXX, prXX},
+	{prXX, prXX, prClose, prCL, gcPe, prF, prXX, prXX},
+	{prXX, prXX, prXX, prID, gcSm, prF, prXX, prXX},
+	{prXX, prMidNum, prSContinue, prCL, gcPo, prF, prXX, prXX},
+	{prXX, prXX, prSContinue, prID, gcPd, prF, prXX, prXX},
+	{prXX, prMidNumLet, prATerm, prCL, gcPo, prF, prXX, prXX},
+	{prXX, prNumeric, prNumeric, prID, gcNd, prF, prXX, prXX},
+	{prXX, prMidLetter, prSContinue, prNS, gcPo, prF, prXX, prXX},
+	{prXX, prMidNum, prSContinue, prNS, gcPo, prF, prXX, prXX},
+	{prXX, prALetter, prUpper, prID, gcLu, prF, prXX, prXX},
+	{prXX, prXX, prXX, prID, gcSk, prF, prXX, prXX},
+	{prXX, prExtendNumLet, prXX, prID, gcPc, prF, prXX, prXX},
+	{prXX, prALetter, prLower, prID, gcLl, prF, prXX, prXX},
+	{prXX, prXX, prSTerm, prCL, gcPo, prH, prXX, prXX},
+	{prXX, prXX, prClose, prOP, gcPs, prH, prXX, prXX},
+	{prXX, prXX, prClose, prCL, gcPe, prH, prXX, prXX},
+	{prXX, prXX, prSContinue, prCL, gcPo, prH, prXX, prXX},
+	{prXX, prXX, prXX, prNS, gcPo, prH, prXX, prXX},
+	{prXX, prKatakana, prOLetter, prID, gcLo, prH, prXX, prXX},
+	{prXX, prKatakana, prOLetter, prCJ, gcLo, prH, prXX, prXX},
+	{prXX, prKatakana, prOLetter, prCJ, gcLm, prH, prXX, prXX},
+	{prExtend, prExtend, prExtend, prNS, gcLm, prH, prXX, prInCBExtend},
+	{prXX, prALetter, prOLetter, prID, gcLo, prH, prXX, prXX},
+	{prXX, prXX, prXX, prPO, gcSc, prF, prXX, prXX},
+	{prXX, prXX, prXX, prID, gcSo, prF, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcSo, prH, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcSm, prH, prXX, prXX},
+	{prXX, prXX, prXX, prCB, gcSo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prIN, gcPo, prN, prXX, prXX},
+	{prXX, prALetter, prOLetter, prAP, gcLo, prN, prXX, prXX},
+	{prExtend, prExtend, prExtend, prVI, gcMn, prN, prXX, prInCBExtend},
+	{prXX, prXX, prXX, prID, gcNo, prN, prXX, prXX},
+	{prExtend, prExtend, prExtend, prVI, gcMc, prN, prXX, prInCBExtend},
+	{prXX, prALetter, prOLetter, prAS, gcLo, prN, prXX, prInCBConsonant},
+	{prXX, prALetter, prOLetter, prID, gcLo, prN, prXX, prXX},
+	{prExtend, prExtend, prExtend, prVI, gcMn, prN, prXX, prInCBLinker},
+	{prPrepend, prALetter, prOLetter, prAP, gcLo, prN, prXX, prXX},
+	{prXX, prXX, prSTerm, prID, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prSA, gcNo, prN, prXX, prXX},
+	{prXX, prALetter, prOLetter, prOP, gcLo, prN, prXX, prXX},
+	{prXX, prALetter, prOLetter, prCL, gcLo, prN, prXX, prXX},
+	{prControl, prFormat, prFormat, prOP, gcCf, prN, prXX, prXX},
+	{prControl, prFormat, prFormat, prCL, gcCf, prN, prXX, prXX},
+	{prV, prALetter, prOLetter, prAL, gcLo, prN, prXX, prXX},
+	{prExtend, prExtend, prExtend, prGL, gcMn, prW, prXX, prInCBExtend},
+	{prXX, prXX, prOLetter, prAL, gcLo, prW, prXX, prXX},
+	{prXX, prKatakana, prOLetter, prAL, gcLm, prW, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcNo, prW, prXX, prXX},
+	{prExtendedPictographic, prXX, prXX, prID, gcCn, prXX, prXX, prXX},
+	{prRegionalIndicator, prRegionalIndicator, prXX, prRI, gcSo, prN, prEmojiPresentation, prXX},
+	{prExtend, prExtend, prXX, prEM, gcSk, prW, prEmojiPresentation, prInCBExtend},
+	{prXX, prXX, prXX, prNS, gcSo, prN, prXX, prXX},
+	{prExtendedPictographic, prXX, prXX, prXX, gcNone, prXX, prXX, prXX},
+	{prExtend, prExtend, prExtend, prCM, gcCf, prN, prXX, prInCBExtend},
+}
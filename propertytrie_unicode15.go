@@ -0,0 +1,3250 @@
+//go:build uniseg_unicode15
+
+// Code generated via go generate from gen_properties.go. DO NOT EDIT.
+
+package uniseg
+
+// propertyTrieShift is the number of lower bits of a code point which are
+// used as an index into a block of propertyTrieBlocks.
+const propertyTrieShift = 7
+
+// propertyTrieIndex, propertyTrieBlocks, and propertyTrieValues form a
+// three-stage lookup table for the properties of all code points, see
+// [codePointProperties]. They were derived on October 18, 2026 from the
+// property tables in the following files:
+//
+//   - graphemeproperties_unicode15.go
+//   - wordproperties_unicode15.go
+//   - sentenceproperties_unicode15.go
+//   - lineproperties_unicode15.go
+//   - eastasianwidth_unicode15.go
+//   - emojipresentation_unicode15.go
+//   - incbproperties.go
+var propertyTrieIndex = [8704]uint16{
+	0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15,
+	16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
+	32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 41, 41, 42, 43, 44, 45,
+	46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
+	62, 63, 64, 65, 66, 67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77,
+	78, 78, 79, 80, 81, 81, 82, 83, 84, 85, 86, 87, 88, 89, 90, 91,
+	92, 93, 94, 95, 96, 97, 98, 90, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 100, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	101, 102, 102, 102, 102, 102, 102, 102, 102, 103, 41, 41, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117, 118, 119, 120, 121, 122, 116,
+	117, 118, 119, 120, 121, 122, 116, 117, 118, 119, 120, 121, 122, 116, 117, 118,
+	119, 120, 121, 122, 116, 117, 118, 119, 120, 121, 122, 116, 117, 118, 119, 120,
+	121, 122, 116, 117, 118, 119, 120, 121, 122, 116, 117, 118, 119, 120, 121, 122,
+	116, 117, 118, 119, 120, 121, 122, 116, 117, 118, 119, 120, 121, 122, 116, 117,
+	118, 119, 120, 121, 122, 116, 117, 118, 119, 120, 121, 122, 116, 117, 118, 123,
+	124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 99, 99, 126, 127, 128, 129, 41, 41, 130, 131, 132, 133, 134, 135,
+	136, 137, 138, 139, 140, 141, 142, 143, 144, 145, 146, 147, 41, 41, 148, 149,
+	150, 151, 152, 153, 154, 155, 156, 157, 158, 159, 160, 140, 161, 162, 163, 164,
+	165, 166, 167, 168, 169, 170, 171, 172, 173, 174, 140, 175, 176, 177, 178, 140,
+	179, 180, 181, 182, 183, 184, 185, 140, 186, 187, 188, 189, 140, 190, 191, 192,
+	41, 41, 41, 41, 41, 41, 41, 193, 194, 41, 195, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 196,
+	41, 41, 41, 41, 197, 198, 199, 41, 200, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 41, 41, 41, 201, 202, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 203, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	41, 41, 41, 41, 204, 205, 206, 207, 140, 140, 140, 140, 208, 209, 210, 211,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 212,
+	99, 99, 99, 99, 99, 99, 213, 213, 213, 214, 215, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 216,
+	217, 99, 218, 99, 99, 219, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 220, 221, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 222, 223,
+	78, 224, 225, 226, 227, 228, 229, 140, 230, 231, 232, 233, 234, 235, 236, 237,
+	78, 78, 78, 78, 238, 239, 140, 140, 140, 140, 140, 140, 140, 140, 240, 140,
+	241, 242, 243, 140, 140, 244, 140, 140, 140, 245, 140, 246, 140, 247, 140, 248,
+	41, 249, 250, 140, 140, 140, 140, 140, 251, 252, 253, 140, 254, 255, 140, 140,
+	256, 257, 258, 259, 260, 261, 262, 263, 264, 265, 266, 267, 268, 269, 270, 271,
+	272, 273, 274, 275, 276, 277, 78, 278, 261, 261, 261, 261, 261, 261, 261, 279,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 280, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 281, 99,
+	282, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 283, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 284, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	99, 99, 99, 99, 286, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 287,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 288, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 289, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 287,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	290, 291, 292, 293, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291,
+	291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 294,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 294,
+}
+
+var propertyTrieBlocks = [37760]uint16{
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 2, 3, 3, 4, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	5, 6, 7, 8, 9, 10, 8, 11, 12, 13, 8, 14, 15, 16, 17, 18,
+	19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 20, 21, 22, 22, 22, 6,
+	8, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
+	23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 12, 24, 13, 25, 26,
+	25, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27,
+	27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 12, 28, 29, 22, 0,
+	0, 0, 0, 0, 0, 30, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	31, 32, 33, 9, 34, 9, 35, 36, 37, 38, 39, 40, 22, 41, 42, 25,
+	43, 44, 45, 45, 46, 47, 36, 48, 37, 45, 39, 49, 45, 45, 45, 32,
+	50, 50, 50, 50, 50, 50, 51, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	51, 50, 50, 50, 50, 50, 50, 52, 53, 54, 54, 54, 54, 54, 53, 55,
+	55, 55, 56, 56, 56, 56, 55, 56, 55, 55, 55, 56, 55, 55, 56, 56,
+	55, 56, 55, 55, 56, 56, 56, 52, 57, 57, 57, 47, 57, 47, 57, 47,
+	54, 55, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 55, 54, 55, 54, 56, 54, 56, 54, 56, 54, 55, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 53, 55, 54, 56, 54, 55, 54, 56, 54, 56,
+	54, 55, 53, 55, 54, 56, 54, 56, 55, 54, 56, 54, 56, 54, 56, 53,
+	55, 53, 55, 54, 55, 54, 56, 54, 55, 55, 53, 55, 54, 55, 54, 56,
+	54, 56, 53, 55, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 53, 55, 54, 56, 54, 55, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 54, 56, 54, 56, 54, 56, 56,
+	56, 54, 54, 56, 54, 56, 54, 54, 56, 54, 54, 54, 56, 56, 54, 54,
+	54, 54, 56, 54, 54, 56, 54, 54, 54, 56, 56, 56, 54, 54, 56, 54,
+	54, 56, 54, 56, 54, 56, 54, 54, 56, 54, 56, 56, 54, 56, 54, 54,
+	56, 54, 54, 54, 56, 54, 56, 54, 54, 56, 56, 58, 54, 56, 56, 56,
+	58, 58, 58, 58, 54, 54, 56, 54, 54, 56, 54, 54, 56, 54, 55, 54,
+	55, 54, 55, 54, 55, 54, 55, 54, 55, 54, 55, 54, 55, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	56, 54, 54, 56, 54, 56, 54, 54, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 56, 56, 56, 56, 56, 56, 54, 54, 56, 54, 54, 56,
+	56, 54, 56, 54, 54, 54, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	47, 57, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 57, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 58, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 60, 60, 60, 60, 60, 60, 60,
+	59, 59, 61, 61, 62, 61, 60, 63, 64, 63, 63, 63, 64, 63, 60, 60,
+	63, 60, 61, 61, 61, 61, 61, 61, 37, 37, 37, 37, 65, 37, 61, 66,
+	59, 59, 59, 59, 59, 61, 61, 61, 61, 61, 61, 61, 60, 61, 60, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 68,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 68, 68, 68, 68,
+	68, 68, 68, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	54, 56, 54, 56, 60, 65, 54, 56, 69, 69, 59, 47, 47, 47, 70, 50,
+	69, 69, 69, 69, 65, 65, 50, 71, 50, 50, 50, 69, 50, 69, 54, 54,
+	56, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53,
+	53, 53, 69, 53, 53, 53, 53, 53, 53, 53, 54, 54, 56, 56, 56, 56,
+	56, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55,
+	55, 55, 56, 55, 55, 55, 55, 55, 55, 55, 56, 56, 56, 56, 56, 54,
+	56, 56, 54, 54, 54, 56, 56, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	56, 56, 56, 56, 54, 56, 72, 54, 56, 54, 54, 56, 56, 54, 54, 54,
+	54, 53, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53,
+	53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53,
+	55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55,
+	55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55,
+	56, 55, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 73, 74, 74, 74, 74, 74, 75, 75, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	69, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 50, 69, 69, 60, 76, 76, 76, 77, 76, 71,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 78, 79, 69, 69, 73, 73, 80,
+	69, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 81, 74,
+	82, 74, 74, 82, 74, 74, 83, 74, 69, 69, 69, 69, 69, 69, 69, 69,
+	84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84,
+	84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 69, 69, 69, 69, 84,
+	84, 84, 84, 85, 71, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	86, 86, 86, 86, 86, 86, 72, 72, 72, 87, 87, 88, 89, 89, 73, 73,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 83, 90, 91, 91, 91,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	60, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 87, 93, 94, 82, 58, 58,
+	74, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 91, 58, 74, 74, 74, 74, 74, 74, 74, 86, 73, 74,
+	74, 74, 74, 74, 74, 60, 60, 74, 74, 73, 74, 74, 74, 74, 58, 58,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 58, 58, 58, 73, 73, 58,
+	95, 95, 95, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 69, 86,
+	58, 74, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 69, 69, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 60, 60, 73, 82, 89, 91, 60, 69, 69, 74, 80, 80,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 74, 74, 74, 74, 60, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 60, 74, 74, 74, 60, 74, 74, 74, 74, 74, 69, 69,
+	82, 82, 82, 82, 82, 82, 82, 95, 82, 95, 82, 82, 82, 95, 95, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 74, 74, 74, 69, 69, 82, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 65, 58, 58, 58, 58, 58, 58, 69,
+	86, 86, 69, 69, 69, 69, 69, 96, 74, 74, 74, 74, 74, 74, 74, 74,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 60, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 86, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 97, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 74, 97, 74, 58, 97, 97,
+	97, 74, 74, 74, 74, 74, 74, 74, 74, 97, 97, 97, 97, 99, 97, 97,
+	58, 74, 74, 74, 74, 74, 74, 74, 98, 98, 98, 98, 98, 98, 98, 98,
+	58, 58, 74, 74, 100, 100, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
+	82, 60, 58, 58, 58, 58, 58, 58, 98, 98, 98, 98, 98, 98, 98, 98,
+	58, 74, 97, 97, 69, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 58,
+	58, 69, 69, 58, 58, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 98, 98, 98, 98, 98, 69, 98, 98, 98, 98, 98, 98,
+	98, 69, 98, 69, 69, 69, 98, 98, 98, 98, 69, 69, 74, 58, 101, 97,
+	97, 74, 74, 74, 74, 69, 69, 97, 97, 69, 69, 97, 97, 99, 58, 69,
+	69, 69, 69, 69, 69, 69, 69, 101, 69, 69, 69, 69, 98, 98, 69, 98,
+	58, 58, 74, 74, 69, 69, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
+	98, 98, 88, 88, 102, 102, 102, 102, 102, 103, 73, 80, 58, 82, 74, 69,
+	69, 74, 74, 97, 69, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 58,
+	58, 69, 69, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 58,
+	58, 69, 58, 58, 69, 58, 58, 69, 58, 58, 69, 69, 74, 69, 97, 97,
+	97, 74, 74, 69, 69, 69, 69, 74, 74, 69, 69, 74, 74, 74, 69, 69,
+	69, 74, 69, 69, 69, 69, 69, 69, 69, 58, 58, 58, 58, 69, 58, 69,
+	69, 69, 69, 69, 69, 69, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
+	74, 74, 58, 58, 58, 74, 82, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 74, 74, 97, 69, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58,
+	58, 58, 69, 58, 58, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 98, 98, 98, 98, 98, 69, 98, 98, 98, 98, 98, 98,
+	98, 69, 98, 98, 69, 98, 98, 98, 98, 98, 69, 69, 74, 58, 97, 97,
+	97, 74, 74, 74, 74, 74, 69, 74, 74, 97, 69, 97, 97, 99, 69, 69,
+	58, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 74, 74, 69, 69, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
+	82, 80, 69, 69, 69, 69, 69, 69, 69, 98, 74, 74, 74, 74, 74, 74,
+	69, 74, 97, 97, 69, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 58,
+	58, 69, 69, 58, 58, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 98, 98, 98, 98, 98, 69, 98, 98, 98, 98, 98, 98,
+	98, 69, 98, 98, 69, 98, 98, 98, 98, 98, 69, 69, 74, 58, 101, 74,
+	97, 74, 74, 74, 74, 69, 69, 97, 97, 69, 69, 97, 97, 99, 69, 69,
+	69, 69, 69, 69, 69, 74, 74, 101, 69, 69, 69, 69, 98, 98, 69, 98,
+	58, 58, 74, 74, 69, 69, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
+	73, 98, 102, 102, 102, 102, 102, 102, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 74, 58, 69, 58, 58, 58, 58, 58, 58, 69, 69, 69, 58, 58,
+	58, 69, 58, 58, 58, 58, 69, 69, 69, 58, 58, 69, 58, 69, 58, 58,
+	69, 69, 69, 58, 58, 69, 69, 69, 58, 58, 58, 69, 69, 69, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 101, 97,
+	74, 97, 97, 69, 69, 69, 97, 97, 97, 69, 97, 97, 97, 74, 69, 69,
+	58, 69, 69, 69, 69, 69, 69, 101, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
+	102, 102, 102, 73, 73, 73, 73, 73, 73, 80, 73, 69, 69, 69, 69, 69,
+	74, 97, 97, 97, 74, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 58,
+	58, 69, 58, 58, 58, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 98, 98, 98, 98, 98, 69, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 69, 69, 74, 58, 74, 74,
+	74, 97, 97, 97, 97, 69, 74, 74, 74, 69, 74, 74, 74, 99, 69, 69,
+	69, 69, 69, 69, 69, 74, 74, 69, 98, 98, 98, 69, 69, 58, 69, 69,
+	58, 58, 74, 74, 69, 69, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
+	69, 69, 69, 69, 69, 69, 69, 104, 102, 102, 102, 102, 102, 102, 102, 73,
+	58, 74, 97, 97, 104, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 58,
+	58, 69, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 69, 69, 74, 58, 97, 74,
+	105, 97, 101, 97, 97, 69, 74, 105, 105, 69, 105, 105, 74, 74, 69, 69,
+	69, 69, 69, 69, 69, 101, 101, 69, 69, 69, 69, 69, 69, 58, 58, 69,
+	58, 58, 74, 74, 69, 69, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
+	69, 58, 58, 97, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	74, 74, 97, 97, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 58,
+	58, 69, 58, 58, 58, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 74, 74, 58, 101, 97,
+	97, 74, 74, 74, 74, 69, 97, 97, 97, 69, 97, 97, 97, 99, 106, 73,
+	69, 69, 69, 69, 58, 58, 58, 101, 102, 102, 102, 102, 102, 102, 102, 58,
+	58, 58, 74, 74, 69, 69, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
+	102, 102, 102, 102, 102, 102, 102, 102, 102, 107, 58, 58, 58, 58, 58, 58,
+	69, 74, 97, 97, 69, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 69, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 74, 69, 69, 69, 69, 101,
+	97, 97, 74, 74, 74, 69, 74, 69, 97, 97, 97, 97, 97, 97, 97, 101,
+	69, 69, 69, 69, 69, 69, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
+	69, 69, 97, 97, 82, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
+	108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
+	108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
+	108, 109, 108, 110, 109, 109, 109, 109, 109, 109, 109, 69, 69, 69, 69, 80,
+	108, 108, 108, 108, 108, 108, 111, 109, 109, 109, 109, 109, 109, 109, 109, 82,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 112, 112, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 108, 108, 69, 108, 69, 108, 108, 108, 108, 108, 69, 108, 108, 108, 108,
+	108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
+	108, 108, 108, 108, 69, 108, 69, 108, 108, 108, 108, 108, 108, 108, 108, 108,
+	108, 109, 108, 110, 109, 109, 109, 109, 109, 109, 109, 109, 109, 108, 69, 69,
+	108, 108, 108, 108, 108, 69, 111, 69, 109, 109, 109, 109, 109, 109, 109, 69,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 69, 108, 108, 108, 108,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 113, 113, 113, 104, 82, 104, 104, 114, 104, 104, 112, 114, 83, 83, 83,
+	83, 83, 114, 73, 83, 73, 73, 73, 74, 74, 73, 73, 73, 73, 73, 73,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 102, 102, 102, 102, 102, 102,
+	102, 102, 102, 102, 115, 74, 73, 74, 73, 74, 116, 117, 116, 117, 97, 97,
+	58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69,
+	69, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 118,
+	74, 74, 74, 74, 74, 112, 74, 74, 58, 58, 58, 58, 58, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 69, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 69, 115, 115,
+	73, 73, 73, 73, 73, 73, 74, 73, 73, 73, 73, 73, 73, 69, 73, 73,
+	104, 104, 112, 104, 82, 73, 73, 73, 73, 114, 114, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 120, 120, 109, 109, 109,
+	109, 121, 109, 109, 109, 109, 109, 109, 120, 122, 109, 121, 121, 109, 109, 119,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 100, 100, 82, 82, 82, 82,
+	119, 119, 119, 119, 119, 119, 121, 121, 109, 109, 119, 119, 119, 119, 109, 109,
+	109, 119, 120, 120, 120, 119, 119, 120, 120, 120, 120, 120, 120, 120, 119, 119,
+	119, 109, 109, 109, 109, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	119, 119, 109, 120, 121, 109, 109, 120, 120, 120, 120, 120, 120, 109, 119, 120,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 120, 120, 120, 109, 123, 123,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 69, 50, 69, 69, 69, 69, 69, 50, 69, 69,
+	124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124,
+	124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124,
+	124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 82, 59, 124, 124, 124,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126,
+	126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126,
+	126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126,
+	126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126,
+	126, 126, 126, 126, 126, 126, 126, 126, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 69, 58, 69, 58, 58, 58, 58, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 69, 58, 58, 58, 58, 69, 69, 58, 58, 58, 58, 58, 58, 58, 69,
+	58, 69, 58, 58, 58, 58, 69, 69, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 69, 58, 58, 58, 58, 69, 69, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 74, 74, 74,
+	82, 112, 95, 82, 82, 82, 82, 95, 95, 102, 102, 102, 102, 102, 102, 102,
+	102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 69, 69, 69, 69, 69, 69,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 69, 69, 47, 47, 47, 47, 47, 47, 69, 69,
+	81, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 73, 95, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	128, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 116, 117, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 112, 112, 112, 129, 129,
+	129, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 74, 74, 74, 105, 69, 69, 69, 69, 69, 69, 69, 69, 69, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 74, 74, 105, 100, 100, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 74, 74, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 58,
+	58, 69, 74, 74, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	119, 119, 119, 119, 109, 109, 121, 109, 109, 109, 109, 109, 109, 109, 121, 121,
+	121, 121, 121, 121, 121, 121, 109, 121, 121, 109, 109, 109, 109, 109, 109, 109,
+	109, 109, 122, 109, 112, 112, 130, 111, 112, 82, 112, 80, 108, 109, 69, 69,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 69, 69, 69, 69, 69,
+	102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 69, 69, 69, 69, 69, 69,
+	82, 82, 131, 91, 112, 112, 132, 82, 131, 91, 82, 74, 74, 74, 133, 74,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 60, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 74, 74, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 74, 58, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69,
+	74, 74, 74, 97, 97, 97, 97, 74, 74, 97, 97, 97, 69, 69, 69, 69,
+	97, 97, 74, 97, 97, 97, 97, 97, 97, 74, 74, 74, 69, 69, 69, 69,
+	73, 69, 69, 69, 91, 91, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
+	108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
+	108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 69, 69,
+	108, 108, 108, 108, 108, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
+	108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
+	108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 69, 69, 69, 69,
+	108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
+	108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 69, 69, 69, 69, 69, 69,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 134, 69, 69, 69, 123, 123,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 74, 74, 97, 97, 74, 69, 69, 82, 82,
+	119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	119, 119, 119, 119, 119, 121, 109, 121, 109, 109, 109, 109, 109, 109, 109, 69,
+	122, 120, 109, 120, 120, 109, 109, 109, 109, 109, 109, 109, 109, 121, 121, 121,
+	121, 121, 121, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 69, 69, 74,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 69, 69, 69, 69, 69,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 69, 69, 69, 69, 69,
+	135, 135, 135, 135, 135, 135, 135, 111, 136, 136, 136, 136, 135, 135, 69, 69,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 75, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 96,
+	96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 69, 69,
+	96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	74, 74, 74, 74, 97, 58, 58, 58, 58, 58, 58, 98, 98, 58, 58, 58,
+	58, 58, 58, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 74, 101, 74, 74, 74, 74, 74, 105, 74, 105, 97, 97,
+	97, 97, 74, 105, 137, 98, 98, 98, 98, 98, 98, 98, 98, 69, 69, 69,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 100, 100, 82, 112, 100, 100,
+	112, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 73, 73, 73, 73, 73, 73, 73, 73, 73, 100, 100, 69,
+	74, 74, 97, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98,
+	98, 97, 74, 74, 74, 74, 97, 97, 74, 74, 105, 99, 74, 74, 98, 98,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 58, 98, 98, 98, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 74, 97, 74, 74, 97, 97, 97, 74, 97, 74,
+	74, 74, 105, 105, 69, 69, 69, 69, 69, 69, 69, 69, 82, 82, 82, 82,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 97, 97, 97, 97, 97, 97, 97, 97, 74, 74, 74, 74,
+	74, 74, 74, 74, 97, 97, 74, 74, 69, 69, 69, 100, 100, 112, 112, 112,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 69, 69, 58, 58, 58,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 60, 60, 60, 60, 60, 60, 100, 100,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 69, 69, 69, 69, 69, 69, 69,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 69, 69, 138, 138, 138,
+	82, 82, 82, 82, 82, 82, 82, 82, 69, 69, 69, 69, 69, 69, 69, 69,
+	74, 74, 74, 82, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 97, 74, 74, 74, 74, 74, 74, 74, 58, 58, 58, 58, 74, 58, 58,
+	58, 58, 58, 58, 74, 58, 58, 97, 74, 74, 58, 69, 69, 69, 69, 69,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 59, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 139, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 139, 74, 74, 74,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 56, 56, 56, 56, 56, 56, 56, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 54, 54, 54, 54, 54, 54, 54, 54,
+	56, 56, 56, 56, 56, 56, 69, 69, 50, 50, 50, 50, 50, 50, 69, 69,
+	56, 56, 56, 56, 56, 56, 56, 56, 54, 54, 54, 54, 54, 54, 54, 54,
+	56, 56, 56, 56, 56, 56, 56, 56, 54, 54, 54, 54, 54, 54, 54, 54,
+	56, 56, 56, 56, 56, 56, 69, 69, 50, 50, 50, 50, 50, 50, 69, 69,
+	47, 47, 47, 47, 47, 47, 47, 47, 69, 50, 69, 50, 69, 50, 69, 54,
+	56, 56, 56, 56, 56, 56, 56, 56, 54, 54, 54, 54, 54, 54, 54, 54,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 69, 69,
+	56, 56, 56, 56, 56, 56, 56, 56, 54, 54, 54, 54, 54, 54, 54, 54,
+	56, 56, 56, 56, 56, 56, 56, 56, 54, 54, 54, 54, 54, 54, 54, 54,
+	56, 56, 56, 56, 56, 56, 56, 56, 54, 54, 54, 54, 54, 54, 54, 54,
+	56, 56, 56, 56, 56, 69, 56, 56, 54, 54, 54, 54, 54, 65, 47, 65,
+	65, 65, 47, 47, 47, 69, 56, 56, 54, 54, 54, 54, 54, 65, 65, 65,
+	47, 47, 47, 47, 69, 69, 56, 56, 54, 54, 54, 54, 69, 65, 65, 65,
+	56, 56, 56, 56, 56, 56, 56, 56, 54, 54, 54, 54, 54, 65, 65, 65,
+	69, 69, 47, 47, 47, 69, 56, 56, 54, 54, 54, 54, 54, 140, 65, 69,
+	128, 128, 128, 128, 128, 128, 128, 31, 128, 128, 128, 141, 142, 143, 90, 90,
+	144, 145, 81, 146, 147, 148, 36, 82, 149, 150, 116, 40, 151, 152, 116, 40,
+	36, 36, 153, 82, 154, 155, 155, 156, 157, 158, 90, 90, 90, 90, 90, 159,
+	160, 87, 160, 160, 87, 160, 87, 87, 82, 40, 49, 36, 161, 162, 153, 163,
+	163, 82, 82, 82, 164, 116, 117, 162, 162, 161, 82, 82, 82, 82, 82, 82,
+	82, 82, 72, 82, 163, 82, 112, 87, 112, 112, 112, 112, 82, 112, 112, 128,
+	165, 166, 166, 166, 166, 167, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90,
+	102, 59, 69, 69, 45, 102, 102, 102, 102, 102, 72, 72, 72, 116, 117, 168,
+	102, 45, 45, 45, 45, 102, 102, 102, 102, 102, 72, 72, 72, 116, 117, 69,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 69, 69, 69,
+	80, 80, 80, 80, 80, 80, 80, 88, 80, 169, 80, 80, 34, 80, 80, 80,
+	80, 80, 80, 80, 80, 80, 88, 80, 80, 80, 80, 88, 80, 80, 88, 80,
+	88, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 75, 75, 75,
+	75, 74, 75, 75, 75, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	73, 73, 50, 43, 73, 171, 73, 50, 73, 43, 56, 54, 54, 54, 56, 56,
+	54, 54, 54, 172, 73, 50, 173, 73, 72, 50, 50, 50, 50, 50, 73, 73,
+	73, 171, 174, 73, 50, 73, 51, 73, 50, 73, 50, 175, 50, 50, 73, 56,
+	54, 54, 54, 54, 56, 58, 58, 58, 58, 176, 73, 73, 56, 56, 54, 54,
+	72, 72, 72, 72, 72, 54, 56, 56, 56, 56, 73, 72, 73, 73, 47, 73,
+	102, 102, 102, 177, 45, 178, 102, 102, 102, 102, 102, 45, 177, 177, 45, 102,
+	179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 180, 180, 180, 180,
+	181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 182, 182, 182, 182, 182, 182,
+	129, 129, 129, 54, 56, 129, 129, 129, 129, 45, 73, 73, 69, 69, 69, 69,
+	52, 52, 52, 52, 183, 174, 174, 174, 174, 174, 72, 72, 73, 73, 73, 73,
+	72, 73, 73, 72, 73, 73, 72, 73, 73, 38, 38, 73, 73, 73, 72, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 184, 184, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 72, 72,
+	73, 73, 52, 73, 52, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 184, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	52, 72, 52, 52, 72, 72, 72, 52, 52, 72, 72, 52, 72, 72, 72, 52,
+	72, 52, 185, 185, 72, 52, 72, 72, 72, 72, 52, 72, 72, 52, 52, 52,
+	52, 72, 72, 52, 72, 52, 72, 52, 52, 52, 52, 52, 52, 72, 52, 72,
+	72, 72, 72, 72, 52, 52, 52, 52, 72, 72, 72, 72, 52, 52, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 52, 72, 72, 72, 52, 72, 72, 72,
+	72, 72, 52, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	52, 52, 72, 72, 52, 52, 52, 52, 72, 72, 52, 52, 72, 72, 52, 52,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 52, 52, 72, 72, 52, 52, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 52, 72, 72, 72, 52, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 52, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 52,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 186,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	73, 73, 73, 73, 73, 73, 73, 73, 116, 117, 116, 117, 73, 73, 73, 73,
+	73, 73, 171, 73, 73, 73, 73, 73, 73, 73, 187, 187, 73, 73, 73, 73,
+	72, 72, 73, 73, 73, 73, 73, 73, 38, 188, 189, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 72, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 38, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 38,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 72, 72, 72, 72,
+	72, 72, 73, 73, 73, 73, 73, 73, 73, 190, 190, 190, 190, 38, 38, 38,
+	187, 191, 191, 187, 73, 73, 73, 73, 38, 38, 38, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45,
+	45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45,
+	45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45,
+	45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 171, 171, 171, 171,
+	171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171,
+	171, 171, 171, 171, 171, 171, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 193, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194,
+	194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 178, 45, 45, 45, 45, 45,
+	45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 177,
+	171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171,
+	171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171,
+	171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171,
+	171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171,
+	171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 73, 73, 73, 73,
+	171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171,
+	171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171,
+	171, 171, 171, 171, 195, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171,
+	73, 73, 171, 171, 171, 171, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	171, 171, 73, 171, 171, 171, 171, 171, 171, 171, 38, 38, 73, 73, 73, 73,
+	73, 73, 171, 171, 73, 73, 174, 52, 73, 73, 73, 73, 171, 171, 73, 73,
+	174, 52, 73, 73, 73, 73, 171, 171, 171, 73, 73, 171, 73, 73, 171, 171,
+	171, 171, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 171, 171, 171, 171, 73, 73, 73, 73, 73, 73, 73, 73, 73, 171,
+	73, 73, 73, 73, 73, 73, 73, 73, 72, 72, 72, 196, 196, 197, 197, 72,
+	191, 191, 191, 191, 38, 174, 171, 38, 38, 174, 38, 38, 38, 38, 174, 174,
+	38, 38, 38, 73, 187, 187, 198, 198, 191, 38, 191, 191, 199, 200, 199, 191,
+	38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38,
+	38, 38, 38, 38, 38, 38, 38, 38, 38, 191, 191, 191, 38, 38, 38, 38,
+	174, 38, 174, 38, 38, 38, 38, 38, 190, 190, 190, 190, 190, 190, 190, 190,
+	190, 190, 190, 190, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38,
+	174, 174, 38, 174, 174, 174, 38, 174, 199, 174, 174, 38, 174, 174, 38, 183,
+	38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 187,
+	38, 38, 38, 38, 38, 38, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	38, 38, 38, 190, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 174, 174,
+	38, 190, 38, 38, 38, 38, 38, 38, 38, 38, 190, 190, 38, 38, 38, 38,
+	38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 187, 187, 199,
+	191, 191, 191, 191, 187, 187, 199, 199, 199, 174, 174, 174, 174, 199, 190, 199,
+	199, 199, 174, 199, 187, 174, 174, 174, 199, 199, 174, 174, 199, 174, 174, 199,
+	199, 199, 38, 174, 38, 38, 38, 38, 174, 174, 187, 174, 174, 174, 174, 174,
+	174, 199, 187, 187, 199, 187, 174, 199, 199, 201, 187, 174, 174, 187, 199, 199,
+	191, 191, 191, 191, 191, 190, 73, 73, 191, 191, 202, 202, 200, 200, 38, 38,
+	38, 38, 38, 73, 38, 73, 38, 73, 73, 73, 73, 73, 73, 38, 73, 73,
+	73, 38, 73, 73, 73, 73, 73, 73, 190, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 38, 38, 73, 73, 73, 73, 73, 73, 73, 73, 184, 73, 73,
+	73, 73, 73, 73, 38, 73, 73, 38, 73, 73, 73, 73, 190, 73, 190, 73,
+	73, 73, 73, 190, 190, 190, 73, 203, 73, 73, 73, 204, 204, 204, 204, 204,
+	204, 73, 205, 206, 191, 38, 38, 38, 116, 117, 116, 117, 116, 117, 116, 117,
+	116, 117, 116, 117, 116, 117, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45,
+	178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178,
+	178, 178, 178, 178, 73, 190, 190, 190, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 38, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	190, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 190,
+	72, 72, 72, 72, 72, 116, 117, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 12, 29, 12, 29, 12, 29, 12, 29, 116, 117,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 196, 196, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 116, 117, 12, 29, 116, 117, 116, 117, 116, 117, 116, 117, 116,
+	117, 116, 117, 116, 117, 116, 117, 116, 117, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 116, 117, 116, 117, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 116, 117, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	73, 73, 73, 73, 73, 38, 38, 38, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 190, 190, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 73, 73, 72, 72, 72, 72, 72, 72, 73, 73, 73,
+	190, 73, 73, 73, 73, 203, 171, 171, 171, 171, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 69, 69, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 69, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	54, 56, 54, 54, 54, 56, 56, 54, 56, 54, 56, 54, 56, 54, 54, 54,
+	54, 56, 54, 56, 56, 54, 56, 56, 56, 56, 56, 56, 59, 59, 50, 50,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 56, 73, 73, 73, 73, 73, 73, 54, 56, 54, 56, 74,
+	74, 74, 54, 56, 69, 69, 69, 69, 69, 83, 112, 112, 112, 102, 83, 112,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 69, 47, 69, 69, 69, 69, 69, 47, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69, 60,
+	112, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 74,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 58, 58, 69,
+	58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 58, 58, 69,
+	58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 58, 58, 69,
+	58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 58, 58, 69,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	207, 207, 40, 49, 40, 49, 207, 207, 207, 40, 49, 207, 40, 49, 112, 112,
+	112, 112, 112, 112, 112, 112, 82, 81, 208, 112, 209, 82, 40, 49, 82, 82,
+	40, 49, 116, 117, 116, 117, 116, 117, 116, 117, 112, 112, 112, 112, 91, 60,
+	112, 112, 82, 112, 112, 82, 82, 82, 82, 82, 210, 210, 100, 112, 112, 82,
+	81, 112, 116, 112, 112, 112, 112, 112, 112, 112, 112, 82, 112, 82, 112, 112,
+	73, 73, 82, 91, 91, 116, 117, 116, 117, 116, 117, 116, 117, 81, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 69, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 69, 69, 69, 69,
+	212, 213, 214, 215, 211, 216, 217, 218, 188, 189, 188, 189, 188, 189, 188, 189,
+	188, 189, 211, 211, 188, 189, 188, 189, 188, 189, 188, 189, 219, 188, 189, 189,
+	211, 218, 218, 218, 218, 218, 218, 218, 218, 218, 220, 220, 220, 220, 221, 221,
+	222, 223, 223, 223, 223, 224, 211, 211, 218, 218, 218, 216, 225, 226, 211, 227,
+	69, 228, 217, 228, 217, 228, 217, 228, 217, 228, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 228, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 228, 217, 228, 217, 228, 217, 217, 217, 217, 217, 217, 228, 217,
+	217, 217, 217, 217, 217, 228, 228, 69, 69, 220, 220, 229, 229, 230, 230, 217,
+	231, 232, 233, 232, 233, 232, 233, 232, 233, 232, 233, 233, 233, 233, 233, 233,
+	233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
+	233, 233, 233, 232, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
+	233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
+	233, 233, 233, 232, 233, 232, 233, 232, 233, 233, 233, 233, 233, 233, 232, 233,
+	233, 233, 233, 233, 233, 232, 232, 233, 233, 233, 233, 234, 235, 236, 236, 233,
+	69, 69, 69, 69, 69, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	69, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 69,
+	211, 211, 238, 238, 238, 238, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 69,
+	238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 45, 45, 45, 45, 45, 45, 45, 45,
+	211, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 239, 211, 239, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240,
+	240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240,
+	240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 211,
+	240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240,
+	240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240,
+	240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240,
+	240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240,
+	240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240,
+	240, 240, 240, 240, 240, 240, 240, 240, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 237, 216, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 69, 69, 69,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 60, 60, 60, 60, 60, 60, 112, 100,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 60, 112, 91, 100,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 58, 58, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 58, 74,
+	75, 75, 75, 82, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 82, 60,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 59, 59, 74, 74,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129,
+	74, 74, 82, 100, 112, 112, 112, 100, 69, 69, 69, 69, 69, 69, 69, 69,
+	65, 65, 65, 65, 65, 65, 65, 65, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 60, 60, 60, 60, 60, 60, 60, 60, 60,
+	61, 61, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	56, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	59, 56, 56, 56, 56, 56, 56, 56, 56, 54, 56, 54, 56, 54, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 60, 61, 61, 54, 56, 54, 56, 58,
+	54, 56, 54, 56, 56, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 54, 54, 54, 54, 56,
+	54, 54, 54, 54, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56, 54, 56,
+	54, 56, 54, 56, 54, 54, 54, 54, 56, 54, 56, 69, 69, 69, 69, 69,
+	54, 56, 69, 47, 69, 56, 54, 56, 54, 56, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 59, 59, 59, 54, 56, 58, 59, 59, 47, 58, 58, 58, 58, 58,
+	58, 58, 74, 58, 58, 58, 74, 58, 58, 58, 58, 74, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 97, 97, 74, 74, 97, 73, 73, 73, 73, 74, 69, 69, 69,
+	102, 102, 102, 102, 102, 102, 73, 73, 88, 73, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 104, 104, 91, 91, 69, 69, 69, 69, 69, 69, 69, 69,
+	97, 97, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
+	97, 97, 97, 97, 74, 74, 69, 69, 69, 69, 69, 69, 69, 69, 100, 100,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 69, 69, 69, 69, 69,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 58, 58, 58, 58, 58, 58, 82, 82, 82, 58, 104, 58, 58, 74,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 74, 74, 74, 74, 74, 74, 74, 74, 112, 100,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 97, 105, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 82,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 69, 69, 69,
+	74, 74, 74, 97, 58, 58, 58, 58, 58, 98, 98, 98, 58, 58, 58, 98,
+	98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 74, 97, 97, 74, 74, 74, 74, 97, 97, 74, 74, 97, 97,
+	137, 82, 82, 82, 82, 82, 82, 112, 100, 100, 82, 82, 82, 82, 69, 60,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 69, 69, 69, 82, 82,
+	119, 119, 119, 119, 119, 109, 111, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 119, 119, 119, 119, 119, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 74, 74, 74, 74, 74, 74, 97,
+	97, 74, 74, 97, 97, 74, 74, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 74, 58, 58, 58, 58, 58, 58, 58, 58, 74, 97, 69, 69,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 69, 82, 100, 100, 100,
+	119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	111, 119, 119, 119, 108, 108, 108, 123, 123, 123, 119, 120, 109, 120, 119, 119,
+	108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
+	108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
+	108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
+	109, 108, 109, 109, 109, 108, 108, 109, 109, 108, 108, 108, 108, 108, 109, 109,
+	108, 109, 108, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 108, 108, 111, 135, 135,
+	98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 97, 74, 74, 97, 97,
+	100, 100, 58, 60, 60, 97, 99, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 58, 58, 58, 58, 58, 58, 69, 69, 58, 58, 58, 58, 58, 58, 69,
+	69, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 58, 58, 69,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 61, 59, 59, 59, 59,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 59, 65, 65, 69, 69, 69, 69,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 58, 58, 58, 58, 58,
+	58, 58, 58, 97, 97, 74, 97, 97, 74, 97, 97, 100, 97, 74, 69, 69,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 69, 69, 69, 69, 69,
+	241, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 241, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 241, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 241, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	241, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 241, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 241, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 241, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	241, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 241, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 241, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 241, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	241, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 241, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 241, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 241, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	241, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 241, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 241, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 241, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	241, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 241, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 241, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 241, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	241, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 241, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 241, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 241, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	241, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 241, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 241, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 241, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 241, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
+	242, 242, 242, 242, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126,
+	126, 126, 126, 126, 126, 126, 126, 69, 69, 69, 69, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 69, 69, 69, 69,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 245, 245,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	47, 47, 47, 47, 47, 47, 47, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 47, 47, 47, 47, 47, 69, 69, 69, 69, 69, 84, 74, 84,
+	84, 84, 84, 84, 84, 84, 84, 84, 84, 72, 84, 84, 84, 84, 84, 84,
+	84, 84, 84, 84, 84, 84, 84, 69, 84, 84, 84, 84, 84, 69, 84, 69,
+	84, 84, 69, 84, 84, 69, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65,
+	65, 65, 65, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 117, 116,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	69, 69, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69, 73,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 88, 73, 73, 73,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	246, 213, 247, 248, 249, 250, 250, 188, 189, 251, 69, 69, 69, 69, 69, 69,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	215, 252, 252, 253, 253, 188, 189, 188, 189, 188, 189, 188, 189, 188, 189, 188,
+	189, 188, 189, 188, 189, 215, 215, 188, 189, 215, 215, 215, 215, 253, 253, 253,
+	254, 255, 256, 69, 257, 258, 259, 259, 252, 188, 189, 188, 189, 188, 189, 215,
+	215, 215, 260, 252, 260, 260, 260, 69, 215, 261, 262, 215, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 165,
+	69, 263, 264, 264, 265, 266, 264, 267, 268, 269, 264, 270, 271, 272, 273, 264,
+	274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 275, 276, 270, 270, 270, 263,
+	264, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277,
+	277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 268, 264, 269, 278, 279,
+	278, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280,
+	280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 268, 270, 269, 270, 268,
+	269, 281, 282, 283, 284, 285, 286, 287, 287, 287, 287, 287, 287, 287, 287, 287,
+	288, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286,
+	286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286,
+	286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 289, 289,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 69,
+	69, 69, 290, 290, 290, 290, 290, 290, 69, 69, 290, 290, 290, 290, 290, 290,
+	69, 69, 290, 290, 290, 290, 290, 290, 69, 69, 290, 290, 290, 69, 69, 69,
+	291, 265, 270, 278, 292, 265, 265, 69, 293, 294, 294, 294, 294, 293, 293, 69,
+	167, 167, 167, 167, 167, 167, 167, 167, 167, 90, 90, 90, 295, 171, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 69, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69,
+	112, 112, 112, 69, 69, 69, 69, 102, 102, 102, 102, 102, 102, 102, 102, 102,
+	102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102,
+	102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102,
+	102, 102, 102, 102, 69, 69, 69, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129,
+	129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129,
+	129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129,
+	129, 129, 129, 129, 129, 102, 102, 102, 102, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 102, 102, 73, 73, 73, 69,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 69, 69, 69,
+	73, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 74, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	74, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102,
+	102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	102, 102, 102, 102, 69, 69, 69, 69, 69, 69, 69, 69, 69, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 129, 58, 58, 58, 58, 58, 58, 58, 58, 129, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 74, 74, 74, 74, 74, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 112,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 69, 69, 69, 69, 58, 58, 58, 58, 58, 58, 58, 58,
+	112, 129, 129, 129, 129, 129, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 69, 69, 69, 69, 69,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 69, 69, 69, 69, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 82,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 69, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 69, 50, 50, 50, 50,
+	50, 50, 50, 69, 50, 50, 69, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 69, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 69, 47, 47, 47, 47, 47, 47, 47, 69, 47, 47, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	59, 60, 60, 59, 59, 59, 69, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 69, 59, 59, 59, 59, 59, 59, 59, 59, 59, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 69, 69, 58, 69, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 69, 58, 58, 69, 69, 69, 58, 69, 69, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 69, 112, 102, 102, 102, 102, 102, 102, 102, 102,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 73, 73, 102, 102, 102, 102, 102, 102, 102,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69,
+	69, 69, 69, 69, 69, 69, 69, 102, 102, 102, 102, 102, 102, 102, 102, 102,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 69, 58, 58, 69, 69, 69, 69, 69, 102, 102, 102, 102, 102,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 102, 102, 102, 102, 102, 102, 69, 69, 69, 112,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 82,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 102, 102, 58, 58,
+	102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102,
+	69, 69, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102,
+	102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102,
+	102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102,
+	98, 74, 74, 74, 69, 74, 74, 69, 69, 69, 69, 69, 74, 74, 74, 74,
+	98, 98, 98, 98, 69, 98, 98, 98, 69, 98, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 98, 98, 69, 69, 74, 74, 74, 69, 69, 69, 69, 99,
+	102, 102, 102, 102, 102, 102, 102, 102, 102, 69, 69, 69, 69, 69, 69, 69,
+	112, 112, 112, 112, 112, 112, 100, 100, 82, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 102, 102, 82,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 102, 102, 102,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 73, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 74, 74, 69, 69, 69, 69, 102, 102, 102, 102, 102,
+	112, 112, 112, 112, 112, 112, 296, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 69, 69, 69, 112, 112, 112, 112, 112, 112, 112,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 69, 69, 102, 102, 102, 102, 102, 102, 102, 102,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 69, 69, 69, 69, 69, 102, 102, 102, 102, 102, 102, 102, 102,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 69, 69, 69, 69, 69, 69, 69, 82, 82, 82, 82, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 102, 102, 102, 102, 102, 102, 102,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 69, 69, 69, 69, 69, 69, 69, 102, 102, 102, 102, 102, 102,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 74, 74, 74, 74, 69, 69, 69, 69, 69, 69, 69, 69,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 96, 96, 96, 96, 96, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102,
+	102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 74, 74, 81, 69, 69,
+	58, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 96, 96, 96, 74, 74, 74,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 102, 102, 102,
+	102, 102, 102, 102, 102, 102, 102, 58, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 102, 102, 102, 102, 95, 95, 95, 95, 95, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 74, 74, 74, 74, 95, 95, 95, 95, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 102, 102, 102, 102, 102, 102, 102, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	97, 74, 97, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 100, 100, 82, 82, 82, 82, 82, 69, 69,
+	69, 69, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102,
+	102, 102, 102, 102, 102, 102, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
+	74, 58, 58, 74, 74, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69, 74,
+	74, 74, 97, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	97, 97, 97, 74, 74, 74, 74, 97, 97, 74, 74, 82, 82, 86, 100, 100,
+	100, 100, 74, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 86, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 69, 69, 69, 69, 69,
+	74, 74, 74, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 98, 98, 98, 74, 74, 74, 74, 74, 97, 74, 74, 74,
+	74, 74, 74, 99, 74, 69, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
+	112, 100, 100, 100, 98, 97, 97, 98, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 74, 82, 104, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	74, 74, 97, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 97, 97, 97, 74, 74, 74, 74, 74, 74, 74, 74, 74, 97,
+	105, 58, 106, 106, 58, 100, 100, 82, 112, 74, 74, 74, 74, 95, 97, 74,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 58, 104, 58, 112, 100, 100,
+	69, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102,
+	102, 102, 102, 102, 102, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 69, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 97, 97, 97, 74,
+	74, 74, 97, 97, 74, 105, 74, 74, 100, 100, 82, 100, 100, 82, 74, 58,
+	58, 74, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 69, 58, 69, 58, 58, 58, 58, 69, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 100, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 74,
+	97, 97, 97, 74, 74, 74, 74, 74, 74, 74, 74, 69, 69, 69, 69, 69,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 69, 69, 69, 69, 69,
+	74, 74, 97, 97, 69, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 58,
+	58, 69, 69, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 58,
+	58, 69, 58, 58, 69, 58, 58, 58, 58, 58, 69, 74, 74, 58, 101, 97,
+	74, 97, 97, 97, 97, 69, 69, 97, 97, 69, 69, 97, 97, 105, 69, 69,
+	58, 69, 69, 69, 69, 69, 69, 101, 69, 69, 69, 69, 69, 58, 58, 58,
+	58, 58, 97, 97, 69, 69, 74, 74, 74, 74, 74, 74, 74, 69, 69, 69,
+	74, 74, 74, 74, 74, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 69, 297, 69, 69, 297, 69,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
+	297, 297, 297, 297, 297, 297, 69, 69, 96, 69, 69, 96, 96, 96, 96, 96,
+	96, 69, 96, 69, 69, 96, 69, 96, 96, 96, 69, 69, 69, 69, 96, 96,
+	298, 69, 96, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 96, 96, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 97, 97, 97, 74, 74, 74, 74, 74, 74, 74, 74,
+	97, 97, 74, 74, 74, 97, 74, 58, 58, 58, 58, 100, 100, 112, 112, 82,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 112, 112, 69, 82, 74, 58,
+	58, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	101, 97, 97, 74, 74, 74, 74, 74, 74, 97, 74, 97, 97, 101, 97, 74,
+	74, 97, 74, 74, 58, 58, 82, 58, 69, 69, 69, 69, 69, 69, 69, 69,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 101,
+	97, 97, 74, 74, 74, 74, 69, 69, 97, 97, 97, 97, 74, 74, 97, 74,
+	74, 104, 100, 100, 83, 83, 82, 82, 82, 100, 100, 100, 100, 100, 100, 100,
+	100, 100, 100, 100, 100, 100, 100, 100, 58, 58, 58, 58, 74, 74, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	97, 97, 97, 74, 74, 74, 74, 74, 74, 74, 74, 97, 97, 74, 97, 74,
+	74, 100, 100, 82, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 69, 69, 69, 69, 69,
+	104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 74, 97, 74, 97, 97,
+	74, 74, 74, 74, 74, 74, 105, 74, 58, 82, 69, 69, 69, 69, 69, 69,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
+	108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 69, 69, 109, 299, 109,
+	120, 120, 109, 109, 109, 109, 121, 109, 109, 109, 109, 109, 69, 69, 69, 69,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 134, 134, 100, 100, 100, 123,
+	108, 108, 108, 108, 108, 108, 108, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 97, 97, 97, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 97, 74, 74, 82, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 102, 102, 102, 102, 102, 102,
+	102, 102, 102, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 58,
+	98, 98, 98, 98, 98, 98, 98, 69, 69, 98, 69, 69, 98, 98, 98, 98,
+	98, 98, 98, 98, 69, 98, 98, 69, 98, 98, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98,
+	101, 97, 97, 97, 97, 97, 69, 97, 97, 69, 69, 74, 74, 105, 99, 106,
+	97, 106, 97, 74, 100, 112, 100, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 97, 97, 97, 74, 74, 74, 74, 69, 69, 74, 74, 97, 97, 97, 97,
+	74, 58, 104, 58, 97, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	98, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 74, 74, 74, 74, 74, 74, 97, 106, 74, 74, 74, 74, 104,
+	82, 112, 100, 100, 112, 104, 82, 99, 69, 69, 69, 69, 69, 69, 69, 69,
+	98, 74, 74, 74, 74, 74, 74, 97, 97, 74, 74, 74, 98, 98, 98, 98,
+	98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 106, 106, 106, 106, 106, 106, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 97, 74, 99, 112, 100, 100, 58, 104, 104,
+	104, 112, 112, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69,
+	104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	96, 69, 96, 96, 96, 69, 96, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 97,
+	74, 74, 74, 74, 74, 74, 74, 69, 74, 74, 74, 74, 74, 74, 97, 74,
+	58, 100, 100, 112, 112, 112, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 102, 102, 102, 102, 102, 102,
+	102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 69, 69, 69,
+	104, 83, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	69, 69, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 69, 97, 74, 74, 74, 74, 74, 74,
+	74, 97, 74, 74, 97, 74, 74, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 69, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 74, 74, 74, 74, 74, 74, 69, 69, 69, 74, 69, 74, 74, 69, 74,
+	74, 74, 74, 74, 74, 74, 106, 74, 69, 69, 69, 69, 69, 69, 69, 69,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 69, 58, 58, 69, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 97, 97, 97, 97, 97, 69,
+	74, 74, 69, 97, 97, 74, 97, 74, 58, 69, 69, 69, 69, 69, 69, 69,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 74, 74, 97, 97, 95, 95, 69, 69, 69, 69, 69, 69, 69,
+	74, 74, 106, 97, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98,
+	98, 69, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 97, 97, 74, 74, 74, 74, 74, 69, 69, 69, 97, 97,
+	74, 105, 99, 100, 100, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 96, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102,
+	102, 102, 102, 102, 102, 73, 73, 73, 73, 73, 73, 73, 73, 88, 88, 88,
+	88, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 112,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129,
+	129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129,
+	129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129,
+	129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129,
+	129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129,
+	129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129,
+	129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 69,
+	112, 112, 112, 112, 112, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 82, 82, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 301, 301, 301, 302, 302, 302, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 302, 58, 58, 58, 301, 302, 301, 302, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 301, 302, 302, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	133, 133, 133, 133, 133, 133, 133, 303, 304, 133, 133, 133, 303, 304, 303, 304,
+	74, 58, 58, 58, 58, 58, 58, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 301, 302,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 96, 96,
+	96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 69, 69, 69, 96, 96, 96,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 69, 69, 69, 100, 100,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69,
+	74, 74, 74, 74, 74, 100, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	74, 74, 74, 74, 74, 74, 74, 100, 100, 112, 82, 82, 73, 73, 73, 73,
+	60, 60, 60, 60, 100, 73, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 102, 102, 102, 102, 102,
+	102, 102, 69, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102,
+	102, 102, 102, 102, 102, 102, 102, 112, 100, 82, 82, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 74,
+	58, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 97, 97, 69, 69, 69, 69, 69, 69, 69, 74,
+	74, 74, 74, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	216, 216, 234, 216, 305, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	306, 306, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 69, 69, 69, 69, 69, 69, 69, 69,
+	307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307,
+	307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307,
+	307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307,
+	307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307,
+	307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307,
+	307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307,
+	307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307,
+	307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307,
+	307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307,
+	307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307,
+	307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307,
+	307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307,
+	307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307,
+	307, 307, 307, 307, 307, 307, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	308, 308, 308, 308, 69, 308, 308, 308, 308, 308, 308, 308, 69, 308, 308, 69,
+	233, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	233, 233, 233, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 228, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	228, 228, 228, 69, 69, 232, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 232, 232, 232, 232, 69, 69, 69, 69, 69, 69, 69, 69,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 73, 74, 74, 100,
+	90, 90, 90, 90, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 69, 69,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 69, 69, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 101, 105, 74, 74, 74, 73, 73, 73, 105, 101, 101,
+	101, 101, 101, 90, 90, 90, 90, 90, 90, 90, 90, 74, 74, 74, 74, 74,
+	74, 74, 74, 73, 73, 74, 74, 74, 74, 74, 74, 74, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 74, 74, 74, 74, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 74, 74, 74, 73, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102,
+	102, 102, 102, 102, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102,
+	102, 102, 102, 102, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102,
+	102, 102, 102, 102, 102, 102, 102, 102, 102, 69, 69, 69, 69, 69, 69, 69,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 56, 56,
+	56, 56, 56, 56, 56, 69, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 54, 69, 50, 50,
+	69, 69, 50, 69, 69, 50, 50, 69, 69, 50, 50, 50, 50, 69, 54, 54,
+	54, 54, 54, 54, 54, 54, 56, 56, 56, 56, 69, 47, 69, 47, 47, 47,
+	47, 47, 47, 47, 69, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 54, 54, 69, 50, 50, 50, 50, 69, 69, 50, 50, 50,
+	50, 50, 50, 50, 50, 69, 50, 50, 50, 50, 50, 50, 50, 69, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 54, 54, 69, 50, 50, 50, 50, 69,
+	50, 50, 50, 50, 50, 69, 50, 69, 69, 69, 50, 50, 50, 50, 50, 50,
+	50, 69, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 69, 69, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 72, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 72, 56, 56, 56, 56,
+	56, 56, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 72, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 72, 56, 56, 56, 56, 56, 56, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 72, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 72,
+	56, 56, 56, 56, 56, 56, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 72,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 72, 56, 56, 56, 56, 56, 56,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 72, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47,
+	47, 47, 47, 72, 56, 56, 56, 56, 56, 56, 54, 56, 69, 69, 92, 92,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 73, 73, 73, 73, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 73, 73, 73,
+	73, 73, 73, 73, 73, 74, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 74, 73, 73, 112, 100, 112, 112, 82, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 74, 74, 74, 74, 74,
+	69, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 58, 47, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 69,
+	69, 69, 69, 69, 69, 47, 47, 47, 47, 47, 47, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	74, 74, 74, 74, 74, 74, 74, 69, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 69, 69, 74, 74, 74, 74, 74,
+	74, 74, 69, 74, 74, 69, 74, 74, 74, 74, 74, 69, 69, 69, 69, 69,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 74,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69,
+	74, 74, 74, 74, 74, 74, 74, 60, 60, 60, 60, 60, 60, 60, 69, 69,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 69, 69, 69, 58, 73,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 74, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 74, 74, 74, 74,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 69, 69, 69, 69, 80,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 60, 74, 74, 74, 74,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 96, 96,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 96, 69, 69, 96, 69, 69, 69, 69, 69, 69, 69, 96, 96,
+	69, 69, 69, 69, 69, 96, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 69, 58, 58, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 69, 69, 102, 102, 102, 102, 102, 102, 102, 102, 102,
+	74, 74, 74, 74, 74, 74, 74, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 54, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 74, 74, 74, 74, 74, 74, 74, 60, 69, 69, 69, 69,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 69, 69, 69, 208, 208,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102,
+	102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102,
+	102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102,
+	102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 107, 102, 102, 102,
+	88, 102, 102, 102, 102, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102,
+	102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102,
+	102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 73, 102,
+	102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	58, 58, 58, 58, 69, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	69, 58, 58, 69, 58, 69, 69, 58, 69, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 69, 58, 58, 58, 58, 69, 58, 69, 58, 69, 69, 69, 69,
+	69, 69, 58, 69, 69, 69, 69, 58, 69, 58, 69, 58, 69, 58, 58, 58,
+	69, 58, 58, 69, 58, 69, 69, 58, 69, 58, 69, 58, 69, 58, 69, 58,
+	69, 58, 58, 69, 58, 69, 69, 58, 58, 58, 58, 69, 58, 58, 58, 58,
+	58, 58, 58, 69, 58, 58, 58, 58, 69, 58, 58, 58, 58, 69, 58, 69,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69,
+	69, 58, 58, 58, 69, 58, 58, 58, 58, 58, 69, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	72, 72, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	191, 191, 191, 191, 187, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 309, 309, 309, 309,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 309,
+	309, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	309, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 187,
+	309, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 178, 178, 191, 191, 191,
+	171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171,
+	171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 73, 38,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 171, 171, 171, 171, 171, 171,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 73, 73, 38, 191, 191, 191,
+	310, 310, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 310, 310,
+	311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 171, 171, 171, 171, 203, 171,
+	171, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 171, 171, 171, 171, 171,
+	171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 191, 309, 309,
+	309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	309, 309, 309, 309, 309, 309, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312,
+	312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312,
+	211, 187, 239, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 187, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 187,
+	211, 211, 187, 187, 187, 187, 187, 239, 187, 187, 187, 211, 309, 309, 309, 309,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 309, 309, 309, 309, 309, 309, 309,
+	187, 187, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	239, 239, 239, 239, 239, 239, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 191, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 191, 187, 187,
+	187, 187, 187, 187, 187, 202, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 191, 191, 191, 191, 191, 191, 191, 191, 38, 38, 191, 191,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 190, 190, 187, 187, 187, 187, 187, 190, 187, 187, 187,
+	187, 187, 202, 202, 202, 187, 187, 202, 187, 187, 202, 200, 200, 191, 191, 187,
+	187, 187, 187, 187, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 191, 191, 191, 187, 191, 191, 191, 187, 187, 187, 313, 313, 313, 313, 313,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 191,
+	187, 191, 202, 202, 187, 187, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 187, 187, 187, 202, 187, 187, 187,
+	187, 202, 202, 202, 187, 202, 202, 202, 187, 187, 187, 187, 187, 187, 187, 202,
+	187, 202, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	190, 187, 190, 187, 190, 187, 187, 187, 187, 187, 202, 187, 187, 187, 187, 190,
+	187, 190, 190, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 191, 191, 187,
+	190, 190, 190, 190, 190, 190, 190, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 190, 190, 190, 190, 190, 190, 190, 190, 190,
+	190, 190, 190, 190, 190, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 73, 73,
+	73, 73, 73, 73, 73, 73, 38, 38, 38, 38, 191, 187, 187, 187, 187, 191,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 200, 200, 191, 191, 191, 191, 202, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	200, 191, 191, 191, 191, 202, 202, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 187, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 38, 38, 38, 38, 38, 38, 38, 38, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 38, 38, 38, 38, 38, 38, 191, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 202, 202, 202, 187, 187, 187, 202, 202, 202, 202, 202,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 204, 204, 204, 314, 314, 314, 73, 73, 73, 73,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 202, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 202, 202, 202, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	202, 187, 187, 187, 187, 187, 191, 191, 191, 191, 191, 191, 202, 191, 191, 191,
+	187, 187, 187, 191, 191, 187, 187, 187, 309, 309, 309, 309, 187, 187, 187, 187,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 187, 187, 309, 309, 309,
+	191, 191, 191, 191, 187, 187, 187, 187, 187, 187, 187, 187, 187, 309, 309, 309,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 191, 191, 191, 309, 309, 309, 309, 191, 191, 191, 191, 191,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 191, 191, 191, 191, 191, 309, 309, 309, 309, 309, 309,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 309, 309, 309, 309,
+	187, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 309, 309, 309, 309,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 309, 309, 309, 309, 309, 309, 309, 309,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 309, 309, 309, 309, 309, 309,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 309, 309, 309, 309, 309, 309, 309, 309,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 309, 309,
+	191, 191, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 202, 187, 187, 202,
+	187, 187, 187, 187, 187, 187, 187, 187, 202, 202, 202, 202, 202, 202, 202, 202,
+	187, 187, 187, 187, 187, 187, 202, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 187, 227, 202, 202, 202, 187,
+	187, 187, 187, 187, 187, 187, 227, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 202, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 202, 202, 187, 202, 202, 187, 202, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 202, 202, 202,
+	187, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38,
+	38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38,
+	38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38,
+	38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38,
+	38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38,
+	38, 38, 38, 38, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 309, 309,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 309, 309, 309,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 309, 309, 309, 309, 309, 309, 309,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 309, 187,
+	187, 187, 187, 202, 202, 202, 309, 309, 309, 309, 309, 309, 309, 309, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 309, 309, 309, 309,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 309, 309, 309, 309, 309, 309, 309,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 309, 309, 309, 309, 309, 309, 309,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 69, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 69, 69, 69, 69, 69, 69,
+	309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
+	309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 69, 69,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 245, 245, 245, 245, 245, 245,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 245, 245,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 69, 69,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 245, 245, 245, 245, 245,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	167, 90, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
+	167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
+	315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315,
+	315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315,
+	315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315,
+	315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315,
+	315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315,
+	315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315,
+	167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
+	167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
+	167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
+	167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
+	167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
+	167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
+	167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
+	167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 244, 69, 69,
+}
+
+var propertyTrieValues = [316]codePointProperties{
+	{prControl, prXX, prXX, prCM, gcCc, prN, prXX, prXX},
+	{prControl, prXX, prSp, prBA, gcCc, prN, prXX, prXX},
+	{prLF, prLF, prLF, prLF, gcCc, prN, prXX, prXX},
+	{prControl, prNewline, prSp, prBK, gcCc, prN, prXX, prXX},
+	{prCR, prCR, prCR, prCR, gcCc, prN, prXX, prXX},
+	{prXX, prWSegSpace, prSp, prSP, gcZs, prNa, prXX, prXX},
+	{prXX, prXX, prSTerm, prEX, gcPo, prNa, prXX, prXX},
+	{prXX, prDoubleQuote, prClose, prQU, gcPo, prNa, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcPo, prNa, prXX, prXX},
+	{prXX, prXX, prXX, prPR, gcSc, prNa, prXX, prXX},
+	{prXX, prXX, prXX, prPO, gcPo, prNa, prXX, prXX},
+	{prXX, prSingleQuote, prClose, prQU, gcPo, prNa, prXX, prXX},
+	{prXX, prXX, prClose, prOP, gcPs, prNa, prXX, prXX},
+	{prXX, prXX, prClose, prCP, gcPe, prNa, prXX, prXX},
+	{prXX, prXX, prXX, prPR, gcSm, prNa, prXX, prXX},
+	{prXX, prMidNum, prSContinue, prIS, gcPo, prNa, prXX, prXX},
+	{prXX, prXX, prSContinue, prHY, gcPd, prNa, prXX, prXX},
+	{prXX, prMidNumLet, prATerm, prIS, gcPo, prNa, prXX, prXX},
+	{prXX, prXX, prXX, prSY, gcPo, prNa, prXX, prXX},
+	{prXX, prNumeric, prNumeric, prNU, gcNd, prNa, prXX, prXX},
+	{prXX, prMidLetter, prSContinue, prIS, gcPo, prNa, prXX, prXX},
+	{prXX, prMidNum, prXX, prIS, gcPo, prNa, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcSm, prNa, prXX, prXX},
+	{prXX, prALetter, prUpper, prAL, gcLu, prNa, prXX, prXX},
+	{prXX, prXX, prXX, prPR, gcPo, prNa, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcSk, prNa, prXX, prXX},
+	{prXX, prExtendNumLet, prXX, prAL, gcPc, prNa, prXX, prXX},
+	{prXX, prALetter, prLower, prAL, gcLl, prNa, prXX, prXX},
+	{prXX, prXX, prXX, prBA, gcSm, prNa, prXX, prXX},
+	{prXX, prXX, prClose, prCL, gcPe, prNa, prXX, prXX},
+	{prControl, prNewline, prSep, prNL, gcCc, prN, prXX, prXX},
+	{prXX, prXX, prSp, prGL, gcZs, prN, prXX, prXX},
+	{prXX, prXX, prXX, prOP, gcPo, prA, prXX, prXX},
+	{prXX, prXX, prXX, prPO, gcSc, prNa, prXX, prXX},
+	{prXX, prXX, prXX, prPR, gcSc, prA, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcSo, prNa, prXX, prXX},
+	{prXX, prXX, prXX, prAI, gcPo, prA, prXX, prXX},
+	{prXX, prXX, prXX, prAI, gcSk, prA, prXX, prXX},
+	{prExtendedPictographic, prExtendedPictographic, prXX, prAL, gcSo, prN, prXX, prXX},
+	{prXX, prALetter, prLower, prAI, gcLo, prA, prXX, prXX},
+	{prXX, prXX, prClose, prQU, gcPi, prN, prXX, prXX},
+	{prControl, prFormat, prFormat, prBA, gcCf, prA, prXX, prXX},
+	{prExtendedPictographic, prExtendedPictographic, prXX, prAL, gcSo, prA, prXX, prXX},
+	{prXX, prXX, prXX, prPO, gcSo, prA, prXX, prXX},
+	{prXX, prXX, prXX, prPR, gcSm, prA, prXX, prXX},
+	{prXX, prXX, prXX, prAI, gcNo, prA, prXX, prXX},
+	{prXX, prXX, prXX, prBB, gcSk, prA, prXX, prXX},
+	{prXX, prALetter, prLower, prAL, gcLl, prN, prXX, prXX},
+	{prXX, prMidLetter, prXX, prAI, gcPo, prA, prXX, prXX},
+	{prXX, prXX, prClose, prQU, gcPf, prN, prXX, prXX},
+	{prXX, prALetter, prUpper, prAL, gcLu, prN, prXX, prXX},
+	{prXX, prALetter, prUpper, prAL, gcLu, prA, prXX, prXX},
+	{prXX, prXX, prXX, prAI, gcSm, prA, prXX, prXX},
+	{prXX, prALetter, prUpper, prAL, gcLC, prA, prXX, prXX},
+	{prXX, prALetter, prUpper, prAL, gcLC, prN, prXX, prXX},
+	{prXX, prALetter, prLower, prAL, gcLC, prA, prXX, prXX},
+	{prXX, prALetter, prLower, prAL, gcLC, prN, prXX, prXX},
+	{prXX, prALetter, prLower, prAL, gcLl, prA, prXX, prXX},
+	{prXX, prALetter, prOLetter, prAL, gcLo, prN, prXX, prXX},
+	{prXX, prALetter, prLower, prAL, gcLm, prN, prXX, prXX},
+	{prXX, prALetter, prOLetter, prAL, gcLm, prN, prXX, prXX},
+	{prXX, prALetter, prXX, prAL, gcSk, prN, prXX, prXX},
+	{prXX, prALetter, prXX, prAL, gcSk, prA, prXX, prXX},
+	{prXX, prALetter, prOLetter, prAI, gcLm, prA, prXX, prXX},
+	{prXX, prALetter, prOLetter, prBB, gcLm, prN, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcSk, prN, prXX, prXX},
+	{prXX, prALetter, prXX, prBB, gcSk, prA, prXX, prXX},
+	{prExtend, prExtend, prExtend, prCM, gcMn, prA, prXX, prInCBExtend},
+	{prExtend, prExtend, prExtend, prGL, gcMn, prA, prXX, prInCBExtend},
+	{prXX, prXX, prXX, prXX, gcNone, prXX, prXX, prXX},
+	{prXX, prMidNum, prXX, prIS, gcPo, prN, prXX, prXX},
+	{prXX, prMidLetter, prXX, prAL, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcSm, prN, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcSo, prN, prXX, prXX},
+	{prExtend, prExtend, prExtend, prCM, gcMn, prN, prXX, prInCBExtend},
+	{prExtend, prExtend, prExtend, prCM, gcMe, prN, prXX, prInCBExtend},
+	{prXX, prALetter, prXX, prAL, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prSContinue, prAL, gcPo, prN, prXX, prXX},
+	{prXX, prMidNum, prSTerm, prIS, gcPo, prN, prXX, prXX},
+	{prXX, prALetter, prXX, prBA, gcPd, prN, prXX, prXX},
+	{prXX, prXX, prXX, prPR, gcSc, prN, prXX, prXX},
+	{prXX, prXX, prXX, prBA, gcPd, prN, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prEX, gcPo, prN, prXX, prXX},
+	{prXX, prHebrewLetter, prOLetter, prHL, gcLo, prN, prXX, prXX},
+	{prXX, prALetter, prOLetter, prAL, gcPo, prN, prXX, prXX},
+	{prPrepend, prFormat, prFormat, prAL, gcCf, prN, prXX, prXX},
+	{prXX, prXX, prXX, prPO, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prPO, gcSc, prN, prXX, prXX},
+	{prXX, prMidNum, prSContinue, prIS, gcPo, prN, prXX, prXX},
+	{prControl, prFormat, prFormat, prCM, gcCf, prN, prXX, prXX},
+	{prXX, prXX, prSTerm, prEX, gcPo, prN, prXX, prXX},
+	{prXX, prNumeric, prNumeric, prNU, gcNd, prN, prXX, prXX},
+	{prXX, prNumeric, prNumeric, prNU, gcPo, prN, prXX, prXX},
+	{prXX, prMidNum, prNumeric, prNU, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prSTerm, prAL, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prXX, gcNone, prXX, prXX, prInCBExtend},
+	{prSpacingMark, prExtend, prExtend, prCM, gcMc, prN, prXX, prXX},
+	{prXX, prALetter, prOLetter, prAL, gcLo, prN, prXX, prInCBConsonant},
+	{prExtend, prExtend, prExtend, prCM, gcMn, prN, prXX, prInCBLinker},
+	{prXX, prXX, prSTerm, prBA, gcPo, prN, prXX, prXX},
+	{prExtend, prExtend, prExtend, prCM, gcMc, prN, prXX, prInCBExtend},
+	{prXX, prXX, prXX, prAL, gcNo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prPO, gcNo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prBB, gcPo, prN, prXX, prXX},
+	{prSpacingMark, prExtend, prExtend, prCM, gcMc, prN, prXX, prInCBExtend},
+	{prPrepend, prALetter, prOLetter, prAL, gcLo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prPO, gcSo, prN, prXX, prXX},
+	{prXX, prXX, prOLetter, prSA, gcLo, prN, prXX, prXX},
+	{prExtend, prExtend, prExtend, prSA, gcMn, prN, prXX, prInCBExtend},
+	{prSpacingMark, prXX, prOLetter, prSA, gcLo, prN, prXX, prXX},
+	{prXX, prXX, prOLetter, prSA, gcLm, prN, prXX, prXX},
+	{prXX, prXX, prXX, prBA, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prBB, gcSo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prGL, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prBA, gcSo, prN, prXX, prXX},
+	{prXX, prXX, prClose, prOP, gcPs, prN, prXX, prXX},
+	{prXX, prXX, prClose, prCL, gcPe, prN, prXX, prXX},
+	{prSpacingMark, prExtend, prExtend, prBA, gcMc, prN, prXX, prXX},
+	{prXX, prXX, prOLetter, prSA, gcLo, prN, prXX, prInCBConsonant},
+	{prXX, prExtend, prExtend, prSA, gcMc, prN, prXX, prXX},
+	{prSpacingMark, prExtend, prExtend, prSA, gcMc, prN, prXX, prXX},
+	{prExtend, prExtend, prExtend, prSA, gcMn, prN, prXX, prInCBLinker},
+	{prXX, prXX, prXX, prSA, gcSo, prN, prXX, prXX},
+	{prXX, prALetter, prOLetter, prAL, gcLl, prN, prXX, prXX},
+	{prL, prALetter, prOLetter, prJL, gcLo, prW, prXX, prXX},
+	{prV, prALetter, prOLetter, prJV, gcLo, prN, prXX, prXX},
+	{prT, prALetter, prOLetter, prJT, gcLo, prN, prXX, prXX},
+	{prXX, prWSegSpace, prSp, prBA, gcZs, prN, prXX, prXX},
+	{prXX, prALetter, prOLetter, prAL, gcNl, prN, prXX, prXX},
+	{prXX, prXX, prXX, prNS, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prSContinue, prEX, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prBB, gcPd, prN, prXX, prXX},
+	{prControl, prFormat, prFormat, prGL, gcCf, prN, prXX, prXX},
+	{prXX, prXX, prXX, prSA, gcNo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prSA, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prSTerm, prSA, gcPo, prN, prXX, prXX},
+	{prSpacingMark, prExtend, prExtend, prCM, gcMc, prN, prXX, prInCBLinker},
+	{prXX, prALetter, prOLetter, prAL, gcLu, prN, prXX, prXX},
+	{prExtend, prExtend, prExtend, prGL, gcMn, prN, prXX, prInCBExtend},
+	{prXX, prXX, prXX, prBB, gcSk, prN, prXX, prXX},
+	{prControl, prXX, prFormat, prZW, gcCf, prN, prXX, prXX},
+	{prExtend, prExtend, prExtend, prCM, gcCf, prN, prXX, prXX},
+	{prZWJ, prZWJ, prExtend, prZWJ, gcCf, prN, prXX, prInCBExtend},
+	{prXX, prXX, prXX, prBA, gcPd, prA, prXX, prXX},
+	{prXX, prXX, prXX, prGL, gcPd, prN, prXX, prXX},
+	{prXX, prXX, prSContinue, prBA, gcPd, prA, prXX, prXX},
+	{prXX, prXX, prSContinue, prB2, gcPd, prA, prXX, prXX},
+	{prXX, prXX, prXX, prAI, gcPd, prA, prXX, prXX},
+	{prXX, prMidNumLet, prClose, prQU, gcPi, prA, prXX, prXX},
+	{prXX, prMidNumLet, prClose, prQU, gcPf, prA, prXX, prXX},
+	{prXX, prXX, prClose, prQU, gcPi, prA, prXX, prXX},
+	{prXX, prXX, prClose, prQU, gcPf, prA, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcPo, prA, prXX, prXX},
+	{prXX, prMidNumLet, prATerm, prIN, gcPo, prA, prXX, prXX},
+	{prXX, prXX, prXX, prIN, gcPo, prA, prXX, prXX},
+	{prXX, prMidLetter, prXX, prBA, gcPo, prA, prXX, prXX},
+	{prControl, prNewline, prSep, prBK, gcZl, prN, prXX, prXX},
+	{prControl, prNewline, prSep, prBK, gcZp, prN, prXX, prXX},
+	{prXX, prExtendNumLet, prSp, prGL, gcZs, prN, prXX, prXX},
+	{prXX, prXX, prXX, prPO, gcPo, prA, prXX, prXX},
+	{prExtendedPictographic, prExtendedPictographic, prSTerm, prNS, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prSTerm, prNS, gcPo, prN, prXX, prXX},
+	{prXX, prExtendNumLet, prXX, prAL, gcPc, prN, prXX, prXX},
+	{prXX, prMidNum, prXX, prIS, gcSm, prN, prXX, prXX},
+	{prControl, prFormat, prFormat, prWJ, gcCf, prN, prXX, prXX},
+	{prControl, prFormat, prFormat, prAL, gcCf, prN, prXX, prXX},
+	{prControl, prXX, prXX, prXX, gcNone, prXX, prXX, prXX},
+	{prXX, prALetter, prLower, prAI, gcLm, prA, prXX, prXX},
+	{prXX, prXX, prXX, prPR, gcSc, prH, prXX, prXX},
+	{prXX, prXX, prXX, prPR, gcCn, prXX, prXX, prXX},
+	{prXX, prXX, prXX, prAI, gcSo, prA, prXX, prXX},
+	{prXX, prALetter, prLower, prAI, gcLl, prA, prXX, prXX},
+	{prXX, prXX, prXX, prPR, gcSo, prA, prXX, prXX},
+	{prExtendedPictographic, prExtendedPictographic, prXX, prAI, gcSo, prA, prXX, prXX},
+	{prXX, prALetter, prUpper, prAI, gcLu, prA, prXX, prXX},
+	{prExtendedPictographic, prALetter, prLower, prAL, gcLl, prN, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcNo, prA, prXX, prXX},
+	{prXX, prXX, prXX, prAI, gcNo, prN, prXX, prXX},
+	{prXX, prALetter, prUpper, prAI, gcNl, prA, prXX, prXX},
+	{prXX, prALetter, prUpper, prAL, gcNl, prN, prXX, prXX},
+	{prXX, prALetter, prLower, prAI, gcNl, prA, prXX, prXX},
+	{prXX, prALetter, prLower, prAL, gcNl, prN, prXX, prXX},
+	{prExtendedPictographic, prExtendedPictographic, prXX, prAI, gcSm, prA, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcSo, prA, prXX, prXX},
+	{prXX, prXX, prXX, prPR, gcSm, prN, prXX, prXX},
+	{prXX, prXX, prXX, prIN, gcSm, prN, prXX, prXX},
+	{prExtendedPictographic, prExtendedPictographic, prXX, prID, gcSo, prW, prEmojiPresentation, prXX},
+	{prXX, prXX, prClose, prOP, gcPs, prW, prXX, prXX},
+	{prXX, prXX, prClose, prCL, gcPe, prW, prXX, prXX},
+	{prExtendedPictographic, prExtendedPictographic, prXX, prAL, gcSo, prW, prEmojiPresentation, prXX},
+	{prExtendedPictographic, prExtendedPictographic, prXX, prID, gcSo, prN, prXX, prXX},
+	{prXX, prALetter, prUpper, prAI, gcSo, prA, prXX, prXX},
+	{prExtendedPictographic, prALetter, prUpper, prAI, gcSo, prA, prXX, prXX},
+	{prXX, prALetter, prLower, prAI, gcSo, prA, prXX, prXX},
+	{prXX, prXX, prXX, prAI, gcSo, prN, prXX, prXX},
+	{prExtendedPictographic, prExtendedPictographic, prXX, prAL, gcSm, prN, prXX, prXX},
+	{prExtendedPictographic, prExtendedPictographic, prXX, prAL, gcSm, prW, prEmojiPresentation, prXX},
+	{prExtendedPictographic, prExtendedPictographic, prXX, prAI, gcSo, prN, prXX, prXX},
+	{prExtendedPictographic, prExtendedPictographic, prXX, prID, gcSo, prA, prXX, prXX},
+	{prExtendedPictographic, prExtendedPictographic, prXX, prEB, gcSo, prN, prXX, prXX},
+	{prExtendedPictographic, prExtendedPictographic, prXX, prEB, gcSo, prA, prXX, prXX},
+	{prExtendedPictographic, prExtendedPictographic, prXX, prEB, gcSo, prW, prEmojiPresentation, prXX},
+	{prExtendedPictographic, prExtendedPictographic, prXX, prAI, gcSo, prW, prEmojiPresentation, prXX},
+	{prXX, prXX, prClose, prQU, gcSo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prEX, gcSo, prN, prXX, prXX},
+	{prExtendedPictographic, prExtendedPictographic, prXX, prEX, gcSo, prN, prXX, prXX},
+	{prXX, prXX, prClose, prQU, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prOP, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcPd, prN, prXX, prXX},
+	{prXX, prXX, prXX, prB2, gcPd, prN, prXX, prXX},
+	{prXX, prXX, prXX, prID, gcSo, prW, prXX, prXX},
+	{prXX, prWSegSpace, prSp, prBA, gcZs, prF, prXX, prXX},
+	{prXX, prXX, prSContinue, prCL, gcPo, prW, prXX, prXX},
+	{prXX, prXX, prSTerm, prCL, gcPo, prW, prXX, prXX},
+	{prXX, prXX, prXX, prID, gcPo, prW, prXX, prXX},
+	{prXX, prALetter, prOLetter, prNS, gcLm, prW, prXX, prXX},
+	{prXX, prXX, prOLetter, prID, gcLo, prW, prXX, prXX},
+	{prXX, prXX, prOLetter, prID, gcNl, prW, prXX, prXX},
+	{prXX, prXX, prXX, prNS, gcPd, prW, prXX, prXX},
+	{prExtend, prExtend, prExtend, prCM, gcMn, prW, prXX, prInCBExtend},
+	{prExtend, prExtend, prExtend, prCM, gcMc, prW, prXX, prInCBExtend},
+	{prExtendedPictographic, prExtendedPictographic, prXX, prID, gcPd, prW, prXX, prXX},
+	{prXX, prKatakana, prOLetter, prID, gcLm, prW, prXX, prXX},
+	{prXX, prKatakana, prOLetter, prCM, gcLm, prW, prXX, prXX},
+	{prXX, prALetter, prOLetter, prNS, gcLo, prW, prXX, prXX},
+	{prExtendedPictographic, prExtendedPictographic, prXX, prID, gcPo, prW, prXX, prXX},
+	{prXX, prXX, prXX, prID, gcSo, prN, prXX, prXX},
+	{prXX, prXX, prOLetter, prCJ, gcLo, prW, prXX, prXX},
+	{prXX, prKatakana, prXX, prNS, gcSk, prW, prXX, prXX},
+	{prXX, prXX, prOLetter, prNS, gcLm, prW, prXX, prXX},
+	{prXX, prKatakana, prXX, prNS, gcPd, prW, prXX, prXX},
+	{prXX, prKatakana, prOLetter, prCJ, gcLo, prW, prXX, prXX},
+	{prXX, prKatakana, prOLetter, prID, gcLo, prW, prXX, prXX},
+	{prXX, prXX, prXX, prNS, gcPo, prW, prXX, prXX},
+	{prXX, prKatakana, prOLetter, prCJ, gcLm, prW, prXX, prXX},
+	{prXX, prKatakana, prOLetter, prNS, gcLm, prW, prXX, prXX},
+	{prXX, prALetter, prOLetter, prID, gcLo, prW, prXX, prXX},
+	{prXX, prXX, prXX, prID, gcNo, prW, prXX, prXX},
+	{prExtendedPictographic, prExtendedPictographic, prXX, prID, gcSo, prW, prXX, prXX},
+	{prXX, prKatakana, prXX, prID, gcSo, prW, prXX, prXX},
+	{prLV, prALetter, prOLetter, prH2, gcLo, prW, prXX, prXX},
+	{prLVT, prALetter, prOLetter, prH3, gcLo, prW, prXX, prXX},
+	{prXX, prXX, prXX, prSG, gcCs, prN, prXX, prXX},
+	{prXX, prXX, prXX, prXX, gcCo, prA, prXX, prXX},
+	{prXX, prXX, prXX, prID, gcCn, prW, prXX, prXX},
+	{prXX, prMidNum, prSContinue, prIS, gcPo, prW, prXX, prXX},
+	{prXX, prXX, prXX, prCL, gcPo, prW, prXX, prXX},
+	{prXX, prMidLetter, prSContinue, prIS, gcPo, prW, prXX, prXX},
+	{prXX, prMidNum, prXX, prIS, gcPo, prW, prXX, prXX},
+	{prXX, prXX, prXX, prEX, gcPo, prW, prXX, prXX},
+	{prXX, prXX, prXX, prIN, gcPo, prW, prXX, prXX},
+	{prXX, prXX, prSContinue, prID, gcPd, prW, prXX, prXX},
+	{prXX, prExtendNumLet, prXX, prID, gcPc, prW, prXX, prXX},
+	{prXX, prMidNum, prSContinue, prCL, gcPo, prW, prXX, prXX},
+	{prXX, prXX, prSContinue, prID, gcPo, prW, prXX, prXX},
+	{prXX, prMidNumLet, prATerm, prCL, gcPo, prW, prXX, prXX},
+	{prXX, prMidNum, prXX, prNS, gcPo, prW, prXX, prXX},
+	{prXX, prMidLetter, prSContinue, prNS, gcPo, prW, prXX, prXX},
+	{prXX, prXX, prSTerm, prEX, gcPo, prW, prXX, prXX},
+	{prXX, prXX, prXX, prID, gcSm, prW, prXX, prXX},
+	{prXX, prXX, prXX, prPR, gcSc, prW, prXX, prXX},
+	{prXX, prXX, prXX, prPO, gcPo, prW, prXX, prXX},
+	{prXX, prXX, prSTerm, prEX, gcPo, prF, prXX, prXX},
+	{prXX, prXX, prXX, prID, gcPo, prF, prXX, prXX},
+	{prXX, prXX, prXX, prPR, gcSc, prF, prXX, prXX},
+	{prXX, prXX, prXX, prPO, gcPo, prF, prXX, prXX},
+	{prXX, prMidNumLet, prXX, prID, gcPo, prF, prXX, prXX},
+	{prXX, prXX, prClose, prOP, gcPs, prF, prXX, prXX},
+	{prXX, prXX, prClose, prCL, gcPe, prF, prXX, prXX},
+	{prXX, prXX, prXX, prID, gcSm, prF, prXX, prXX},
+	{prXX, prMidNum, prSContinue, prCL, gcPo, prF, prXX, prXX},
+	{prXX, prXX, prSContinue, prID, gcPd, prF, prXX, prXX},
+	{prXX, prMidNumLet, prATerm, prCL, gcPo, prF, prXX, prXX},
+	{prXX, prNumeric, prNumeric, prID, gcNd, prF, prXX, prXX},
+	{prXX, prMidLetter, prSContinue, prNS, gcPo, prF, prXX, prXX},
+	{prXX, prMidNum, prXX, prNS, gcPo, prF, prXX, prXX},
+	{prXX, prALetter, prUpper, prID, gcLu, prF, prXX, prXX},
+	{prXX, prXX, prXX, prID, gcSk, prF, prXX, prXX},
+	{prXX, prExtendNumLet, prXX, prID, gcPc, prF, prXX, prXX},
+	{prXX, prALetter, prLower, prID, gcLl, prF, prXX, prXX},
+	{prXX, prXX, prSTerm, prCL, gcPo, prH, prXX, prXX},
+	{prXX, prXX, prClose, prOP, gcPs, prH, prXX, prXX},
+	{prXX, prXX, prClose, prCL, gcPe, prH, prXX, prXX},
+	{prXX, prXX, prSContinue, prCL, gcPo, prH, prXX, prXX},
+	{prXX, prXX, prXX, prNS, gcPo, prH, prXX, prXX},
+	{prXX, prKatakana, prOLetter, prID, gcLo, prH, prXX, prXX},
+	{prXX, prKatakana, prOLetter, prCJ, gcLo, prH, prXX, prXX},
+	{prXX, prKatakana, prOLetter, prCJ, gcLm, prH, prXX, prXX},
+	{prExtend, prExtend, prExtend, prNS, gcLm, prH, prXX, prInCBExtend},
+	{prXX, prALetter, prOLetter, prID, gcLo, prH, prXX, prXX},
+	{prXX, prXX, prXX, prPO, gcSc, prF, prXX, prXX},
+	{prXX, prXX, prXX, prID, gcSo, prF, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcSo, prH, prXX, prXX},
+	{prXX, prXX, prXX, prAL, gcSm, prH, prXX, prXX},
+	{prXX, prXX, prXX, prCB, gcSo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prIN, gcPo, prN, prXX, prXX},
+	{prXX, prXX, prXX, prXX, gcNone, prXX, prXX, prInCBConsonant},
+	{prXX, prXX, prXX, prXX, gcNone, prXX, prXX, prInCBLinker},
+	{prExtend, prExtend, prExtend, prSA, gcMn, prN, prXX, prXX},
+	{prXX, prXX, prXX, prID, gcPo, prN, prXX, prXX},
+	{prXX, prALetter, prOLetter, prOP, gcLo, prN, prXX, prXX},
+	{prXX, prALetter, prOLetter, prCL, gcLo, prN, prXX, prXX},
+	{prControl, prFormat, prFormat, prOP, gcCf, prN, prXX, prXX},
+	{prControl, prFormat, prFormat, prCL, gcCf, prN, prXX, prXX},
+	{prExtend, prExtend, prExtend, prGL, gcMn, prW, prXX, prInCBExtend},
+	{prSpacingMark, prExtend, prExtend, prCM, gcMc, prW, prXX, prInCBExtend},
+	{prXX, prXX, prOLetter, prAL, gcLo, prW, prXX, prXX},
+	{prXX, prKatakana, prOLetter, prAL, gcLm, prW, prXX, prXX},
+	{prExtendedPictographic, prExtendedPictographic, prXX, prID, gcCn, prXX, prXX, prXX},
+	{prExtendedPictographic, prExtendedPictographic, prUpper, prAI, gcSo, prA, prXX, prXX},
+	{prXX, prXX, prUpper, prAI, gcSo, prA, prXX, prXX},
+	{prRegionalIndicator, prRegionalIndicator, prXX, prRI, gcSo, prN, prEmojiPresentation, prXX},
+	{prExtend, prExtend, prXX, prEM, gcSk, prW, prEmojiPresentation, prInCBExtend},
+	{prXX, prXX, prXX, prNS, gcSo, prN, prXX, prXX},
+	{prExtend, prExtend, prExtend, prCM, gcCf, prN, prXX, prInCBExtend},
+}
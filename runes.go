@@ -0,0 +1,36 @@
+package uniseg
+
+// This file contains variants of the segmentation functions which operate on
+// rune slices, e.g. text which was normalized or which an editor stores as
+// runes. The text does not need to be encoded as UTF-8 first. All returned
+// slices are sub-slices of the input, so offsets derived from them (e.g.
+// len(cluster)) are counted in runes.
+
+// FirstGraphemeClusterRunes is like [FirstGraphemeCluster] but its input and
+// outputs are rune slices.
+func FirstGraphemeClusterRunes(runes []rune, state int) (cluster, rest []rune, width, newState int) {
+	return firstGraphemeCluster(runes, state, nil)
+}
+
+// StepRunes is like [Step] but its input and outputs are rune slices.
+func StepRunes(runes []rune, state int) (cluster, rest []rune, boundaries int, newState int) {
+	return step(runes, state, nil)
+}
+
+// FirstWordRunes is like [FirstWord] but its input and outputs are rune
+// slices.
+func FirstWordRunes(runes []rune, state int) (word, rest []rune, newState int) {
+	return firstWord(runes, state)
+}
+
+// FirstSentenceRunes is like [FirstSentence] but its input and outputs are
+// rune slices.
+func FirstSentenceRunes(runes []rune, state int) (sentence, rest []rune, newState int) {
+	return firstSentence(runes, state)
+}
+
+// FirstLineSegmentRunes is like [FirstLineSegment] but its input and outputs
+// are rune slices.
+func FirstLineSegmentRunes(runes []rune, state int) (segment, rest []rune, mustBreak bool, newState int) {
+	return firstLineSegment(runes, state)
+}
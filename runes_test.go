@@ -0,0 +1,30 @@
+package uniseg
+
+import "testing"
+
+// Test the rune slice functions against their UTF-8 counterparts, using the
+// official Unicode test cases.
+func TestRunesConformance(t *testing.T) {
+	testEncodingConformance(t, encodingFuncs[[]rune]{
+		name:                 "runes",
+		encode:               func(s string) []rune { return []rune(s) },
+		decode:               func(r []rune) string { return string(r) },
+		step:                 StepRunes,
+		firstGraphemeCluster: FirstGraphemeClusterRunes,
+		firstWord:            FirstWordRunes,
+		firstSentence:        FirstSentenceRunes,
+		firstLineSegment:     FirstLineSegmentRunes,
+	})
+}
+
+// Test that the rune slice functions return offsets in runes.
+func TestRunesOffsets(t *testing.T) {
+	testEncodingOffsets(t, FirstGraphemeClusterRunes, []encodingOffsetTestCase[[]rune]{
+		{nil, nil, 0},
+		{[]rune("abc"), []int{1, 1, 1}, 3},
+		{[]rune("🏳️‍🌈!"), []int{4, 1}, 3},
+		{[]rune("🇩🇪🇫🇷"), []int{2, 2}, 4},
+		{[]rune("e\u0301\r\n"), []int{2, 2}, 1},
+		{[]rune("1️⃣"), []int{3}, 2},
+	})
+}
@@ -0,0 +1,100 @@
+package uniseg
+
+import "unicode/utf8"
+
+// ScanGraphemes is a split function for a [bufio.Scanner] that returns each
+// grapheme cluster (as determined by [FirstGraphemeCluster]) as a token.
+//
+// Because the scanner cannot pass state between calls, each token is
+// determined as if it was the beginning of the text, which yields the same
+// results as [FirstGraphemeCluster] would.
+func ScanGraphemes(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	return scan(data, atEOF, false, func(b []byte) (token, rest []byte) {
+		token, rest, _, _ = FirstGraphemeCluster(b, -1)
+		return
+	})
+}
+
+// ScanWords is a split function for a [bufio.Scanner] that returns each word
+// (as determined by [FirstWord]) as a token. Note that, unlike
+// [bufio.ScanWords], this includes all segments between word boundaries, e.g.
+// punctuation and whitespace.
+//
+// Some word boundaries can only be decided by looking at code points following
+// the boundary (e.g. rules WB6 and WB7 of UAX #29). Unless the end of the input
+// has been reached, a token is therefore only returned once at least one full
+// subsequent word has been read.
+func ScanWords(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	return scan(data, atEOF, true, func(b []byte) (token, rest []byte) {
+		token, rest, _ = FirstWord(b, -1)
+		return
+	})
+}
+
+// ScanSentences is a split function for a [bufio.Scanner] that returns each
+// sentence (as determined by [FirstSentence]) as a token.
+//
+// Some sentence boundaries can only be decided by looking at code points
+// following the boundary (e.g. rule SB8 of UAX #29). Unless the end of the
+// input has been reached, a token is therefore only returned once at least one
+// full subsequent sentence has been read.
+func ScanSentences(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	return scan(data, atEOF, true, func(b []byte) (token, rest []byte) {
+		token, rest, _ = FirstSentence(b, -1)
+		return
+	})
+}
+
+// ScanLineSegments is a split function for a [bufio.Scanner] that returns each
+// line segment (as determined by [FirstLineSegment]) as a token. Use
+// [HasTrailingLineBreak] to find out if a line break is mandatory after a
+// token.
+//
+// Some line breaks can only be decided by looking at code points following the
+// break opportunity (e.g. rule LB25 of UAX #14). Unless the end of the input
+// has been reached, a token is therefore only returned once at least one full
+// subsequent segment has been read.
+func ScanLineSegments(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	return scan(data, atEOF, true, func(b []byte) (token, rest []byte) {
+		token, rest, _, _ = FirstLineSegment(b, -1)
+		return
+	})
+}
+
+// scan implements the split functions above. The "first" function extracts
+// the first token from a byte slice. If "lookahead" is true, the boundary
+// after the first token may depend on code points after the next token so that
+// the next token must be complete, too, before the first token is returned.
+func scan(data []byte, atEOF, lookahead bool, first func(b []byte) (token, rest []byte)) (advance int, token []byte, err error) {
+	if atEOF {
+		if len(data) == 0 {
+			return 0, nil, nil
+		}
+		token, _ = first(data)
+		return len(token), token, nil
+	}
+
+	// Ignore an incomplete UTF-8 sequence at the end, we will need more data to
+	// decode it.
+	for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			if !utf8.FullRune(data[i:]) {
+				data = data[:i]
+			}
+			break
+		}
+	}
+
+	// Find the first token.
+	token, rest := first(data)
+	if len(rest) == 0 {
+		return 0, nil, nil // The token may continue in the next data.
+	}
+	if lookahead {
+		if _, rest = first(rest); len(rest) == 0 {
+			return 0, nil, nil // The boundary may still change.
+		}
+	}
+
+	return len(token), token, nil
+}
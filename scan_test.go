@@ -0,0 +1,75 @@
+package uniseg
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+// scanTestCases runs all given test cases through a bufio.Scanner with the
+// given split function. The input is read one byte at a time to make sure that
+// tokens are only returned when their boundaries can be determined.
+func scanTestCases(t *testing.T, name string, split bufio.SplitFunc, testCases []testCase) {
+	for testNum, testCase := range testCases {
+		scanner := bufio.NewScanner(iotest.OneByteReader(strings.NewReader(testCase.original)))
+		scanner.Split(split)
+		var index int
+	TokenLoop:
+		for index = 0; scanner.Scan(); index++ {
+			if index >= len(testCase.expected) {
+				t.Errorf(`%s test case %d %q failed: More tokens %d returned than expected %d`,
+					name,
+					testNum,
+					testCase.original,
+					index,
+					len(testCase.expected))
+				break
+			}
+			token := []rune(scanner.Text())
+			if len(token) != len(testCase.expected[index]) {
+				t.Errorf(`%s test case %d %q failed: Token at index %d has %d codepoints %x, %d expected %x`,
+					name,
+					testNum,
+					testCase.original,
+					index,
+					len(token),
+					token,
+					len(testCase.expected[index]),
+					testCase.expected[index])
+				break
+			}
+			for i, r := range token {
+				if r != testCase.expected[index][i] {
+					t.Errorf(`%s test case %d %q failed: Token at index %d is %x, expected %x`,
+						name,
+						testNum,
+						testCase.original,
+						index,
+						token,
+						testCase.expected[index])
+					break TokenLoop
+				}
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			t.Errorf(`%s test case %d %q failed: %s`, name, testNum, testCase.original, err)
+		}
+		if index < len(testCase.expected) {
+			t.Errorf(`%s test case %d %q failed: Fewer tokens returned (%d) than expected (%d)`,
+				name,
+				testNum,
+				testCase.original,
+				index,
+				len(testCase.expected))
+		}
+	}
+}
+
+// Test the split functions with all official Unicode test cases.
+func TestScan(t *testing.T) {
+	scanTestCases(t, "Graphemes", ScanGraphemes, append(testCases, graphemeBreakTestCases...))
+	scanTestCases(t, "Words", ScanWords, wordBreakTestCases)
+	scanTestCases(t, "Sentences", ScanSentences, sentenceBreakTestCases)
+	scanTestCases(t, "LineSegments", ScanLineSegments, lineBreakTestCases)
+}
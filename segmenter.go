@@ -0,0 +1,263 @@
+package uniseg
+
+import (
+	"errors"
+	"io"
+	"unicode/utf8"
+)
+
+// DefaultMaxClusterLength is the default maximum length, in bytes, of a grapheme
+// cluster returned by a [Segmenter].
+const DefaultMaxClusterLength = 1024
+
+// ErrNoProgress is returned by [Segmenter.Err] if the underlying reader
+// returned no data and no error many times in a row.
+var ErrNoProgress = errors.New("uniseg: reader returned no data")
+
+// Segmenter implements an iterator over the grapheme clusters read from an
+// [io.Reader]. It provides the same information as the [Graphemes] class (word
+// boundaries, sentence boundaries, line breaks, and monospace widths) but does
+// not require the entire text to be held in memory.
+//
+// After constructing the class via [NewSegmenter], [Segmenter.Next] is called
+// for every grapheme cluster in a loop until it returns false. Inside the loop,
+// information about the grapheme cluster is available via the various methods.
+// After the loop, [Segmenter.Err] returns the first non-EOF error that was
+// encountered by the reader. The text read before such an error is still
+// segmented as if the text ended there.
+//
+// Some boundaries can only be determined by looking at the text following the
+// current grapheme cluster (e.g. rules WB6 and WB7 or SB8 of UAX #29, or LB25
+// of UAX #14). The Segmenter therefore keeps at least the maximum cluster
+// length (see [Segmenter.SetMaxClusterLength]) worth of text buffered after
+// the current grapheme cluster. Any lookahead context exceeding this length is
+// treated as if the text ended there. Grapheme clusters longer than the maximum
+// length, which only occur with pathological input such as long sequences of
+// combining characters, are split into multiple clusters. Beyond these limits,
+// the results are identical to those of [Step].
+type Segmenter struct {
+	// The reader providing the text.
+	reader io.Reader
+
+	// The buffer holding text read from the reader. buffer[start:end] is the
+	// text not yet parsed.
+	buffer     []byte
+	start, end int
+
+	// Whether the reader has no more data to provide.
+	eof bool
+
+	// The first non-EOF error returned by the reader.
+	err error
+
+	// The maximum length of a grapheme cluster, in bytes.
+	maxClusterLength int
+
+	// The current grapheme cluster, a sub-slice of the buffer.
+	cluster []byte
+
+	// The byte offset of the current grapheme cluster relative to the start of
+	// the text.
+	offset int
+
+	// The current boundary information of the [Step] parser.
+	boundaries int
+
+	// The current state of the [Step] parser. -1 before the first call to
+	// [Segmenter.Next], -2 after the iterator has reached the end.
+	state int
+}
+
+// NewSegmenter returns a new grapheme cluster iterator reading text from the
+// given reader.
+func NewSegmenter(r io.Reader) *Segmenter {
+	return &Segmenter{
+		reader:           r,
+		maxClusterLength: DefaultMaxClusterLength,
+		state:            -1,
+	}
+}
+
+// SetMaxClusterLength sets the maximum length, in bytes, of the grapheme
+// clusters returned by this iterator and thus also the maximum length of the
+// lookahead context (see [Segmenter] for details). The memory used by the
+// iterator is proportional to this length. Values smaller than [utf8.UTFMax]
+// are ignored. If this function is called during the iteration, the new
+// length applies from the next call to [Segmenter.Next] onwards.
+func (s *Segmenter) SetMaxClusterLength(length int) *Segmenter {
+	if length >= utf8.UTFMax {
+		s.maxClusterLength = length
+	}
+	return s
+}
+
+// Next advances the iterator by one grapheme cluster and returns false if no
+// clusters are left. If the reader returned an error, the clusters of the text
+// read before it are returned first, followed by false. This function must be
+// called before the first cluster is accessed.
+func (s *Segmenter) Next() bool {
+	if s.state == -2 {
+		return false
+	}
+	s.offset += len(s.cluster)
+
+	// Make sure the buffer holds the next cluster and its lookahead context.
+	// The buffer is twice as large as needed for this so we only need to refill
+	// it every few clusters.
+	if len(s.buffer) < 4*s.maxClusterLength {
+		// The buffer is allocated on the first call and grown if the maximum
+		// cluster length was increased since.
+		buffer := make([]byte, 4*s.maxClusterLength)
+		s.end = copy(buffer, s.buffer[s.start:s.end])
+		s.start = 0
+		s.buffer = buffer
+	}
+	for !s.eof && s.end-s.start < 2*s.maxClusterLength {
+		s.fill()
+	}
+	text := s.buffer[s.start:s.end]
+	if len(text) == 0 {
+		s.state = -2
+		s.cluster = nil
+		return false
+	}
+
+	// We can't parse an incomplete rune at the end before we have more data.
+	if !s.eof {
+		for i := len(text) - 1; i >= 0 && i >= len(text)-utf8.UTFMax; i-- {
+			if utf8.RuneStart(text[i]) {
+				if !utf8.FullRune(text[i:]) {
+					text = text[:i]
+				}
+				break
+			}
+		}
+	}
+
+	// Parse the next cluster.
+	s.cluster, _, s.boundaries, s.state = Step(text, s.state)
+	if len(s.cluster) > s.maxClusterLength {
+		// This cluster is too long. Cut it off at a rune boundary and start over
+		// afterwards.
+		length := s.maxClusterLength
+		for length > 0 && !utf8.RuneStart(s.cluster[length]) {
+			length--
+		}
+		if length == 0 {
+			_, length = utf8.DecodeRune(s.cluster)
+		}
+		s.cluster = s.cluster[:length]
+		s.boundaries = LineCanBreak | (StringWidth(string(s.cluster)) << ShiftWidth)
+		s.state = -1
+	}
+	s.start += len(s.cluster)
+
+	return true
+}
+
+// fill reads more data from the reader into the free part of the buffer,
+// moving the unparsed text to the beginning of the buffer first.
+func (s *Segmenter) fill() {
+	if s.start > 0 {
+		copy(s.buffer, s.buffer[s.start:s.end])
+		s.end -= s.start
+		s.start = 0
+	}
+	for empty := 0; empty < 100; empty++ {
+		n, err := s.reader.Read(s.buffer[s.end:])
+		s.end += n
+		if err != nil {
+			if err != io.EOF {
+				s.err = err
+			}
+			s.eof = true
+			return
+		}
+		if n > 0 {
+			return
+		}
+	}
+	s.err = ErrNoProgress
+	s.eof = true
+}
+
+// Err returns the first non-EOF error that was encountered by the iterator's
+// reader.
+func (s *Segmenter) Err() error {
+	return s.err
+}
+
+// Runes returns a slice of runes (code points) which corresponds to the current
+// grapheme cluster. If the iterator is already past the end or
+// [Segmenter.Next] has not yet been called, nil is returned.
+func (s *Segmenter) Runes() []rune {
+	if s.cluster == nil {
+		return nil
+	}
+	return []rune(string(s.cluster))
+}
+
+// Str returns the current grapheme cluster as a string. If the iterator is
+// already past the end or [Segmenter.Next] has not yet been called, an empty
+// string is returned.
+func (s *Segmenter) Str() string {
+	return string(s.cluster)
+}
+
+// Bytes returns a byte slice which corresponds to the current grapheme cluster.
+// The underlying array may be overwritten by the next call to
+// [Segmenter.Next]. If the iterator is already past the end or
+// [Segmenter.Next] has not yet been called, nil is returned.
+func (s *Segmenter) Bytes() []byte {
+	return s.cluster
+}
+
+// Positions returns the interval of the current grapheme cluster as byte
+// positions into the text read from the reader. The first returned value
+// "from" indexes the first byte and the second returned value "to" indexes the
+// first byte that is not included anymore. If [Segmenter.Next] has not yet
+// been called, both values are 0. If the iterator is already past the end,
+// both values are the length of the text.
+func (s *Segmenter) Positions() (int, int) {
+	return s.offset, s.offset + len(s.cluster)
+}
+
+// IsWordBoundary returns true if a word ends after the current grapheme
+// cluster.
+func (s *Segmenter) IsWordBoundary() bool {
+	if s.cluster == nil {
+		return true
+	}
+	return s.boundaries&MaskWord != 0
+}
+
+// IsSentenceBoundary returns true if a sentence ends after the current
+// grapheme cluster.
+func (s *Segmenter) IsSentenceBoundary() bool {
+	if s.cluster == nil {
+		return true
+	}
+	return s.boundaries&MaskSentence != 0
+}
+
+// LineBreak returns whether the line can be broken after the current grapheme
+// cluster. A value of [LineDontBreak] means the line may not be broken, a value
+// of [LineMustBreak] means the line must be broken, and a value of
+// [LineCanBreak] means the line may or may not be broken.
+func (s *Segmenter) LineBreak() int {
+	if s.cluster == nil {
+		if s.state == -2 {
+			return LineMustBreak
+		}
+		return LineDontBreak
+	}
+	return s.boundaries & MaskLine
+}
+
+// Width returns the monospace width of the current grapheme cluster.
+func (s *Segmenter) Width() int {
+	if s.cluster == nil {
+		return 0
+	}
+	return s.boundaries >> ShiftWidth
+}
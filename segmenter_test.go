@@ -0,0 +1,223 @@
+package uniseg
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+// Test all grapheme cluster test cases using the Segmenter class, reading one
+// byte at a time.
+func TestSegmenterGraphemes(t *testing.T) {
+	allCases := append(testCases, graphemeBreakTestCases...)
+	for testNum, testCase := range allCases {
+		segmenter := NewSegmenter(iotest.OneByteReader(strings.NewReader(testCase.original)))
+		var index int
+	GraphemeLoop:
+		for index = 0; segmenter.Next(); index++ {
+			if index >= len(testCase.expected) {
+				t.Errorf(`Test case %d %q failed: More grapheme clusters returned than expected %d`,
+					testNum,
+					testCase.original,
+					len(testCase.expected))
+				break
+			}
+			cluster := segmenter.Runes()
+			if len(cluster) != len(testCase.expected[index]) {
+				t.Errorf(`Test case %d %q failed: Grapheme cluster at index %d has %d codepoints %x, %d expected %x`,
+					testNum,
+					testCase.original,
+					index,
+					len(cluster),
+					cluster,
+					len(testCase.expected[index]),
+					testCase.expected[index])
+				break
+			}
+			for i, r := range cluster {
+				if r != testCase.expected[index][i] {
+					t.Errorf(`Test case %d %q failed: Grapheme cluster at index %d is %x, expected %x`,
+						testNum,
+						testCase.original,
+						index,
+						cluster,
+						testCase.expected[index])
+					break GraphemeLoop
+				}
+			}
+		}
+		if index < len(testCase.expected) {
+			t.Errorf(`Test case %d %q failed: Fewer grapheme clusters returned (%d) than expected (%d)`,
+				testNum,
+				testCase.original,
+				index,
+				len(testCase.expected))
+		}
+	}
+}
+
+// Test that the Segmenter class returns the same results as StepString, even
+// if contexts straddle the edges of its buffer.
+func TestSegmenterStep(t *testing.T) {
+	var builder strings.Builder
+	for _, cases := range [][]testCase{wordBreakTestCases, sentenceBreakTestCases, lineBreakTestCases} {
+		for _, testCase := range cases {
+			builder.WriteString(testCase.original)
+		}
+	}
+	text := builder.String()
+
+	for _, maxLength := range []int{64, 256, DefaultMaxClusterLength} {
+		segmenter := NewSegmenter(iotest.HalfReader(strings.NewReader(text))).SetMaxClusterLength(maxLength)
+		str, state := text, -1
+		var (
+			cluster    string
+			boundaries int
+			offset     int
+		)
+		for index := 0; len(str) > 0; index++ {
+			cluster, str, boundaries, state = StepString(str, state)
+			if !segmenter.Next() {
+				t.Fatalf(`Max length %d: Segmenter ended at cluster %d, expected %q`, maxLength, index, cluster)
+			}
+			if actual := segmenter.Str(); actual != cluster {
+				t.Fatalf(`Max length %d: Cluster %d is %q, expected %q`, maxLength, index, actual, cluster)
+			}
+			from, to := segmenter.Positions()
+			if from != offset || to != offset+len(cluster) {
+				t.Fatalf(`Max length %d: Cluster %d has positions %d-%d, expected %d-%d`, maxLength, index, from, to, offset, offset+len(cluster))
+			}
+			offset = to
+			if segmenter.IsWordBoundary() != (boundaries&MaskWord != 0) ||
+				segmenter.IsSentenceBoundary() != (boundaries&MaskSentence != 0) ||
+				segmenter.LineBreak() != boundaries&MaskLine ||
+				segmenter.Width() != boundaries>>ShiftWidth {
+				t.Fatalf(`Max length %d: Cluster %d %q at %d has different boundaries`, maxLength, index, cluster, from)
+			}
+		}
+		if segmenter.Next() {
+			t.Errorf(`Max length %d: Expected no more clusters, got %q`, maxLength, segmenter.Str())
+		}
+		if err := segmenter.Err(); err != nil {
+			t.Errorf(`Max length %d: Unexpected error: %s`, maxLength, err)
+		}
+	}
+}
+
+// Test that the Segmenter class limits the length of grapheme clusters.
+func TestSegmenterMaxClusterLength(t *testing.T) {
+	text := "a" + strings.Repeat("̈", 1000) + "b"
+	segmenter := NewSegmenter(strings.NewReader(text)).SetMaxClusterLength(65)
+	var joined string
+	for segmenter.Next() {
+		if len(segmenter.Bytes()) > 65 {
+			t.Errorf(`Cluster %q is longer than 65 bytes`, segmenter.Str())
+		}
+		joined += segmenter.Str()
+	}
+	if joined != text {
+		t.Errorf(`Expected clusters to make up the original text`)
+	}
+	if from, to := segmenter.Positions(); from != len(text) || to != len(text) {
+		t.Errorf(`Expected from=%d to=%d, got from=%d to=%d`, len(text), len(text), from, to)
+	}
+	if segmenter.LineBreak() != LineMustBreak {
+		t.Errorf(`Expected mandatory line break after the end`)
+	}
+}
+
+// Test that reader errors are reported.
+func TestSegmenterError(t *testing.T) {
+	readErr := errors.New("read error")
+	segmenter := NewSegmenter(iotest.DataErrReader(iotest.ErrReader(readErr)))
+	if segmenter.Next() {
+		t.Errorf(`Expected no clusters, got %q`, segmenter.Str())
+	}
+	if err := segmenter.Err(); err != readErr {
+		t.Errorf(`Expected read error, got %v`, err)
+	}
+	if segmenter.Bytes() != nil || segmenter.Runes() != nil || segmenter.Width() != 0 {
+		t.Errorf(`Expected empty cluster`)
+	}
+}
+
+// Test that the text read before a reader error is still segmented.
+func TestSegmenterErrorAfterData(t *testing.T) {
+	readErr := errors.New("read error")
+	segmenter := NewSegmenter(io.MultiReader(strings.NewReader("Hi!"), iotest.ErrReader(readErr)))
+	var joined string
+	for segmenter.Next() {
+		joined += segmenter.Str()
+	}
+	if joined != "Hi!" {
+		t.Errorf(`Expected clusters to make up "Hi!", got %q`, joined)
+	}
+	if segmenter.LineBreak() != LineMustBreak {
+		t.Errorf(`Expected mandatory line break after the end`)
+	}
+	if err := segmenter.Err(); err != readErr {
+		t.Errorf(`Expected read error, got %v`, err)
+	}
+}
+
+// Test changing the maximum cluster length during the iteration.
+func TestSegmenterChangeMaxClusterLength(t *testing.T) {
+	text := strings.Repeat("Hello, world! ", 100)
+	for _, maxLength := range []int{8, 5000} {
+		segmenter := NewSegmenter(strings.NewReader(text)).SetMaxClusterLength(16)
+		var joined string
+		for segmenter.Next() {
+			if joined == "" {
+				segmenter.SetMaxClusterLength(maxLength)
+			}
+			joined += segmenter.Str()
+		}
+		if joined != text {
+			t.Errorf(`Max length %d: Expected clusters to make up the original text, got %q`, maxLength, joined)
+		}
+		if err := segmenter.Err(); err != nil {
+			t.Errorf(`Max length %d: Unexpected error: %s`, maxLength, err)
+		}
+	}
+}
+
+// Benchmark the use of the Segmenter class.
+func BenchmarkSegmenter(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		segmenter := NewSegmenter(strings.NewReader(benchmarkStr))
+		for segmenter.Next() {
+			resultRunes = segmenter.Runes()
+		}
+	}
+}
+
+// countingReader counts the calls to its Read method.
+type countingReader struct {
+	reader io.Reader
+	reads  int
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	r.reads++
+	return r.reader.Read(p)
+}
+
+// Test that the Segmenter class does not read from the reader for every
+// grapheme cluster.
+func TestSegmenterReads(t *testing.T) {
+	text := strings.Repeat("Hello, world! ", 10000)
+	reader := &countingReader{reader: strings.NewReader(text)}
+	segmenter := NewSegmenter(reader)
+	var clusters int
+	for segmenter.Next() {
+		clusters++
+	}
+	if clusters != len(text) {
+		t.Errorf(`Expected %d clusters, got %d`, len(text), clusters)
+	}
+	if max := len(text)/(2*DefaultMaxClusterLength) + 2; reader.reads > max {
+		t.Errorf(`Expected at most %d reads, got %d`, max, reader.reads)
+	}
+}
@@ -1,6 +1,130 @@
 package uniseg
 
-import "unicode/utf8"
+import "fmt"
+
+// Sentences implements an iterator over sentences according to the rules of
+// [Unicode Standard Annex #29, Sentence Boundaries].
+//
+// After constructing the class via [NewSentences] for a given string "str" (or
+// [NewSentencesBytes] for a byte slice), [Sentences.Next] is called for every
+// sentence in a loop until it returns false. Inside the loop, information about
+// the sentence is available via the various methods (see examples below).
+//
+// This class basically wraps the [FirstSentenceInString] parser and provides a
+// convenient interface to it. If performance is important, using
+// [FirstSentenceInString] directly is faster.
+//
+// [Unicode Standard Annex #29, Sentence Boundaries]: http://unicode.org/reports/tr29/#Sentence_Boundaries
+type Sentences struct {
+	// The original string.
+	original string
+
+	// The remaining string to be parsed.
+	remaining string
+
+	// The current sentence.
+	sentence string
+
+	// The byte offset of the current sentence relative to the original string.
+	offset int
+
+	// The current state of the sentence parser. -1 before the first call to
+	// [Sentences.Next], -2 after the iterator has reached the end.
+	state int
+}
+
+// NewSentences returns a new sentence iterator.
+func NewSentences(str string) *Sentences {
+	return &Sentences{
+		original:  str,
+		remaining: str,
+		state:     -1,
+	}
+}
+
+// NewSentencesBytes returns a new sentence iterator for the given byte slice.
+// The byte slice is copied, i.e. later changes to it will not affect the
+// iterator.
+func NewSentencesBytes(b []byte) *Sentences {
+	return NewSentences(string(b))
+}
+
+// String returns a string representation of the current sentence iterator. It
+// includes the current sentence, wrapped in curly brackets, and the first 10
+// bytes of the remaining string.
+func (s *Sentences) String() string {
+	remaining := s.remaining
+	if len(remaining) > 10 {
+		remaining = remaining[:10] + "..."
+	}
+	return fmt.Sprintf("{%s}%s", s.sentence, remaining)
+}
+
+// Next advances the iterator by one sentence and returns false if no sentences
+// are left. This function must be called before the first sentence is
+// accessed.
+func (s *Sentences) Next() bool {
+	if len(s.remaining) == 0 {
+		// We're already past the end.
+		s.state = -2
+		s.sentence = ""
+		return false
+	}
+	s.offset += len(s.sentence)
+	s.sentence, s.remaining, s.state = FirstSentenceInString(s.remaining, s.state)
+	return true
+}
+
+// Runes returns a slice of runes (code points) which corresponds to the current
+// sentence. If the iterator is already past the end or [Sentences.Next] has not
+// yet been called, nil is returned.
+func (s *Sentences) Runes() []rune {
+	if s.state < 0 {
+		return nil
+	}
+	return []rune(s.sentence)
+}
+
+// Str returns a substring of the original string which corresponds to the
+// current sentence. If the iterator is already past the end or
+// [Sentences.Next] has not yet been called, an empty string is returned.
+func (s *Sentences) Str() string {
+	return s.sentence
+}
+
+// Bytes returns a byte slice which corresponds to the current sentence. If the
+// iterator is already past the end or [Sentences.Next] has not yet been called,
+// nil is returned.
+func (s *Sentences) Bytes() []byte {
+	if s.state < 0 {
+		return nil
+	}
+	return []byte(s.sentence)
+}
+
+// Positions returns the interval of the current sentence as byte positions
+// into the original string. The first returned value "from" indexes the first
+// byte and the second returned value "to" indexes the first byte that is not
+// included anymore, i.e. str[from:to] is the current sentence of the original
+// string "str". If [Sentences.Next] has not yet been called, both values are
+// 0. If the iterator is already past the end, both values are 1.
+func (s *Sentences) Positions() (int, int) {
+	if s.state == -1 {
+		return 0, 0
+	} else if s.state == -2 {
+		return 1, 1
+	}
+	return s.offset, s.offset + len(s.sentence)
+}
+
+// Reset puts the iterator into its initial state such that the next call to
+// [Sentences.Next] sets it to the first sentence again.
+func (s *Sentences) Reset() {
+	s.state = -1
+	s.offset = 0
+	s.sentence = ""
+	s.remaining = s.original
+}
 
 // FirstSentence returns the first sentence found in the given byte slice
 // according to the rules of [Unicode Standard Annex #29, Sentence Boundaries].
@@ -20,71 +144,114 @@ import "unicode/utf8"
 //
 // [Unicode Standard Annex #29, Sentence Boundaries]: http://unicode.org/reports/tr29/#Sentence_Boundaries
 func FirstSentence(b []byte, state int) (sentence, rest []byte, newState int) {
-	// An empty byte slice returns nothing.
-	if len(b) == 0 {
+	return firstSentence(b, state)
+}
+
+// FirstSentenceInString is like [FirstSentence] but its input and outputs are
+// strings.
+func FirstSentenceInString(str string, state int) (sentence, rest string, newState int) {
+	return firstSentence(str, state)
+}
+
+// firstSentence implements [FirstSentence] for all supported text representations.
+func firstSentence[T text](t T, state int) (sentence, rest T, newState int) {
+	// An empty text returns nothing.
+	if len(t) == 0 {
 		return
 	}
 
 	// Extract the first rune.
-	r, length := utf8.DecodeRune(b)
-	if len(b) <= length { // If we're already past the end, there is nothing else to parse.
-		return b, nil, sbAny
+	r, remainder := decodeText(t)
+	if len(remainder) == 0 { // If we're already past the end, there is nothing else to parse.
+		return t, rest, sbAny
 	}
 
 	// If we don't know the state, determine it now.
 	if state < 0 {
-		state, _ = transitionSentenceBreakState(state, r, b[length:], "")
+		state, _ = transitionSentenceBreakState(state, r, remainder)
 	}
 
 	// Transition until we find a boundary.
 	var boundary bool
 	for {
-		r, l := utf8.DecodeRune(b[length:])
-		state, boundary = transitionSentenceBreakState(state, r, b[length+l:], "")
+		r, next := decodeText(remainder)
+		state, boundary = transitionSentenceBreakState(state, r, next)
 
 		if boundary {
-			return b[:length], b[length:], state
+			sentence, rest = splitText(t, len(t)-len(remainder))
+			return sentence, rest, state
 		}
 
-		length += l
-		if len(b) <= length {
-			return b, nil, sbAny
+		remainder = next
+		if len(remainder) == 0 {
+			return t, rest, sbAny
 		}
 	}
 }
 
-// FirstSentenceInString is like [FirstSentence] but its input and outputs are
-// strings.
-func FirstSentenceInString(str string, state int) (sentence, rest string, newState int) {
+// LastSentence returns the last sentence found in the given byte slice
+// according to the rules of [Unicode Standard Annex #29, Sentence Boundaries].
+// This function can be called continuously to extract all sentences from a
+// byte slice in reverse order.
+//
+// The "rest" slice is the sub-slice of the original byte slice "b" ending before
+// the first byte of the identified sentence. If the length of the "rest" slice
+// is 0, the entire byte slice "b" has been processed. The "sentence" byte slice
+// is the sub-slice of the input slice containing the identified sentence.
+//
+// Given an empty byte slice "b", the function returns nil values.
+//
+// As sentence boundaries depend on the context preceding them, the function
+// looks back to the beginning of the last paragraph, i.e. to the last paragraph
+// separator (see rule SB4), and parses forward from there.
+//
+// [Unicode Standard Annex #29, Sentence Boundaries]: http://unicode.org/reports/tr29/#Sentence_Boundaries
+func LastSentence(b []byte) (sentence, rest []byte) {
 	// An empty byte slice returns nothing.
-	if len(str) == 0 {
+	if len(b) == 0 {
 		return
 	}
 
-	// Extract the first rune.
-	r, length := utf8.DecodeRuneInString(str)
-	if len(str) <= length { // If we're already past the end, there is nothing else to parse.
-		return str, "", sbAny
+	// Parse forward from a safe starting point.
+	remainder, state := b[lastSentenceSafePoint(b):], -1
+	for len(remainder) > 0 {
+		sentence, remainder, state = FirstSentence(remainder, state)
 	}
+	return sentence, b[:len(b)-len(sentence)]
+}
 
-	// If we don't know the state, determine it now.
-	if state < 0 {
-		state, _ = transitionSentenceBreakState(state, r, nil, str[length:])
+// LastSentenceInString is like [LastSentence] but its input and outputs are
+// strings.
+func LastSentenceInString(str string) (sentence, rest string) {
+	// An empty string returns nothing.
+	if len(str) == 0 {
+		return
 	}
 
-	// Transition until we find a boundary.
-	var boundary bool
-	for {
-		r, l := utf8.DecodeRuneInString(str[length:])
-		state, boundary = transitionSentenceBreakState(state, r, nil, str[length+l:])
-
-		if boundary {
-			return str[:length], str[length:], state
-		}
+	// Parse forward from a safe starting point.
+	remainder, state := str[lastSentenceSafePoint(str):], -1
+	for len(remainder) > 0 {
+		sentence, remainder, state = FirstSentenceInString(remainder, state)
+	}
+	return sentence, str[:len(str)-len(sentence)]
+}
 
-		length += l
-		if len(str) <= length {
-			return str, "", sbAny
+// lastSentenceSafePoint returns the position after the last paragraph
+// separator (SB4) in the given text which is followed by at least one more
+// code point. Parsing may start at this position with an initial state of -1.
+// The function returns 0 if no such position was found.
+func lastSentenceSafePoint[T text](t T) int {
+	next, rest := decodeLastText(t)
+	nextProp := propertySentences(next)
+	for len(rest) > 0 {
+		pos := len(rest)
+		var r rune
+		r, rest = decodeLastText(rest)
+		prop := propertySentences(r)
+		if prop == prSep || prop == prLF || prop == prCR && nextProp != prLF {
+			return pos
 		}
+		nextProp = prop
 	}
+	return 0
 }
@@ -171,3 +171,139 @@ func BenchmarkSentenceFunctionString(b *testing.B) {
 		}
 	}
 }
+
+// Test all official Unicode test cases for sentence boundaries using the
+// Sentences class.
+func TestSentencesClass(t *testing.T) {
+	for testNum, testCase := range sentenceBreakTestCases {
+		sentences := NewSentences(testCase.original)
+		var index int
+	SentenceLoop:
+		for index = 0; sentences.Next(); index++ {
+			if index >= len(testCase.expected) {
+				t.Errorf(`Test case %d %q failed: More sentences %d returned than expected %d`,
+					testNum,
+					testCase.original,
+					index,
+					len(testCase.expected))
+				break
+			}
+			sentence := sentences.Runes()
+			if len(sentence) != len(testCase.expected[index]) {
+				t.Errorf(`Test case %d %q failed: Sentence at index %d has %d codepoints %x, %d expected %x`,
+					testNum,
+					testCase.original,
+					index,
+					len(sentence),
+					sentence,
+					len(testCase.expected[index]),
+					testCase.expected[index])
+				break
+			}
+			for i, r := range sentence {
+				if r != testCase.expected[index][i] {
+					t.Errorf(`Test case %d %q failed: Sentence at index %d is %x, expected %x`,
+						testNum,
+						testCase.original,
+						index,
+						sentence,
+						testCase.expected[index])
+					break SentenceLoop
+				}
+			}
+		}
+		if index < len(testCase.expected) {
+			t.Errorf(`Test case %d %q failed: Fewer sentences returned (%d) than expected (%d)`,
+				testNum,
+				testCase.original,
+				index,
+				len(testCase.expected))
+		}
+	}
+}
+
+// Test the positions returned by the Sentences class.
+func TestSentencesPositions(t *testing.T) {
+	sentences := NewSentencesBytes([]byte("Hä? Ja. 世界。"))
+	expected := []struct {
+		sentence string
+		from, to int
+	}{
+		{"Hä? ", 0, 5},
+		{"Ja. ", 5, 9},
+		{"世界。", 9, 18},
+	}
+	for index, e := range expected {
+		if !sentences.Next() {
+			t.Fatalf(`Expected sentence %d, got none`, index)
+		}
+		if str := sentences.Str(); str != e.sentence {
+			t.Errorf(`Sentence %d: expected %q, got %q`, index, e.sentence, str)
+		}
+		if from, to := sentences.Positions(); from != e.from || to != e.to {
+			t.Errorf(`Sentence %d: expected from=%d to=%d, got from=%d to=%d`, index, e.from, e.to, from, to)
+		}
+	}
+	if sentences.Next() {
+		t.Errorf(`Expected no more sentences, got %q`, sentences.Str())
+	}
+	if from, to := sentences.Positions(); from != 1 || to != 1 {
+		t.Errorf(`Expected from=%d to=%d, got from=%d to=%d`, 1, 1, from, to)
+	}
+	sentences.Reset()
+	if b := sentences.Bytes(); b != nil {
+		t.Errorf(`Expected nil byte slice, got %x`, b)
+	}
+	sentences.Next()
+	if str := sentences.Str(); str != "Hä? " {
+		t.Errorf(`Expected "Hä? ", got %q`, str)
+	}
+}
+
+// Test all official Unicode test cases for sentence boundaries by iterating
+// backwards.
+func TestLastSentence(t *testing.T) {
+	for testNum, testCase := range sentenceBreakTestCases {
+		b := []byte(testCase.original)
+		str := testCase.original
+		index := len(testCase.expected)
+		for len(b) > 0 || len(str) > 0 {
+			index--
+			if index < 0 {
+				t.Errorf(`Test case %d %q failed: More sentences returned than expected %d`,
+					testNum,
+					testCase.original,
+					len(testCase.expected))
+				break
+			}
+			var (
+				sentence    []byte
+				sentenceStr string
+			)
+			sentence, b = LastSentence(b)
+			sentenceStr, str = LastSentenceInString(str)
+			expected := string(testCase.expected[index])
+			if string(sentence) != expected || sentenceStr != expected {
+				t.Errorf(`Test case %d %q failed: Sentence at index %d is %x (bytes) and %x (string), expected %x`,
+					testNum,
+					testCase.original,
+					index,
+					[]rune(string(sentence)),
+					[]rune(sentenceStr),
+					testCase.expected[index])
+				break
+			}
+		}
+		if index > 0 {
+			t.Errorf(`Test case %d %q failed: Fewer sentences returned (%d) than expected (%d)`,
+				testNum,
+				testCase.original,
+				len(testCase.expected)-index,
+				len(testCase.expected))
+		}
+	}
+	sentence, rest := LastSentence([]byte{})
+	if len(sentence) > 0 || len(rest) > 0 {
+		t.Errorf(`Expected empty results, got %q, %q`, sentence, rest)
+	}
+}
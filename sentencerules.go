@@ -21,7 +21,7 @@ const (
 // sbTransitions implements the sentence break parser's state transitions. It's
 // anologous to [grTransitions], see comments there for details.
 //
-// Unicode version 15.0.0.
+// Unicode version 17.0.0.
 func sbTransitions(state, prop int) (newState int, sentenceBreak bool, rule int) {
 	switch uint64(state) | uint64(prop)<<32 {
 	// SB3.
@@ -130,31 +130,31 @@ func sbTransitions(state, prop int) (newState int, sentenceBreak bool, rule int)
 	case sbATerm | prSep<<32:
 		return sbParaSep, false, 90
 	case sbATerm | prCR<<32:
-		return sbParaSep, false, 90
+		return sbCR, false, 90
 	case sbATerm | prLF<<32:
 		return sbParaSep, false, 90
 	case sbSB7 | prSep<<32:
 		return sbParaSep, false, 90
 	case sbSB7 | prCR<<32:
-		return sbParaSep, false, 90
+		return sbCR, false, 90
 	case sbSB7 | prLF<<32:
 		return sbParaSep, false, 90
 	case sbSB8Close | prSep<<32:
 		return sbParaSep, false, 90
 	case sbSB8Close | prCR<<32:
-		return sbParaSep, false, 90
+		return sbCR, false, 90
 	case sbSB8Close | prLF<<32:
 		return sbParaSep, false, 90
 	case sbSTerm | prSep<<32:
 		return sbParaSep, false, 90
 	case sbSTerm | prCR<<32:
-		return sbParaSep, false, 90
+		return sbCR, false, 90
 	case sbSTerm | prLF<<32:
 		return sbParaSep, false, 90
 	case sbSB8aClose | prSep<<32:
 		return sbParaSep, false, 90
 	case sbSB8aClose | prCR<<32:
-		return sbParaSep, false, 90
+		return sbCR, false, 90
 	case sbSB8aClose | prLF<<32:
 		return sbParaSep, false, 90
 
@@ -166,7 +166,7 @@ func sbTransitions(state, prop int) (newState int, sentenceBreak bool, rule int)
 	case sbSB8Sp | prSep<<32:
 		return sbParaSep, false, 100
 	case sbSB8Sp | prCR<<32:
-		return sbParaSep, false, 100
+		return sbCR, false, 100
 	case sbSB8Sp | prLF<<32:
 		return sbParaSep, false, 100
 
@@ -195,12 +195,11 @@ func sbTransitions(state, prop int) (newState int, sentenceBreak bool, rule int)
 // transitionSentenceBreakState determines the new state of the sentence break
 // parser given the current state and the next code point. It also returns
 // whether a sentence boundary was detected. If more than one code point is
-// needed to determine the new state, the byte slice or the string starting
-// after rune "r" can be used (whichever is not nil or empty) for further
-// lookups.
-func transitionSentenceBreakState(state int, r rune, b []byte, str string) (newState int, sentenceBreak bool) {
+// needed to determine the new state, the text starting after rune "r" can be
+// used for further lookups.
+func transitionSentenceBreakState[T text](state int, r rune, rest T) (newState int, sentenceBreak bool) {
 	// Determine the property of the next character.
-	nextProperty := property(sentenceBreakCodePoints, r)
+	nextProperty := propertySentences(r)
 
 	// SB5 (Replacing Ignore Rules).
 	if nextProperty == prExtend || nextProperty == prFormat {
@@ -245,7 +244,6 @@ func transitionSentenceBreakState(state int, r rune, b []byte, str string) (newS
 	// SB8.
 	if rule > 80 && (state == sbATerm || state == sbSB8Close || state == sbSB8Sp || state == sbSB7) {
 		// Check the right side of the rule.
-		var length int
 		for nextProperty != prOLetter &&
 			nextProperty != prUpper &&
 			nextProperty != prLower &&
@@ -255,17 +253,11 @@ func transitionSentenceBreakState(state int, r rune, b []byte, str string) (newS
 			nextProperty != prATerm &&
 			nextProperty != prSTerm {
 			// Move on to the next rune.
-			if b != nil { // Byte slice version.
-				r, length = utf8.DecodeRune(b)
-				b = b[length:]
-			} else { // String version.
-				r, length = utf8.DecodeRuneInString(str)
-				str = str[length:]
-			}
+			r, rest = decodeText(rest)
 			if r == utf8.RuneError {
 				break
 			}
-			nextProperty = property(sentenceBreakCodePoints, r)
+			nextProperty = propertySentences(r)
 		}
 		if nextProperty == prLower {
 			return sbLower, false
@@ -1,7 +1,5 @@
 package uniseg
 
-import "unicode/utf8"
-
 // The bit masks used to extract boundary information returned by [Step].
 const (
 	MaskLine     = 3
@@ -90,105 +88,142 @@ const (
 //
 // [UAX #14 LB3]: https://www.unicode.org/reports/tr14/#Algorithm
 func Step(b []byte, state int) (cluster, rest []byte, boundaries int, newState int) {
-	// An empty byte slice returns nothing.
-	if len(b) == 0 {
+	return step(b, state, nil)
+}
+
+// StepString is like [Step] but its input and outputs are strings.
+func StepString(str string, state int) (cluster, rest string, boundaries int, newState int) {
+	return step(str, state, nil)
+}
+
+// StepBack is the reverse of [Step]. It returns the grapheme cluster of the
+// byte slice "b" which ends at the given byte offset, together with the
+// boundary information between this cluster and the text following it, as
+// described for [Step]. To iterate over the grapheme clusters of "b" in reverse
+// order, start with an offset of len(b) and subtract the length of the
+// returned cluster after each call, until the offset is 0.
+//
+// If the offset is not a grapheme cluster boundary, the cluster containing the
+// byte before the offset is returned. Offsets larger than len(b) are treated
+// like len(b). If the offset is 0 or less, the function returns nil values.
+//
+// Because sentence boundaries and some line breaks depend on text far before
+// them, the function parses forward from the beginning of the paragraph
+// containing the cluster, i.e. from the last paragraph separator (see
+// [LastSentence]). Calling it repeatedly to iterate backwards over long
+// paragraphs therefore takes quadratic time. Use [StepBackSeqBytes] instead,
+// which parses each paragraph only once, or [LastGraphemeCluster] if you don't
+// need the boundary information.
+func StepBack(b []byte, offset int) (cluster []byte, boundaries int) {
+	if offset > len(b) {
+		offset = len(b)
+	}
+	if offset <= 0 {
 		return
 	}
+	return stepBack(b, lastSentenceSafePoint(b[:offset]), offset)
+}
 
-	// Extract the first rune.
-	r, length := utf8.DecodeRune(b)
-	if len(b) <= length { // If we're already past the end, there is nothing else to parse.
-		var prop int
-		if state < 0 {
-			prop = propertyGraphemes(r)
-		} else {
-			prop = state >> shiftPropState
-		}
-		return b, nil, LineMustBreak | (1 << shiftWord) | (1 << shiftSentence) | (runeWidth(r, prop) << ShiftWidth), grAny | (wbAny << shiftWordState) | (sbAny << shiftSentenceState) | (lbAny << shiftLineState) | (prop << shiftPropState)
+// StepBackString is like [StepBack] but its input and output are strings.
+func StepBackString(str string, offset int) (cluster string, boundaries int) {
+	if offset > len(str) {
+		offset = len(str)
 	}
-
-	// If we don't know the state, determine it now.
-	var graphemeState, wordState, sentenceState, lineState, firstProp int
-	remainder := b[length:]
-	if state < 0 {
-		graphemeState, firstProp, _ = transitionGraphemeState(state, r)
-		wordState, _ = transitionWordBreakState(state, r, remainder, "")
-		sentenceState, _ = transitionSentenceBreakState(state, r, remainder, "")
-		lineState, _ = transitionLineBreakState(state, r, remainder, "")
-	} else {
-		graphemeState = state & maskGraphemeState
-		wordState = (state >> shiftWordState) & maskWordState
-		sentenceState = (state >> shiftSentenceState) & maskSentenceState
-		lineState = (state >> shiftLineState) & maskLineState
-		firstProp = state >> shiftPropState
+	if offset <= 0 {
+		return
 	}
+	return stepBack(str, lastSentenceSafePoint(str[:offset]), offset)
+}
 
-	// Transition until we find a grapheme cluster boundary.
-	width := runeWidth(r, firstProp)
+// stepBack implements [StepBack] for all supported text representations,
+// parsing forward from the given position, which must be a paragraph start
+// before the offset.
+func stepBack[T text](t T, from, offset int) (cluster T, boundaries int) {
+	_, rest := splitText(t, from)
+	state := -1
 	for {
-		var (
-			graphemeBoundary, wordBoundary, sentenceBoundary bool
-			lineBreak, prop                                  int
-		)
-
-		r, l := utf8.DecodeRune(remainder)
-		remainder = b[length+l:]
+		cluster, rest, boundaries, state = step(rest, state, nil)
+		if len(t)-len(rest) >= offset {
+			return
+		}
+	}
+}
 
-		graphemeState, prop, graphemeBoundary = transitionGraphemeState(graphemeState, r)
-		wordState, wordBoundary = transitionWordBreakState(wordState, r, remainder, "")
-		sentenceState, sentenceBoundary = transitionSentenceBreakState(sentenceState, r, remainder, "")
-		lineState, lineBreak = transitionLineBreakState(lineState, r, remainder, "")
+// backSteps iterates backwards over the grapheme clusters of a text and their
+// boundary information, as returned by [Step]. The clusters of one paragraph
+// are parsed at once and cached so that each paragraph is parsed only once.
+type backSteps[T text] struct {
+	// The text before the cached clusters.
+	text T
 
-		if graphemeBoundary {
-			boundary := lineBreak | (width << ShiftWidth)
-			if wordBoundary {
-				boundary |= 1 << shiftWord
-			}
-			if sentenceBoundary {
-				boundary |= 1 << shiftSentence
-			}
-			return b[:length], b[length:], boundary, graphemeState | (wordState << shiftWordState) | (sentenceState << shiftSentenceState) | (lineState << shiftLineState) | (prop << shiftPropState)
-		}
+	// The clusters of the current paragraph not yet returned, and their
+	// boundary information.
+	clusters   []T
+	boundaries []int
+}
 
-		if firstProp == prExtendedPictographic {
-			if r == vs15 {
-				width = 1
-			} else if r == vs16 {
-				width = 2
-			}
-		} else if firstProp != prRegionalIndicator && firstProp != prL {
-			width += runeWidth(r, prop)
+// previous returns the cluster before the last one returned (or the last
+// cluster of the text on the first call) and its boundary information. If
+// there are no more clusters, "ok" is false.
+func (s *backSteps[T]) previous() (cluster T, boundaries int, ok bool) {
+	if len(s.clusters) == 0 {
+		if len(s.text) == 0 {
+			return
 		}
 
-		length += l
-		if len(b) <= length {
-			return b, nil, LineMustBreak | (1 << shiftWord) | (1 << shiftSentence) | (width << ShiftWidth), grAny | (wbAny << shiftWordState) | (sbAny << shiftSentenceState) | (lbAny << shiftLineState) | (prop << shiftPropState)
+		// Parse the last paragraph. Paragraph separators always cause
+		// boundaries, so the paragraph's end needs no following context.
+		var paragraph T
+		s.text, paragraph = splitText(s.text, lastSentenceSafePoint(s.text))
+		state := -1
+		for len(paragraph) > 0 {
+			cluster, paragraph, boundaries, state = step(paragraph, state, nil)
+			s.clusters = append(s.clusters, cluster)
+			s.boundaries = append(s.boundaries, boundaries)
 		}
 	}
+	last := len(s.clusters) - 1
+	cluster, boundaries = s.clusters[last], s.boundaries[last]
+	s.clusters, s.boundaries = s.clusters[:last], s.boundaries[:last]
+	return cluster, boundaries, true
 }
 
-// StepString is like [Step] but its input and outputs are strings.
-func StepString(str string, state int) (cluster, rest string, boundaries int, newState int) {
-	// An empty byte slice returns nothing.
-	if len(str) == 0 {
+// step implements [Step] for all supported text representations, using the
+// given width configuration. If config is nil, the package defaults apply.
+func step[T text](t T, state int, config *WidthConfig) (cluster, rest T, boundaries int, newState int) {
+	// An empty text returns nothing.
+	if len(t) == 0 {
 		return
 	}
 
+	// Escape sequences are zero-width clusters of their own. They don't change
+	// the state.
+	if config != nil && config.EscapeSequences {
+		if length := escapeSequenceLength(t); length > 0 {
+			cluster, rest = splitText(t, length)
+			return cluster, rest, escapeBoundaries(len(rest) == 0), state
+		}
+	}
+
 	// Extract the first rune.
-	r, length := utf8.DecodeRuneInString(str)
-	if len(str) <= length { // If we're already past the end, there is nothing else to parse.
-		prop := propertyGraphemes(r)
-		return str, "", LineMustBreak | (1 << shiftWord) | (1 << shiftSentence) | (runeWidth(r, prop) << ShiftWidth), grAny | (wbAny << shiftWordState) | (sbAny << shiftSentenceState) | (lbAny << shiftLineState)
+	first, remainder := decodeText(t)
+	if len(remainder) == 0 { // If we're already past the end, there is nothing else to parse.
+		var prop int
+		if state < 0 {
+			prop = propertyGraphemes(first)
+		} else {
+			prop = state >> shiftPropState
+		}
+		return t, rest, LineMustBreak | (1 << shiftWord) | (1 << shiftSentence) | (runeWidth(first, prop, config) << ShiftWidth), grAny | (wbAny << shiftWordState) | (sbAny << shiftSentenceState) | (lbAny << shiftLineState) | (prop << shiftPropState)
 	}
 
 	// If we don't know the state, determine it now.
 	var graphemeState, wordState, sentenceState, lineState, firstProp int
-	remainder := str[length:]
 	if state < 0 {
-		graphemeState, firstProp, _ = transitionGraphemeState(state, r)
-		wordState, _ = transitionWordBreakState(state, r, nil, remainder)
-		sentenceState, _ = transitionSentenceBreakState(state, r, nil, remainder)
-		lineState, _ = transitionLineBreakState(state, r, nil, remainder)
+		graphemeState, firstProp, _ = transitionGraphemeState(state, first)
+		wordState, _ = transitionWordBreakState(state, first, remainder)
+		sentenceState, _ = transitionSentenceBreakState(state, first, remainder)
+		lineState, _ = transitionLineBreakState(state, first, remainder)
 	} else {
 		graphemeState = state & maskGraphemeState
 		wordState = (state >> shiftWordState) & maskWordState
@@ -197,21 +232,29 @@ func StepString(str string, state int) (cluster, rest string, boundaries int, ne
 		firstProp = state >> shiftPropState
 	}
 
-	// Transition until we find a grapheme cluster boundary.
-	width := runeWidth(r, firstProp)
+	// Transition until we find a grapheme cluster boundary. Escape sequences
+	// are skipped. They belong to the cluster if it continues after them.
+	// Otherwise, the boundaries found after them are reported here.
+	width := runeWidth(first, firstProp, config)
+	prop, previous := firstProp, first
 	for {
 		var (
 			graphemeBoundary, wordBoundary, sentenceBoundary bool
-			lineBreak, prop                                  int
+			lineBreak                                        int
 		)
 
-		r, l := utf8.DecodeRuneInString(remainder)
-		remainder = str[length+l:]
+		skipped := skipEscapeSequences(remainder, config)
+		if len(skipped) == 0 {
+			// Only escape sequences follow. The last one reports the end of the text.
+			cluster, rest = splitText(t, len(t)-len(remainder))
+			return cluster, rest, LineDontBreak | (width << ShiftWidth), grAny | (wbAny << shiftWordState) | (sbAny << shiftSentenceState) | (lbAny << shiftLineState) | (prop << shiftPropState)
+		}
+		r, next := decodeText(skipped)
 
 		graphemeState, prop, graphemeBoundary = transitionGraphemeState(graphemeState, r)
-		wordState, wordBoundary = transitionWordBreakState(wordState, r, nil, remainder)
-		sentenceState, sentenceBoundary = transitionSentenceBreakState(sentenceState, r, nil, remainder)
-		lineState, lineBreak = transitionLineBreakState(lineState, r, nil, remainder)
+		wordState, wordBoundary = transitionWordBreakState(wordState, r, next)
+		sentenceState, sentenceBoundary = transitionSentenceBreakState(sentenceState, r, next)
+		lineState, lineBreak = transitionLineBreakState(lineState, r, next)
 
 		if graphemeBoundary {
 			boundary := lineBreak | (width << ShiftWidth)
@@ -221,22 +264,16 @@ func StepString(str string, state int) (cluster, rest string, boundaries int, ne
 			if sentenceBoundary {
 				boundary |= 1 << shiftSentence
 			}
-			return str[:length], str[length:], boundary, graphemeState | (wordState << shiftWordState) | (sentenceState << shiftSentenceState) | (lineState << shiftLineState) | (prop << shiftPropState)
+			cluster, rest = splitText(t, len(t)-len(remainder))
+			return cluster, rest, boundary, graphemeState | (wordState << shiftWordState) | (sentenceState << shiftSentenceState) | (lineState << shiftLineState) | (prop << shiftPropState)
 		}
 
-		if firstProp == prExtendedPictographic {
-			if r == vs15 {
-				width = 1
-			} else if r == vs16 {
-				width = 2
-			}
-		} else if firstProp != prRegionalIndicator && firstProp != prL {
-			width += runeWidth(r, prop)
-		}
+		width = clusterWidth(width, first, firstProp, previous, r, prop, config)
+		previous = r
 
-		length += l
-		if len(str) <= length {
-			return str, "", LineMustBreak | (1 << shiftWord) | (1 << shiftSentence) | (width << ShiftWidth), grAny | (wbAny << shiftWordState) | (sbAny << shiftSentenceState) | (lbAny << shiftLineState) | (prop << shiftPropState)
+		remainder = next
+		if len(remainder) == 0 {
+			return t, rest, LineMustBreak | (1 << shiftWord) | (1 << shiftSentence) | (width << ShiftWidth), grAny | (wbAny << shiftWordState) | (sbAny << shiftSentenceState) | (lbAny << shiftLineState) | (prop << shiftPropState)
 		}
 	}
 }
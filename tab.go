@@ -0,0 +1,122 @@
+package uniseg
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// FirstGraphemeClusterAt is like [WidthConfig.FirstGraphemeCluster] but the
+// cluster is assumed to start at the given column (the number of cells
+// preceding it on the same line, starting at 0). If the cluster is a horizontal
+// tab and [WidthConfig.TabWidth] is positive, the returned width is the number
+// of cells up to the next tab stop. For all other clusters, the width is the
+// same as the one returned by [WidthConfig.FirstGraphemeCluster].
+//
+// To track the column of subsequent clusters, add the returned width to the
+// column. Note that the column should be reset to 0 after line breaks.
+func (c *WidthConfig) FirstGraphemeClusterAt(b []byte, state, column int) (cluster, rest []byte, width, newState int) {
+	cluster, rest, width, newState = firstGraphemeCluster(b, state, c)
+	if c.TabWidth > 0 && len(cluster) == 1 && cluster[0] == '\t' {
+		width = tabWidth(c.TabWidth, column)
+	}
+	return
+}
+
+// FirstGraphemeClusterInStringAt is like [WidthConfig.FirstGraphemeClusterAt]
+// but its input and outputs are strings.
+func (c *WidthConfig) FirstGraphemeClusterInStringAt(str string, state, column int) (cluster, rest string, width, newState int) {
+	cluster, rest, width, newState = firstGraphemeCluster(str, state, c)
+	width = clusterWidthAt(cluster, width, column, c)
+	return
+}
+
+// StringWidthAt returns the monospace width of the given string if it is
+// printed starting at the given column. The column only makes a difference if
+// the string contains horizontal tabs and [WidthConfig.TabWidth] is positive.
+// The string is assumed to consist of one line only.
+func (c *WidthConfig) StringWidthAt(str string, column int) (width int) {
+	state := -1
+	for len(str) > 0 {
+		var w int
+		_, str, w, state = c.FirstGraphemeClusterInStringAt(str, state, column+width)
+		width += w
+	}
+	return
+}
+
+// ExpandTabs replaces all horizontal tabs in the given string with the number
+// of spaces needed to reach the next tab stop, given that the string is printed
+// starting at the given column. Tab stops are placed every
+// [WidthConfig.TabWidth] cells, and the column is reset to 0 after each line
+// break. If TabWidth is not positive, the string is returned unchanged.
+func (c *WidthConfig) ExpandTabs(str string, column int) string {
+	if c.TabWidth <= 0 || strings.IndexByte(str, '\t') < 0 {
+		return str
+	}
+
+	var (
+		expanded strings.Builder
+		cluster  string
+		width    int
+	)
+	expanded.Grow(len(str))
+	state := -1
+	for len(str) > 0 {
+		cluster, str, width, state = c.FirstGraphemeClusterInStringAt(str, state, column)
+		if cluster == "\t" {
+			expanded.WriteString(strings.Repeat(" ", width))
+		} else {
+			expanded.WriteString(cluster)
+		}
+		column += width
+
+		// Start a new line after line breaks.
+		r, _ := utf8.DecodeRuneInString(cluster)
+		switch prop, _ := propertyLineBreak(r); prop {
+		case prBK, prCR, prLF, prNL:
+			column = 0
+		}
+	}
+
+	return expanded.String()
+}
+
+// ExpandTabs replaces all horizontal tabs in the given string with spaces,
+// assuming tab stops every "tabWidth" cells and a string which starts at the
+// given column. Widths are calculated as in [StringWidth]. See
+// [WidthConfig.ExpandTabs] for details.
+func ExpandTabs(str string, tabWidth, column int) string {
+	config := NewWidthConfig()
+	config.EastAsianAmbiguousWidth = EastAsianAmbiguousWidth
+	config.TabWidth = tabWidth
+	return config.ExpandTabs(str, column)
+}
+
+// clusterWidthAt returns the width of the given grapheme cluster if it starts
+// at the given column, "width" being its width as returned by
+// [firstGraphemeCluster]. Only horizontal tabs are affected, and only if
+// [WidthConfig.TabWidth] is positive. The width configuration may be nil.
+func clusterWidthAt(cluster string, width, column int, config *WidthConfig) int {
+	if config != nil && config.TabWidth > 0 && cluster == "\t" {
+		return tabWidth(config.TabWidth, column)
+	}
+	return width
+}
+
+// stringWidthAt is like [WidthConfig.StringWidthAt] but the width
+// configuration may be nil.
+func stringWidthAt(str string, column int, config *WidthConfig) int {
+	if config == nil {
+		return StringWidth(str)
+	}
+	return config.StringWidthAt(str, column)
+}
+
+// tabWidth returns the number of cells from the given column to the next tab
+// stop, given the distance between tab stops.
+func tabWidth(tabStop, column int) int {
+	if column < 0 {
+		return tabStop
+	}
+	return tabStop - column%tabStop
+}
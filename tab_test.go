@@ -0,0 +1,98 @@
+package uniseg
+
+import "testing"
+
+// Test cases for tab expansion.
+var tabTestCases = []struct {
+	original string
+	tabWidth int
+	column   int
+	expanded string
+	width    int
+}{
+	{"", 8, 0, "", 0},
+	{"abc", 8, 0, "abc", 3},
+	{"\t", 8, 0, "        ", 8},
+	{"\t", 8, 3, "     ", 5},
+	{"\t", 8, 8, "        ", 8},
+	{"a\tb", 4, 0, "a   b", 5},
+	{"abcd\tb", 4, 0, "abcd    b", 9},
+	{"a\tb", 4, 2, "a b", 3},
+	{"世界\t!", 4, 0, "世界    !", 9},
+	{"世\t!", 4, 1, "世 !", 4},
+	{"🇩🇪\t\t", 8, 0, "🇩🇪              ", 16},
+	{"ä\tb", 4, 0, "ä   b", 5},
+	{"\ta\n\tb", 4, 2, "  a\n    b", -1}, // Multiple lines, width is not tested.
+	{"ab\r\n\tc", 4, 0, "ab\r\n    c", -1},
+	{"a\tb", 0, 0, "a\tb", 2},
+}
+
+// Test the expansion of tabs and column-based width calculation.
+func TestExpandTabs(t *testing.T) {
+	for index, testCase := range tabTestCases {
+		if expanded := ExpandTabs(testCase.original, testCase.tabWidth, testCase.column); expanded != testCase.expanded {
+			t.Errorf(`Test case %d %q failed: Expected expanded string %q, got %q`, index, testCase.original, testCase.expanded, expanded)
+		}
+		if testCase.width < 0 {
+			continue
+		}
+		config := NewWidthConfig()
+		config.TabWidth = testCase.tabWidth
+		if width := config.StringWidthAt(testCase.original, testCase.column); width != testCase.width {
+			t.Errorf(`Test case %d %q failed: Expected width %d, got %d`, index, testCase.original, testCase.width, width)
+		}
+	}
+}
+
+// Test column tracking with the "At" functions.
+func TestFirstGraphemeClusterAt(t *testing.T) {
+	config := NewWidthConfig()
+	config.TabWidth = 8
+	str := "a\tb世\tc"
+	expected := []struct {
+		cluster       string
+		column, width int
+	}{
+		{"a", 5, 1},
+		{"\t", 6, 2},
+		{"b", 8, 1},
+		{"世", 9, 2},
+		{"\t", 11, 5},
+		{"c", 16, 1},
+	}
+
+	// Strings.
+	column, state, remaining := 5, -1, str
+	for index := 0; len(remaining) > 0; index++ {
+		var (
+			cluster string
+			width   int
+		)
+		cluster, remaining, width, state = config.FirstGraphemeClusterInStringAt(remaining, state, column)
+		if index >= len(expected) {
+			t.Fatalf(`Unexpected cluster %q`, cluster)
+		}
+		if cluster != expected[index].cluster || column != expected[index].column || width != expected[index].width {
+			t.Errorf(`Cluster %d failed: Expected %q at column %d, width %d, got %q at column %d, width %d`, index, expected[index].cluster, expected[index].column, expected[index].width, cluster, column, width)
+		}
+		column += width
+	}
+
+	// Byte slices.
+	column, state = 5, -1
+	b := []byte(str)
+	for index := 0; len(b) > 0; index++ {
+		var (
+			cluster []byte
+			width   int
+		)
+		cluster, b, width, state = config.FirstGraphemeClusterAt(b, state, column)
+		if index >= len(expected) {
+			t.Fatalf(`Unexpected cluster %q`, cluster)
+		}
+		if string(cluster) != expected[index].cluster || column != expected[index].column || width != expected[index].width {
+			t.Errorf(`Cluster %d failed: Expected %q at column %d, width %d, got %q at column %d, width %d`, index, expected[index].cluster, expected[index].column, expected[index].width, cluster, column, width)
+		}
+		column += width
+	}
+}
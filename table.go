@@ -0,0 +1,256 @@
+package uniseg
+
+import "strings"
+
+// Alignment specifies how text is aligned within a table column.
+type Alignment int
+
+// The available alignments.
+const (
+	AlignLeft Alignment = iota
+	AlignRight
+	AlignCenter
+)
+
+// TableColumn specifies the layout of one column of a table rendered with
+// [RenderTable].
+type TableColumn struct {
+	// The minimum and maximum width of the column, in cells. A value of 0 means
+	// that there is no constraint. Cell contents are wrapped to fit into the
+	// column. A column is never narrower than its widest grapheme cluster,
+	// however.
+	MinWidth, MaxWidth int
+
+	// The alignment of the column's text.
+	Align Alignment
+}
+
+// TableOptions specifies how [RenderTable] lays out a table. The zero value (or
+// a nil pointer) results in the default behaviour.
+type TableOptions struct {
+	// The layout of the individual columns. Columns without an entry here use
+	// the default layout (no width constraints, left-aligned).
+	Columns []TableColumn
+
+	// The maximum total width of the table, in cells, including separators. If
+	// 0 or less, the table's width is not limited. If the table's columns
+	// cannot be made narrow enough, the table will be wider than this.
+	Width int
+
+	// The string placed between columns. If empty, a single space is used.
+	Separator string
+
+	// The width configuration used to measure and wrap cell contents. If nil,
+	// the package defaults apply.
+	WidthConfig *WidthConfig
+}
+
+// TableColumnWidths returns the widths of the columns of a table with the given
+// rows of cells, as used by [RenderTable].
+//
+// Each column's preferred width is the width of its widest cell (or the widest
+// line of a cell containing line breaks). If the table would exceed
+// [TableOptions.Width], columns are narrowed, but not below the width of their
+// longest word, i.e. the widest segment between two line break opportunities.
+// The space in excess of these minimum widths is distributed in proportion to
+// how much each column would need to reach its preferred width. Only if the
+// minimum widths don't fit either, columns are narrowed further, in proportion
+// to their minimum widths. All widths are subject to [TableColumn.MinWidth]
+// and [TableColumn.MaxWidth].
+func TableColumnWidths(rows [][]string, options *TableOptions) []int {
+	if options == nil {
+		options = &TableOptions{}
+	}
+	var columns int
+	for _, row := range rows {
+		if len(row) > columns {
+			columns = len(row)
+		}
+	}
+
+	// Determine the preferred and minimum widths of each column.
+	preferred := make([]int, columns)
+	minimum := make([]int, columns)
+	limit := make([]int, columns) // Columns can't be narrower than their widest grapheme cluster.
+	for _, row := range rows {
+		for index, cell := range row {
+			for _, line := range WrapString(cell, 0, &WrapOptions{WidthConfig: options.WidthConfig}) {
+				if width := stringWidth(line, options.WidthConfig); width > preferred[index] {
+					preferred[index] = width
+				}
+			}
+			for _, word := range WrapString(cell, 1, &WrapOptions{AllowOverflow: true, WidthConfig: options.WidthConfig}) {
+				if width := stringWidth(word, options.WidthConfig); width > minimum[index] {
+					minimum[index] = width
+				}
+			}
+			state := -1
+			for len(cell) > 0 {
+				var width int
+				_, cell, width, state = firstGraphemeCluster(cell, state, options.WidthConfig)
+				if width > limit[index] {
+					limit[index] = width
+				}
+			}
+		}
+	}
+	for index := range preferred {
+		column := options.column(index)
+		if column.MaxWidth > 0 {
+			if preferred[index] > column.MaxWidth {
+				preferred[index] = column.MaxWidth
+			}
+			if minimum[index] > column.MaxWidth {
+				minimum[index] = column.MaxWidth
+			}
+		}
+		if preferred[index] < column.MinWidth {
+			preferred[index] = column.MinWidth
+		}
+		if minimum[index] < column.MinWidth {
+			minimum[index] = column.MinWidth
+		}
+		if limit[index] < column.MinWidth {
+			limit[index] = column.MinWidth
+		}
+		if minimum[index] < limit[index] {
+			minimum[index] = limit[index]
+		}
+		if preferred[index] < minimum[index] {
+			preferred[index] = minimum[index]
+		}
+	}
+
+	// Do we need to narrow the table?
+	if options.Width <= 0 || columns == 0 {
+		return preferred
+	}
+	available := options.Width - (columns-1)*stringWidth(options.separator(), options.WidthConfig)
+	var preferredTotal, minimumTotal int
+	for index := range preferred {
+		preferredTotal += preferred[index]
+		minimumTotal += minimum[index]
+	}
+	if preferredTotal <= available {
+		return preferred
+	}
+
+	widths := make([]int, columns)
+	if minimumTotal <= available {
+		// Distribute the remaining space in proportion to the columns' needs.
+		excess, need := available-minimumTotal, preferredTotal-minimumTotal
+		remaining := excess
+		for index := range widths {
+			extra := (preferred[index] - minimum[index]) * excess / need
+			widths[index] = minimum[index] + extra
+			remaining -= extra
+		}
+		for index := 0; remaining > 0; index = (index + 1) % columns {
+			if widths[index] < preferred[index] {
+				widths[index]++
+				remaining--
+			}
+		}
+		return widths
+	}
+
+	// Even the minimum widths don't fit. Shrink them proportionally. If not even
+	// the separators fit, the columns get as narrow as possible.
+	for index := range widths {
+		if available > 0 {
+			widths[index] = minimum[index] * available / minimumTotal
+		}
+		if widths[index] < limit[index] {
+			widths[index] = limit[index]
+		}
+	}
+	return widths
+}
+
+// RenderTable lays out the given rows of cells as a table in a monospace font
+// and returns its lines. Column widths are determined by [TableColumnWidths].
+// The contents of each cell are wrapped to the width of its column at line
+// break opportunities according to [UAX #14] (see [WrapString]) and aligned as
+// specified by [TableColumn.Align]. Rows may have different numbers of cells;
+// missing cells are treated as empty. A row occupies as many lines as its
+// tallest cell.
+//
+// All returned lines have the same width. Cells are padded with spaces, and
+// columns are separated by [TableOptions.Separator]. If
+// [WidthConfig.TabWidth] is positive, horizontal tabs in cells are replaced
+// with spaces, with tab stops counted from the start of each cell. The lines are wider than
+// [TableOptions.Width] if the columns cannot be narrowed enough, e.g. when the
+// separators alone exceed it. The options may be nil.
+//
+// [UAX #14]: https://www.unicode.org/reports/tr14/
+func RenderTable(rows [][]string, options *TableOptions) (lines []string) {
+	if options == nil {
+		options = &TableOptions{}
+	}
+	widths := TableColumnWidths(rows, options)
+	separator := options.separator()
+
+	cellLines := make([][]string, len(widths))
+	for _, row := range rows {
+		// Wrap all cells of this row.
+		var height int
+		for index, width := range widths {
+			cellLines[index] = nil
+			if index < len(row) {
+				cellLines[index] = WrapString(row[index], width, &WrapOptions{WidthConfig: options.WidthConfig})
+			}
+			if len(cellLines[index]) > height {
+				height = len(cellLines[index])
+			}
+		}
+		if height == 0 {
+			height = 1 // Empty rows still occupy a line.
+		}
+
+		// Render the lines of this row.
+		for lineIndex := 0; lineIndex < height; lineIndex++ {
+			var line strings.Builder
+			for index, width := range widths {
+				if index > 0 {
+					line.WriteString(separator)
+				}
+				var text string
+				if lineIndex < len(cellLines[index]) {
+					text = cellLines[index][lineIndex]
+				}
+				if options.WidthConfig != nil {
+					// Tab stops are relative to the cell, so tabs can't be kept.
+					text = options.WidthConfig.ExpandTabs(text, 0)
+				}
+				switch options.column(index).Align {
+				case AlignRight:
+					text = padLeft(text, width, options.WidthConfig)
+				case AlignCenter:
+					text = center(text, width, options.WidthConfig)
+				default:
+					text = padRight(text, width, options.WidthConfig)
+				}
+				line.WriteString(text)
+			}
+			lines = append(lines, line.String())
+		}
+	}
+
+	return
+}
+
+// column returns the layout of the column with the given index.
+func (o *TableOptions) column(index int) TableColumn {
+	if index < len(o.Columns) {
+		return o.Columns[index]
+	}
+	return TableColumn{}
+}
+
+// separator returns the string placed between columns.
+func (o *TableOptions) separator() string {
+	if o.Separator == "" {
+		return " "
+	}
+	return o.Separator
+}
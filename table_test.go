@@ -0,0 +1,123 @@
+package uniseg
+
+import (
+	"strings"
+	"testing"
+)
+
+// Test cases for table layout.
+var tableTestCases = []struct {
+	rows     [][]string
+	options  *TableOptions
+	widths   []int
+	expected []string
+}{
+	{
+		rows:     nil,
+		widths:   []int{},
+		expected: nil,
+	},
+	{
+		rows:     [][]string{{"a", "bb"}, {"ccc", "d"}},
+		widths:   []int{3, 2},
+		expected: []string{"a   bb", "ccc d "},
+	},
+	{
+		rows:     [][]string{{"Name", "Country"}, {"Käse", "🇩🇪"}, {"世界"}},
+		options:  &TableOptions{Separator: " | "},
+		widths:   []int{4, 7},
+		expected: []string{"Name | Country", "Käse | 🇩🇪     ", "世界 |        "},
+	},
+	{
+		rows:     [][]string{{"Item", "Price"}, {"Coffee", "3.50"}, {"Tea", "12.00"}},
+		options:  &TableOptions{Columns: []TableColumn{{}, {Align: AlignRight}}},
+		widths:   []int{6, 5},
+		expected: []string{"Item   Price", "Coffee  3.50", "Tea    12.00"},
+	},
+	{
+		rows:     [][]string{{"a", "b", "c"}},
+		options:  &TableOptions{Columns: []TableColumn{{MinWidth: 3, Align: AlignCenter}, {MinWidth: 2}, {MinWidth: 4, Align: AlignRight}}},
+		widths:   []int{3, 2, 4},
+		expected: []string{" a  b     c"},
+	},
+	{
+		rows:     [][]string{{"ID", "Description"}, {"1", "The quick brown fox jumps over the lazy dog."}},
+		options:  &TableOptions{Width: 20},
+		widths:   []int{2, 17},
+		expected: []string{"ID Description      ", "1  The quick brown  ", "   fox jumps over   ", "   the lazy dog.    "},
+	},
+	{
+		rows:     [][]string{{"ID", "Description"}, {"1", "The quick brown fox jumps over the lazy dog."}},
+		options:  &TableOptions{Columns: []TableColumn{{}, {MaxWidth: 10}}},
+		widths:   []int{2, 10},
+		expected: []string{"ID Descriptio", "   n         ", "1  The quick ", "   brown fox ", "   jumps over", "   the lazy  ", "   dog.      "},
+	},
+	{
+		rows:     [][]string{{"aaaa bbbb", "cc dd ee ff"}},
+		options:  &TableOptions{Width: 12},
+		widths:   []int{6, 5},
+		expected: []string{"aaaa   cc dd", "bbbb   ee ff"},
+	},
+	{
+		rows:     [][]string{{"aaaaaa", "bbbbbb"}},
+		options:  &TableOptions{Width: 7},
+		widths:   []int{3, 3},
+		expected: []string{"aaa bbb", "aaa bbb"},
+	},
+	{
+		rows:     [][]string{{"世界世界", "x"}},
+		options:  &TableOptions{Width: 2},
+		widths:   []int{2, 1},
+		expected: []string{"世 x", "界  ", "世  ", "界  "},
+	},
+	{
+		rows:     [][]string{{"First\nSecond line", "x"}, {}},
+		widths:   []int{11, 1},
+		expected: []string{"First       x", "Second line  ", "             "},
+	},
+	{
+		rows:     [][]string{{"", "", ""}},
+		options:  &TableOptions{Width: 1, Separator: " | "},
+		widths:   []int{0, 0, 0},
+		expected: []string{" |  | "},
+	},
+	{
+		rows:     [][]string{{"ab", "cd"}},
+		options:  &TableOptions{Width: 2, Separator: " | "},
+		widths:   []int{1, 1},
+		expected: []string{"a | c", "b | d"},
+	},
+	{
+		rows:     [][]string{{"x", "a\tb"}, {"y", "abcde\tf"}},
+		options:  &TableOptions{Separator: "|", WidthConfig: &WidthConfig{TabWidth: 4}},
+		widths:   []int{1, 9},
+		expected: []string{"x|a   b    ", "y|abcde   f"},
+	},
+}
+
+// Test the layout of tables.
+func TestRenderTable(t *testing.T) {
+	for index, testCase := range tableTestCases {
+		widths := TableColumnWidths(testCase.rows, testCase.options)
+		if len(widths) != len(testCase.widths) {
+			t.Errorf(`Test case %d failed: Expected column widths %v, got %v`, index, testCase.widths, widths)
+		} else {
+			for column := range widths {
+				if widths[column] != testCase.widths[column] {
+					t.Errorf(`Test case %d failed: Expected column widths %v, got %v`, index, testCase.widths, widths)
+					break
+				}
+			}
+		}
+		lines := RenderTable(testCase.rows, testCase.options)
+		if strings.Join(lines, "\n") != strings.Join(testCase.expected, "\n") || len(lines) != len(testCase.expected) {
+			t.Errorf(`Test case %d failed: Expected lines %q, got %q`, index, testCase.expected, lines)
+		}
+		for _, line := range lines {
+			if StringWidth(line) != StringWidth(lines[0]) {
+				t.Errorf(`Test case %d failed: Lines have different widths: %q`, index, lines)
+				break
+			}
+		}
+	}
+}
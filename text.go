@@ -0,0 +1,90 @@
+package uniseg
+
+import "unicode/utf8"
+
+// text is the set of text representations which the state machines of this
+// package can look into when they need to examine code points beyond the
+// current one.
+type text interface {
+	[]byte | string | []rune | []uint16
+}
+
+// decodeText returns the first code point of the given text and the text
+// following it. If the text is empty or starts with an invalid encoding,
+// [utf8.RuneError] is returned.
+func decodeText[T text](t T) (r rune, rest T) {
+	var length int
+	switch u := any(t).(type) {
+	case []byte:
+		r, length = utf8.DecodeRune(u)
+		return r, any(u[length:]).(T)
+	case string:
+		r, length = utf8.DecodeRuneInString(u)
+		return r, any(u[length:]).(T)
+	case []rune:
+		if len(u) == 0 {
+			return utf8.RuneError, t
+		}
+		return u[0], any(u[1:]).(T)
+	case []uint16:
+		r, length = decodeUTF16(u)
+		return r, any(u[length:]).(T)
+	}
+	return utf8.RuneError, t
+}
+
+// splitText splits the given text into its first "length" code units and the
+// remaining code units. Code units are bytes for []byte and string, runes for
+// []rune, and uint16 values for []uint16.
+func splitText[T text](t T, length int) (prefix, rest T) {
+	switch u := any(t).(type) {
+	case []byte:
+		return any(u[:length]).(T), any(u[length:]).(T)
+	case string:
+		return any(u[:length]).(T), any(u[length:]).(T)
+	case []rune:
+		return any(u[:length]).(T), any(u[length:]).(T)
+	case []uint16:
+		return any(u[:length]).(T), any(u[length:]).(T)
+	}
+	return t, rest
+}
+
+// decodeLastText returns the last code point of the given text and the text
+// preceding it. If the text is empty or ends with an invalid encoding,
+// [utf8.RuneError] is returned.
+func decodeLastText[T text](t T) (r rune, rest T) {
+	var length int
+	switch u := any(t).(type) {
+	case []byte:
+		r, length = utf8.DecodeLastRune(u)
+		return r, any(u[:len(u)-length]).(T)
+	case string:
+		r, length = utf8.DecodeLastRuneInString(u)
+		return r, any(u[:len(u)-length]).(T)
+	case []rune:
+		if len(u) == 0 {
+			return utf8.RuneError, t
+		}
+		return u[len(u)-1], any(u[:len(u)-1]).(T)
+	case []uint16:
+		r, length = decodeLastUTF16(u)
+		return r, any(u[:len(u)-length]).(T)
+	}
+	return utf8.RuneError, t
+}
+
+// textRuneStart returns true if the code unit at the given index of the text
+// may be the first code unit of a code point, i.e. if it is not a UTF-8
+// continuation byte or a UTF-16 low surrogate.
+func textRuneStart[T text](t T, index int) bool {
+	switch u := any(t).(type) {
+	case []byte:
+		return utf8.RuneStart(u[index])
+	case string:
+		return utf8.RuneStart(u[index])
+	case []uint16:
+		return u[index] < 0xdc00 || u[index] > 0xdfff
+	}
+	return true
+}
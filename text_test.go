@@ -0,0 +1,153 @@
+package uniseg
+
+import "testing"
+
+// encodingFuncs holds the segmentation functions for one text representation
+// and the functions to convert it to and from strings.
+type encodingFuncs[T text] struct {
+	// The name of the representation, used in error messages.
+	name string
+
+	encode func(string) T
+	decode func(T) string
+
+	step                 func(T, int) (T, T, int, int)
+	firstGraphemeCluster func(T, int) (T, T, int, int)
+	firstWord            func(T, int) (T, T, int)
+	firstSentence        func(T, int) (T, T, int)
+	firstLineSegment     func(T, int) (T, T, bool, int)
+}
+
+// testEncodingConformance tests the segmentation functions of a text
+// representation against their UTF-8 counterparts, using the official Unicode
+// test cases.
+func testEncodingConformance[T text](t *testing.T, funcs encodingFuncs[T]) {
+	var testCases []testCase
+	testCases = append(testCases, graphemeBreakTestCases...)
+	testCases = append(testCases, wordBreakTestCases...)
+	testCases = append(testCases, sentenceBreakTestCases...)
+	testCases = append(testCases, lineBreakTestCases...)
+	for testNum, testCase := range testCases {
+		encoded := funcs.encode(testCase.original)
+
+		// Step.
+		b, stateBytes, stateEncoded := []byte(testCase.original), -1, -1
+		for rest := encoded; len(b) > 0 || len(rest) > 0; {
+			var (
+				c                                  []byte
+				ce                                 T
+				boundariesBytes, boundariesEncoded int
+			)
+			c, b, boundariesBytes, stateBytes = Step(b, stateBytes)
+			ce, rest, boundariesEncoded, stateEncoded = funcs.step(rest, stateEncoded)
+			if funcs.decode(ce) != string(c) || boundariesEncoded != boundariesBytes || stateEncoded != stateBytes {
+				t.Errorf(`Test case %d %q failed: Expected cluster %q (boundaries %x, state %x), got %q (boundaries %x, state %x) (Step, %s)`, testNum, testCase.original, c, boundariesBytes, stateBytes, funcs.decode(ce), boundariesEncoded, stateEncoded, funcs.name)
+				break
+			}
+		}
+
+		// Grapheme clusters.
+		str, stateString := testCase.original, -1
+		stateEncoded = -1
+		for rest := encoded; len(str) > 0 || len(rest) > 0; {
+			var (
+				c                         string
+				ce                        T
+				widthString, widthEncoded int
+			)
+			c, str, widthString, stateString = FirstGraphemeClusterInString(str, stateString)
+			ce, rest, widthEncoded, stateEncoded = funcs.firstGraphemeCluster(rest, stateEncoded)
+			if funcs.decode(ce) != c || widthEncoded != widthString || stateEncoded != stateString {
+				t.Errorf(`Test case %d %q failed: Expected cluster %q (width %d), got %q (width %d) (FirstGraphemeCluster, %s)`, testNum, testCase.original, c, widthString, funcs.decode(ce), widthEncoded, funcs.name)
+				break
+			}
+		}
+
+		// Words.
+		str, stateString, stateEncoded = testCase.original, -1, -1
+		for rest := encoded; len(str) > 0 || len(rest) > 0; {
+			var (
+				w  string
+				we T
+			)
+			w, str, stateString = FirstWordInString(str, stateString)
+			we, rest, stateEncoded = funcs.firstWord(rest, stateEncoded)
+			if funcs.decode(we) != w || stateEncoded != stateString {
+				t.Errorf(`Test case %d %q failed: Expected word %q, got %q (%s)`, testNum, testCase.original, w, funcs.decode(we), funcs.name)
+				break
+			}
+		}
+
+		// Sentences.
+		str, stateString, stateEncoded = testCase.original, -1, -1
+		for rest := encoded; len(str) > 0 || len(rest) > 0; {
+			var (
+				s  string
+				se T
+			)
+			s, str, stateString = FirstSentenceInString(str, stateString)
+			se, rest, stateEncoded = funcs.firstSentence(rest, stateEncoded)
+			if funcs.decode(se) != s || stateEncoded != stateString {
+				t.Errorf(`Test case %d %q failed: Expected sentence %q, got %q (%s)`, testNum, testCase.original, s, funcs.decode(se), funcs.name)
+				break
+			}
+		}
+
+		// Line segments.
+		str, stateString, stateEncoded = testCase.original, -1, -1
+		for rest := encoded; len(str) > 0 || len(rest) > 0; {
+			var (
+				l                          string
+				le                         T
+				mustBreakString, mustBreak bool
+			)
+			l, str, mustBreakString, stateString = FirstLineSegmentInString(str, stateString)
+			le, rest, mustBreak, stateEncoded = funcs.firstLineSegment(rest, stateEncoded)
+			if funcs.decode(le) != l || mustBreak != mustBreakString || stateEncoded != stateString {
+				t.Errorf(`Test case %d %q failed: Expected line segment %q, got %q (%s)`, testNum, testCase.original, l, funcs.decode(le), funcs.name)
+				break
+			}
+		}
+	}
+}
+
+// encodingOffsetTestCase is a test case for [testEncodingOffsets].
+type encodingOffsetTestCase[T text] struct {
+	original T
+	expected []int // The lengths of the grapheme clusters, in code units.
+	width    int
+}
+
+// testEncodingOffsets tests that the grapheme cluster function of a text
+// representation returns clusters measured in its code units.
+func testEncodingOffsets[T text](t *testing.T, firstGraphemeCluster func(T, int) (T, T, int, int), testCases []encodingOffsetTestCase[T]) {
+	for index, testCase := range testCases {
+		var (
+			lengths []int
+			width   int
+		)
+		state, rest := -1, testCase.original
+		for len(rest) > 0 {
+			var (
+				cluster T
+				w       int
+			)
+			cluster, rest, w, state = firstGraphemeCluster(rest, state)
+			lengths = append(lengths, len(cluster))
+			width += w
+		}
+		if len(lengths) != len(testCase.expected) {
+			t.Errorf(`Test case %d %x failed: Expected cluster lengths %v, got %v`, index, testCase.original, testCase.expected, lengths)
+			continue
+		}
+		for i := range lengths {
+			if lengths[i] != testCase.expected[i] {
+				t.Errorf(`Test case %d %x failed: Expected cluster lengths %v, got %v`, index, testCase.original, testCase.expected, lengths)
+				break
+			}
+		}
+		if width != testCase.width {
+			t.Errorf(`Test case %d %x failed: Expected width %d, got %d`, index, testCase.original, testCase.width, width)
+		}
+	}
+}
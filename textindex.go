@@ -0,0 +1,227 @@
+package uniseg
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// TextIndex maps between byte offsets, rune (code point) offsets, grapheme
+// cluster indices, and monospace display columns of a string. It is built with
+// one pass over the string (see [NewTextIndex]), after which all lookups take
+// O(log n) time, where n is the number of grapheme clusters. This is useful for
+// text editors and terminal user interfaces which need to position cursors or
+// determine which character was clicked on.
+//
+// Grapheme cluster indices range from 0 to [TextIndex.Len]. The index
+// [TextIndex.Len] refers to the end of the string. The string is treated as a
+// single line, i.e. columns are not reset after line breaks.
+//
+// A TextIndex does not change once it was created. If the underlying string
+// changes, a new TextIndex must be built.
+type TextIndex struct {
+	// The original string.
+	str string
+
+	// The byte offsets, rune offsets, and columns of the beginning of each
+	// grapheme cluster, with one additional element for the end of the string.
+	bytes, runes, columns []int
+}
+
+// NewTextIndex builds an index for the given string. Widths are calculated as
+// in [StringWidth].
+func NewTextIndex(str string) *TextIndex {
+	return newTextIndex(str, nil)
+}
+
+// NewTextIndex is like the package-level function [NewTextIndex] but uses this
+// configuration to calculate widths. If [WidthConfig.TabWidth] is positive,
+// tabs extend to the next tab stop.
+func (c *WidthConfig) NewTextIndex(str string) *TextIndex {
+	return newTextIndex(str, c)
+}
+
+// newTextIndex builds an index for the given string, using the given width
+// configuration, which may be nil.
+func newTextIndex(str string, config *WidthConfig) *TextIndex {
+	index := &TextIndex{
+		str:     str,
+		bytes:   make([]int, 0, len(str)/2+1),
+		runes:   make([]int, 0, len(str)/2+1),
+		columns: make([]int, 0, len(str)/2+1),
+	}
+
+	var (
+		cluster                               string
+		width, byteOffset, runeOffset, column int
+	)
+	state, remaining := -1, str
+	for len(remaining) > 0 {
+		index.bytes = append(index.bytes, byteOffset)
+		index.runes = append(index.runes, runeOffset)
+		index.columns = append(index.columns, column)
+		if config != nil {
+			cluster, remaining, width, state = config.FirstGraphemeClusterInStringAt(remaining, state, column)
+		} else {
+			cluster, remaining, width, state = FirstGraphemeClusterInString(remaining, state)
+		}
+		byteOffset += len(cluster)
+		runeOffset += utf8.RuneCountInString(cluster)
+		column += width
+	}
+	index.bytes = append(index.bytes, byteOffset)
+	index.runes = append(index.runes, runeOffset)
+	index.columns = append(index.columns, column)
+
+	return index
+}
+
+// String returns the string this index was built for.
+func (t *TextIndex) String() string {
+	return t.str
+}
+
+// Len returns the number of grapheme clusters in the string.
+func (t *TextIndex) Len() int {
+	return len(t.bytes) - 1
+}
+
+// Width returns the monospace width of the entire string.
+func (t *TextIndex) Width() int {
+	return t.columns[len(t.columns)-1]
+}
+
+// Grapheme returns the grapheme cluster with the given index and its width. If
+// the index is out of range, an empty string and a width of 0 are returned.
+func (t *TextIndex) Grapheme(index int) (cluster string, width int) {
+	if index < 0 || index >= t.Len() {
+		return "", 0
+	}
+	return t.str[t.bytes[index]:t.bytes[index+1]], t.columns[index+1] - t.columns[index]
+}
+
+// GraphemeStart returns the byte offset, the rune offset, and the column at
+// which the grapheme cluster with the given index starts. An index of
+// [TextIndex.Len] returns the positions of the end of the string. Indices out
+// of range are clamped to this range.
+func (t *TextIndex) GraphemeStart(index int) (byteOffset, runeOffset, column int) {
+	index = t.clamp(index)
+	return t.bytes[index], t.runes[index], t.columns[index]
+}
+
+// GraphemeAtByte returns the index of the grapheme cluster which contains the
+// byte at the given offset. If the offset is negative, 0 is returned. If it is
+// at or beyond the end of the string, [TextIndex.Len] is returned.
+func (t *TextIndex) GraphemeAtByte(byteOffset int) int {
+	return t.search(t.bytes, byteOffset)
+}
+
+// GraphemeAtRune returns the index of the grapheme cluster which contains the
+// rune at the given rune offset. If the offset is negative, 0 is returned. If
+// it is at or beyond the end of the string, [TextIndex.Len] is returned.
+func (t *TextIndex) GraphemeAtRune(runeOffset int) int {
+	return t.search(t.runes, runeOffset)
+}
+
+// GraphemeAtColumn returns the index of the grapheme cluster which occupies the
+// cell at the given column. Wide characters occupy multiple cells, i.e. a
+// column pointing to the second half of a wide character returns that
+// character's index (use [TextIndex.GraphemeStart] to determine the column at
+// which it starts). Zero-width clusters never occupy a cell. If the column is
+// negative, 0 is returned. If it is at or beyond the width of the string,
+// [TextIndex.Len] is returned.
+func (t *TextIndex) GraphemeAtColumn(column int) int {
+	return t.search(t.columns, column)
+}
+
+// CursorAtColumn returns the index of the grapheme cluster boundary closest to
+// the given column, for example to place a cursor after a mouse click. A
+// cursor at index i is located before the grapheme cluster with index i (or at
+// the end of the string, if i is [TextIndex.Len]). Clicks on the left half of
+// a character place the cursor before it, clicks on the right half (e.g. the
+// second cell of a wide character) place it after the character.
+func (t *TextIndex) CursorAtColumn(column int) int {
+	index := t.GraphemeAtColumn(column)
+	if index >= t.Len() || column < 0 {
+		return index
+	}
+	if width := t.columns[index+1] - t.columns[index]; 2*(column-t.columns[index]) >= width {
+		index++
+	}
+	return index
+}
+
+// ByteToRune returns the rune offset of the rune which contains the byte at the
+// given offset. Offsets out of range are clamped to the range from 0 to the
+// length of the string.
+func (t *TextIndex) ByteToRune(byteOffset int) int {
+	index := t.GraphemeAtByte(byteOffset)
+	if index >= t.Len() || byteOffset <= t.bytes[index] {
+		return t.runes[index]
+	}
+
+	// Count runes within the cluster.
+	runeOffset := t.runes[index]
+	for position := t.bytes[index]; position < len(t.str); runeOffset++ {
+		_, length := utf8.DecodeRuneInString(t.str[position:])
+		if position+length > byteOffset {
+			break
+		}
+		position += length
+	}
+	return runeOffset
+}
+
+// RuneToByte returns the byte offset of the rune at the given rune offset.
+// Offsets out of range are clamped to the range from 0 to the number of runes
+// in the string.
+func (t *TextIndex) RuneToByte(runeOffset int) int {
+	index := t.GraphemeAtRune(runeOffset)
+	byteOffset := t.bytes[index]
+	if index >= t.Len() {
+		return byteOffset
+	}
+	for count := runeOffset - t.runes[index]; count > 0; count-- {
+		_, length := utf8.DecodeRuneInString(t.str[byteOffset:])
+		byteOffset += length
+	}
+	return byteOffset
+}
+
+// ByteToColumn returns the column at which the grapheme cluster containing the
+// byte at the given offset starts.
+func (t *TextIndex) ByteToColumn(byteOffset int) int {
+	return t.columns[t.GraphemeAtByte(byteOffset)]
+}
+
+// ColumnToByte returns the byte offset of the grapheme cluster which occupies
+// the cell at the given column (see [TextIndex.GraphemeAtColumn]).
+func (t *TextIndex) ColumnToByte(column int) int {
+	return t.bytes[t.GraphemeAtColumn(column)]
+}
+
+// search returns the index of the last grapheme cluster whose start position
+// in the given slice is at or before the given position, or Len() if the
+// position is at or beyond the end.
+func (t *TextIndex) search(positions []int, position int) int {
+	if position < 0 {
+		return 0
+	}
+	last := len(positions) - 1
+	if position >= positions[last] {
+		return last
+	}
+	return sort.Search(last, func(index int) bool {
+		return positions[index] > position
+	}) - 1
+}
+
+// clamp clamps the given grapheme cluster index to the range from 0 to Len().
+func (t *TextIndex) clamp(index int) int {
+	if index < 0 {
+		return 0
+	}
+	if index > t.Len() {
+		return t.Len()
+	}
+	return index
+}
@@ -0,0 +1,159 @@
+package uniseg
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+// Test the text index against a brute-force calculation.
+func TestTextIndexBruteForce(t *testing.T) {
+	for testNum, str := range []string{
+		"",
+		"Hello, world!",
+		"Käse",
+		"世界, 🇩🇪🏳️‍🌈!",
+		"ä́b\r\nc\t世",
+		"\U0001f469\U0001f3fd‍\U0001f4bb x",
+	} {
+		index := NewTextIndex(str)
+
+		// Determine the expected values.
+		var (
+			bytes, runes, columns, widths  []int
+			byteOffset, runeOffset, column int
+		)
+		g := NewGraphemes(str)
+		for g.Next() {
+			bytes = append(bytes, byteOffset)
+			runes = append(runes, runeOffset)
+			columns = append(columns, column)
+			widths = append(widths, g.Width())
+			byteOffset += len(g.Str())
+			runeOffset += len(g.Runes())
+			column += g.Width()
+		}
+		if index.Len() != len(bytes) {
+			t.Fatalf(`Test case %d %q failed: Expected %d clusters, got %d`, testNum, str, len(bytes), index.Len())
+		}
+		if index.Width() != column || index.Width() != StringWidth(str) {
+			t.Errorf(`Test case %d %q failed: Expected width %d, got %d`, testNum, str, column, index.Width())
+		}
+		if index.String() != str {
+			t.Errorf(`Test case %d %q failed: String() returned %q`, testNum, str, index.String())
+		}
+
+		for cluster := range bytes {
+			b, r, c := index.GraphemeStart(cluster)
+			if b != bytes[cluster] || r != runes[cluster] || c != columns[cluster] {
+				t.Errorf(`Test case %d %q failed: Cluster %d starts at %d/%d/%d, expected %d/%d/%d`, testNum, str, cluster, b, r, c, bytes[cluster], runes[cluster], columns[cluster])
+			}
+			if _, width := index.Grapheme(cluster); width != widths[cluster] {
+				t.Errorf(`Test case %d %q failed: Cluster %d has width %d, expected %d`, testNum, str, cluster, width, widths[cluster])
+			}
+		}
+		if b, r, c := index.GraphemeStart(index.Len() + 5); b != len(str) || r != utf8.RuneCountInString(str) || c != column {
+			t.Errorf(`Test case %d %q failed: End is at %d/%d/%d`, testNum, str, b, r, c)
+		}
+
+		// Byte offsets.
+		for offset := -1; offset <= len(str)+1; offset++ {
+			expected := 0
+			for cluster := range bytes {
+				if bytes[cluster] <= offset {
+					expected = cluster
+				}
+			}
+			if offset >= len(str) {
+				expected = len(bytes)
+			}
+			if actual := index.GraphemeAtByte(offset); actual != expected {
+				t.Errorf(`Test case %d %q failed: Byte %d is in cluster %d, expected %d`, testNum, str, offset, actual, expected)
+			}
+			expectedRune := utf8.RuneCountInString(str)
+			if offset < len(str) {
+				expectedRune = -1
+				for position := range str {
+					if position <= offset {
+						expectedRune++
+					}
+				}
+				if expectedRune < 0 {
+					expectedRune = 0
+				}
+			}
+			if actual := index.ByteToRune(offset); actual != expectedRune {
+				t.Errorf(`Test case %d %q failed: Byte %d is in rune %d, expected %d`, testNum, str, offset, actual, expectedRune)
+			}
+		}
+
+		// Rune offsets.
+		runeCount := utf8.RuneCountInString(str)
+		for offset := -1; offset <= runeCount+1; offset++ {
+			expected := len(str)
+			if offset <= 0 {
+				expected = 0
+			} else if offset < runeCount {
+				expected = len(string([]rune(str)[:offset]))
+			}
+			if actual := index.RuneToByte(offset); actual != expected {
+				t.Errorf(`Test case %d %q failed: Rune %d is at byte %d, expected %d`, testNum, str, offset, actual, expected)
+			}
+			if actual, expected := index.GraphemeAtRune(offset), index.GraphemeAtByte(expected); actual != expected && offset < runeCount {
+				t.Errorf(`Test case %d %q failed: Rune %d is in cluster %d, expected %d`, testNum, str, offset, actual, expected)
+			}
+		}
+
+		// Columns.
+		for col := -1; col <= column+1; col++ {
+			expected := len(bytes)
+			if col < 0 {
+				expected = 0
+			}
+			for cluster := range bytes {
+				if col >= 0 && columns[cluster] <= col && col < columns[cluster]+widths[cluster] {
+					expected = cluster
+				}
+			}
+			if actual := index.GraphemeAtColumn(col); actual != expected {
+				t.Errorf(`Test case %d %q failed: Column %d is in cluster %d, expected %d`, testNum, str, col, actual, expected)
+			}
+			expectedByte := len(str)
+			if expected < len(bytes) {
+				expectedByte = bytes[expected]
+			}
+			if actual := index.ColumnToByte(col); actual != expectedByte {
+				t.Errorf(`Test case %d %q failed: Column %d is at byte %d, expected %d`, testNum, str, col, actual, expectedByte)
+			}
+		}
+	}
+}
+
+// Test hit-testing on wide characters.
+func TestTextIndexCursor(t *testing.T) {
+	config := NewWidthConfig()
+	config.TabWidth = 4
+	config.EscapeSequences = true
+	index := config.NewTextIndex("a世\x1b[1m\t界")
+	for column, expected := range []struct{ cluster, cursor int }{
+		{0, 0}, // a
+		{1, 1}, // 世 (left half)
+		{1, 2}, // 世 (right half)
+		{3, 3}, // Tab (3 to 4, left half)
+		{4, 4}, // 界 (left half)
+		{4, 5}, // 界 (right half)
+		{5, 5}, // End.
+	} {
+		if actual := index.GraphemeAtColumn(column); actual != expected.cluster {
+			t.Errorf(`Column %d is in cluster %d, expected %d`, column, actual, expected.cluster)
+		}
+		if actual := index.CursorAtColumn(column); actual != expected.cursor {
+			t.Errorf(`Cursor at column %d is at %d, expected %d`, column, actual, expected.cursor)
+		}
+	}
+	if cluster, width := index.Grapheme(2); cluster != "\x1b[1m" || width != 0 {
+		t.Errorf(`Unexpected cluster %q with width %d`, cluster, width)
+	}
+	if column := index.ByteToColumn(len("a世\x1b[1m\t")); column != 4 {
+		t.Errorf(`Expected column 4, got %d`, column)
+	}
+}
@@ -0,0 +1,238 @@
+package uniseg
+
+import "strings"
+
+// TruncateString shortens the given string such that its monospace width, as
+// calculated by [StringWidth], does not exceed "maxWidth". If the string needs
+// to be shortened, grapheme clusters are removed from its end and the "tail"
+// string (e.g. "…" or "...") is appended. If the string is not wider than
+// "maxWidth", it is returned unchanged.
+//
+// Strings are only cut between grapheme clusters. Emoji sequences, flags, or
+// characters with combining marks are therefore never split. If a wide
+// character does not fit into the remaining space, it is removed and the
+// resulting gap is filled with spaces, i.e. the returned string is always
+// exactly "maxWidth" cells wide if it was shortened (unless the tail itself
+// contains characters wider than the remaining space). If the tail is wider
+// than "maxWidth", it is shortened itself, without a tail.
+//
+// Use [WidthConfig.TruncateString] for a different width policy.
+func TruncateString(str string, maxWidth int, tail string) string {
+	return truncateString(str, maxWidth, tail, nil)
+}
+
+// truncateString implements [TruncateString] using the given width
+// configuration, which may be nil. If escape sequences are enabled in the
+// configuration, the escape sequences of the removed text are kept.
+func truncateString(str string, maxWidth int, tail string, config *WidthConfig) string {
+	if stringWidth(str, config) <= maxWidth {
+		return str
+	}
+	if maxWidth <= 0 {
+		return truncateEscapes(str, config)
+	}
+	tailWidth := stringWidth(tail, config)
+	if tailWidth > maxWidth {
+		return truncateEscapes(str, config) + truncateString(tail, maxWidth, "", config)
+	}
+
+	head, headWidth := truncateStart(str, maxWidth-tailWidth, config)
+	return head + truncateEscapes(str[len(head):], config) + strings.Repeat(" ", maxWidth-tailWidth-headWidth) + tail
+}
+
+// TruncateStringLeft is like [TruncateString] but removes grapheme clusters
+// from the beginning of the string and prepends the "head" string (e.g. "…").
+// This is useful for file paths, for example. Any spaces needed to fill a gap
+// are inserted after the head.
+func TruncateStringLeft(str string, maxWidth int, head string) string {
+	return truncateStringLeft(str, maxWidth, head, nil)
+}
+
+// truncateStringLeft implements [TruncateStringLeft] using the given width
+// configuration, which may be nil. If escape sequences are enabled in the
+// configuration, the escape sequences of the removed text are kept.
+func truncateStringLeft(str string, maxWidth int, head string, config *WidthConfig) string {
+	if stringWidth(str, config) <= maxWidth {
+		return str
+	}
+	if maxWidth <= 0 {
+		return truncateEscapes(str, config)
+	}
+	headWidth := stringWidth(head, config)
+	if headWidth > maxWidth {
+		return truncateEscapes(str, config) + truncateStringLeft(head, maxWidth, "", config)
+	}
+
+	tail, padding := truncateEnd(str, "", headWidth, maxWidth, config)
+	return truncateEscapes(str[:len(str)-len(tail)], config) + head + strings.Repeat(" ", padding) + tail
+}
+
+// TruncateStringMiddle is like [TruncateString] but removes grapheme clusters
+// from the middle of the string and inserts the "middle" string (e.g. "…")
+// instead. The beginning of the string receives the extra cell if the
+// available space cannot be split evenly. Any spaces needed to fill a gap are
+// inserted before the middle string.
+func TruncateStringMiddle(str string, maxWidth int, middle string) string {
+	return truncateStringMiddle(str, maxWidth, middle, nil)
+}
+
+// truncateStringMiddle implements [TruncateStringMiddle] using the given width
+// configuration, which may be nil. If escape sequences are enabled in the
+// configuration, the escape sequences of the removed text are kept.
+func truncateStringMiddle(str string, maxWidth int, middle string, config *WidthConfig) string {
+	if stringWidth(str, config) <= maxWidth {
+		return str
+	}
+	if maxWidth <= 0 {
+		return truncateEscapes(str, config)
+	}
+	middleWidth := stringWidth(middle, config)
+	if middleWidth > maxWidth {
+		return truncateEscapes(str, config) + truncateString(middle, maxWidth, "", config)
+	}
+
+	available := maxWidth - middleWidth
+	head, headWidth := truncateStart(str, (available+1)/2, config)
+	tail, padding := truncateEnd(str[len(head):], middle, headWidth, maxWidth, config)
+	removed := str[len(head) : len(str)-len(tail)]
+	return head + truncateEscapes(removed, config) + strings.Repeat(" ", padding) + middle + tail
+}
+
+// TruncateString is like the package-level function [TruncateString] but uses
+// this configuration. If [WidthConfig.EscapeSequences] is true, escape
+// sequences contained in the removed text are kept in the result (e.g. to
+// reset colors), without contributing to its width.
+func (c *WidthConfig) TruncateString(str string, maxWidth int, tail string) string {
+	return truncateString(str, maxWidth, tail, c)
+}
+
+// TruncateStringLeft is like the package-level function [TruncateStringLeft]
+// but uses this configuration. See [WidthConfig.TruncateString] for details.
+func (c *WidthConfig) TruncateStringLeft(str string, maxWidth int, head string) string {
+	return truncateStringLeft(str, maxWidth, head, c)
+}
+
+// TruncateStringMiddle is like the package-level function
+// [TruncateStringMiddle] but uses this configuration. See
+// [WidthConfig.TruncateString] for details.
+func (c *WidthConfig) TruncateStringMiddle(str string, maxWidth int, middle string) string {
+	return truncateStringMiddle(str, maxWidth, middle, c)
+}
+
+// truncateEscapes returns the escape sequences contained in the given
+// (removed) string if the width configuration enables them. Otherwise, an
+// empty string is returned.
+func truncateEscapes(str string, config *WidthConfig) string {
+	if config == nil || !config.EscapeSequences {
+		return ""
+	}
+	return escapeSequences(str)
+}
+
+// truncateStart returns the longest prefix of the given string, cut between
+// grapheme clusters, which is at most "maxWidth" cells wide when printed at
+// column 0. It also returns the prefix's width. The width configuration may be
+// nil.
+func truncateStart(str string, maxWidth int, config *WidthConfig) (prefix string, width int) {
+	var (
+		length, clusterWidth int
+		cluster              string
+	)
+	state, remaining := -1, str
+	for len(remaining) > 0 {
+		cluster, remaining, clusterWidth, state = firstGraphemeCluster(remaining, state, config)
+		clusterWidth = clusterWidthAt(cluster, clusterWidth, width, config)
+		if width+clusterWidth > maxWidth {
+			break
+		}
+		width += clusterWidth
+		length += len(cluster)
+	}
+	return str[:length], width
+}
+
+// truncateEnd returns the longest suffix of the given string, cut between
+// grapheme clusters, which fits between the columns "start" and "end" when it
+// is preceded by the "lead" string (which may be empty). It also returns the
+// number of spaces to insert before the lead such that the suffix ends at the
+// "end" column. This may be slightly less if horizontal tabs prevent an exact
+// fit. The width configuration may be nil.
+func truncateEnd(str, lead string, start, end int, config *WidthConfig) (suffix string, padding int) {
+	if config != nil && config.TabWidth > 0 && strings.IndexByte(lead+str, '\t') >= 0 {
+		return truncateEndTabs(str, lead, start, end, config)
+	}
+	maxWidth := end - start - stringWidth(lead, config)
+
+	var width int
+	if config != nil {
+		// Clusters can't be determined backwards with a custom configuration.
+		var (
+			ends   []int // The end positions of the clusters.
+			widths []int // The widths of the clusters.
+		)
+		state, remaining := -1, str
+		for len(remaining) > 0 {
+			var clusterWidth int
+			_, remaining, clusterWidth, state = firstGraphemeCluster(remaining, state, config)
+			ends = append(ends, len(str)-len(remaining))
+			widths = append(widths, clusterWidth)
+		}
+		begin := len(str)
+		for index := len(ends) - 1; index >= 0; index-- {
+			if width+widths[index] > maxWidth {
+				break
+			}
+			width += widths[index]
+			begin = 0
+			if index > 0 {
+				begin = ends[index-1]
+			}
+		}
+		return str[begin:], maxWidth - width
+	}
+
+	var (
+		length, clusterWidth int
+		cluster              string
+	)
+	remaining := str
+	for len(remaining) > 0 {
+		cluster, remaining, clusterWidth = LastGraphemeClusterInString(remaining)
+		if width+clusterWidth > maxWidth {
+			break
+		}
+		width += clusterWidth
+		length += len(cluster)
+	}
+	return str[len(str)-length:], maxWidth - width
+}
+
+// truncateEndTabs implements [truncateEnd] for text containing horizontal tabs
+// whose width depends on the column. Each candidate suffix is therefore
+// measured at the column where it would be printed. The width configuration
+// must not be nil.
+func truncateEndTabs(str, lead string, start, end int, config *WidthConfig) (suffix string, padding int) {
+	var starts []int // The start positions of the clusters.
+	state, remaining := -1, str
+	for len(remaining) > 0 {
+		starts = append(starts, len(str)-len(remaining))
+		_, remaining, _, state = firstGraphemeCluster(remaining, state, config)
+	}
+
+	// Prepending clusters never moves the end of the suffix to the left, so we
+	// can stop at the first one which doesn't fit.
+	suffix = str[len(str):]
+	for index := len(starts) - 1; index >= 0; index-- {
+		candidate := str[starts[index]:]
+		if start+config.StringWidthAt(lead+candidate, start) > end {
+			break
+		}
+		suffix = candidate
+	}
+
+	// Shift the suffix to the right as far as possible.
+	for start+padding+1+config.StringWidthAt(lead+suffix, start+padding+1) <= end {
+		padding++
+	}
+	return
+}
@@ -0,0 +1,81 @@
+package uniseg
+
+import "testing"
+
+// The test cases for the truncation functions.
+var truncateTestCases = []struct {
+	original       string
+	maxWidth       int
+	ellipsis       string
+	right          string // TruncateString.
+	left           string // TruncateStringLeft.
+	middle         string // TruncateStringMiddle.
+	expectedLength int
+}{
+	{"", 5, "…", "", "", "", 0},
+	{"Hello", 5, "…", "Hello", "Hello", "Hello", 5},
+	{"Hello, world!", 0, "…", "", "", "", 0},
+	{"Hello, world!", -1, "…", "", "", "", 0},
+	{"Hello, world!", 8, "…", "Hello, …", "… world!", "Hell…ld!", 8},
+	{"Hello, world!", 8, "", "Hello, w", ", world!", "Hellrld!", 8},
+	{"Hello, world!", 2, "...", "..", "..", "..", 2},
+	{"世界世界世界", 5, "…", "世界…", "…世界", "世…界", 5},
+	{"世界世界世界", 6, "…", "世界 …", "… 世界", "世 …界", 6},
+	{"🇩🇪🇩🇪🇩🇪", 4, "…", "🇩🇪 …", "… 🇩🇪", "🇩🇪 …", 4},
+	{"a🏳️‍🌈b🏳️‍🌈c", 4, "…", "a🏳️‍🌈…", "…🏳️‍🌈c", "a …c", 4},
+	{"Käse-Brot", 6, "…", "Käse-…", "…-Brot", "Käs…ot", 6},
+}
+
+// Test the truncation functions.
+func TestTruncateString(t *testing.T) {
+	for index, testCase := range truncateTestCases {
+		if actual := TruncateString(testCase.original, testCase.maxWidth, testCase.ellipsis); actual != testCase.right {
+			t.Errorf(`Test case %d %q failed: TruncateString returned %q, expected %q`, index, testCase.original, actual, testCase.right)
+		}
+		if actual := TruncateStringLeft(testCase.original, testCase.maxWidth, testCase.ellipsis); actual != testCase.left {
+			t.Errorf(`Test case %d %q failed: TruncateStringLeft returned %q, expected %q`, index, testCase.original, actual, testCase.left)
+		}
+		if actual := TruncateStringMiddle(testCase.original, testCase.maxWidth, testCase.ellipsis); actual != testCase.middle {
+			t.Errorf(`Test case %d %q failed: TruncateStringMiddle returned %q, expected %q`, index, testCase.original, actual, testCase.middle)
+		}
+		for _, actual := range []string{testCase.right, testCase.left, testCase.middle} {
+			if width := StringWidth(actual); width != testCase.expectedLength {
+				t.Errorf(`Test case %d %q failed: %q has width %d, expected %d`, index, testCase.original, actual, width, testCase.expectedLength)
+			}
+		}
+	}
+}
+
+// Test the truncation functions with horizontal tabs, whose width depends on
+// their column.
+func TestTruncateStringTabs(t *testing.T) {
+	config := &WidthConfig{TabWidth: 4}
+	for index, testCase := range []struct {
+		original string
+		maxWidth int
+		ellipsis string
+		right    string // TruncateString.
+		left     string // TruncateStringLeft.
+		middle   string // TruncateStringMiddle.
+	}{
+		{"a\tbcdef", 5, "", "a\tb", "bcdef", "acdef"},
+		{"a\tbcdef", 6, "…", "a\tb…", "…bcdef", "a…cdef"},
+		{"abcdef\tg", 6, "…", "abcde…", "…ef\tg", "abc …g"},
+		{"ab\tcdefg\th", 7, "…", "ab\tcd…", "…fg\th", "ab…\th"},
+	} {
+		if actual := config.TruncateString(testCase.original, testCase.maxWidth, testCase.ellipsis); actual != testCase.right {
+			t.Errorf(`Test case %d %q failed: TruncateString returned %q, expected %q`, index, testCase.original, actual, testCase.right)
+		}
+		if actual := config.TruncateStringLeft(testCase.original, testCase.maxWidth, testCase.ellipsis); actual != testCase.left {
+			t.Errorf(`Test case %d %q failed: TruncateStringLeft returned %q, expected %q`, index, testCase.original, actual, testCase.left)
+		}
+		if actual := config.TruncateStringMiddle(testCase.original, testCase.maxWidth, testCase.ellipsis); actual != testCase.middle {
+			t.Errorf(`Test case %d %q failed: TruncateStringMiddle returned %q, expected %q`, index, testCase.original, actual, testCase.middle)
+		}
+		for _, actual := range []string{testCase.right, testCase.left, testCase.middle} {
+			if width := config.StringWidth(actual); width > testCase.maxWidth {
+				t.Errorf(`Test case %d %q failed: %q has width %d, expected at most %d`, index, testCase.original, actual, width, testCase.maxWidth)
+			}
+		}
+	}
+}
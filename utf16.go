@@ -0,0 +1,82 @@
+package uniseg
+
+import (
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// This file contains variants of the segmentation functions which operate on
+// UTF-16 encoded text, as used by JavaScript, Java, or the Windows API. All
+// returned slices are sub-slices of the input, so offsets derived from them
+// (e.g. len(cluster)) are counted in UTF-16 code units. This is also how the
+// Language Server Protocol and browsers address positions in text.
+//
+// Unpaired surrogates are treated like invalid UTF-8 bytes elsewhere in this
+// package, i.e. each one is decoded as U+FFFD (REPLACEMENT CHARACTER).
+
+// FirstGraphemeClusterUTF16 is like [FirstGraphemeCluster] but its input and
+// outputs are UTF-16 code units.
+func FirstGraphemeClusterUTF16(u []uint16, state int) (cluster, rest []uint16, width, newState int) {
+	return firstGraphemeCluster(u, state, nil)
+}
+
+// StepUTF16 is like [Step] but its input and outputs are UTF-16 code units.
+func StepUTF16(u []uint16, state int) (cluster, rest []uint16, boundaries int, newState int) {
+	return step(u, state, nil)
+}
+
+// FirstWordUTF16 is like [FirstWord] but its input and outputs are UTF-16 code
+// units.
+func FirstWordUTF16(u []uint16, state int) (word, rest []uint16, newState int) {
+	return firstWord(u, state)
+}
+
+// FirstSentenceUTF16 is like [FirstSentence] but its input and outputs are
+// UTF-16 code units.
+func FirstSentenceUTF16(u []uint16, state int) (sentence, rest []uint16, newState int) {
+	return firstSentence(u, state)
+}
+
+// FirstLineSegmentUTF16 is like [FirstLineSegment] but its input and outputs
+// are UTF-16 code units.
+func FirstLineSegmentUTF16(u []uint16, state int) (segment, rest []uint16, mustBreak bool, newState int) {
+	return firstLineSegment(u, state)
+}
+
+// decodeUTF16 returns the first code point of the given UTF-16 text and the
+// number of code units it occupies. Unpaired surrogates are decoded as
+// [utf8.RuneError] with a length of 1. If the text is empty, [utf8.RuneError]
+// and a length of 0 are returned.
+func decodeUTF16(u []uint16) (r rune, length int) {
+	if len(u) == 0 {
+		return utf8.RuneError, 0
+	}
+	r = rune(u[0])
+	if !utf16.IsSurrogate(r) {
+		return r, 1
+	}
+	if len(u) > 1 {
+		if r = utf16.DecodeRune(r, rune(u[1])); r != utf8.RuneError {
+			return r, 2
+		}
+	}
+	return utf8.RuneError, 1
+}
+
+// decodeLastUTF16 is like [decodeUTF16] but returns the last code point of the
+// given UTF-16 text.
+func decodeLastUTF16(u []uint16) (r rune, length int) {
+	if len(u) == 0 {
+		return utf8.RuneError, 0
+	}
+	r = rune(u[len(u)-1])
+	if !utf16.IsSurrogate(r) {
+		return r, 1
+	}
+	if len(u) > 1 {
+		if r = utf16.DecodeRune(rune(u[len(u)-2]), r); r != utf8.RuneError {
+			return r, 2
+		}
+	}
+	return utf8.RuneError, 1
+}
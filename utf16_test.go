@@ -0,0 +1,36 @@
+package uniseg
+
+import (
+	"testing"
+	"unicode/utf16"
+)
+
+// Test the UTF-16 functions against their UTF-8 counterparts, using the
+// official Unicode test cases.
+func TestUTF16Conformance(t *testing.T) {
+	testEncodingConformance(t, encodingFuncs[[]uint16]{
+		name:                 "UTF-16",
+		encode:               func(s string) []uint16 { return utf16.Encode([]rune(s)) },
+		decode:               func(u []uint16) string { return string(utf16.Decode(u)) },
+		step:                 StepUTF16,
+		firstGraphemeCluster: FirstGraphemeClusterUTF16,
+		firstWord:            FirstWordUTF16,
+		firstSentence:        FirstSentenceUTF16,
+		firstLineSegment:     FirstLineSegmentUTF16,
+	})
+}
+
+// Test that the UTF-16 functions return offsets in code units and handle
+// unpaired surrogates.
+func TestUTF16Offsets(t *testing.T) {
+	testEncodingOffsets(t, FirstGraphemeClusterUTF16, []encodingOffsetTestCase[[]uint16]{
+		{nil, nil, 0},
+		{utf16.Encode([]rune("abc")), []int{1, 1, 1}, 3},
+		{utf16.Encode([]rune("🏳️‍🌈!")), []int{6, 1}, 3},
+		{utf16.Encode([]rune("🇩🇪🇫🇷")), []int{4, 4}, 4},
+		{utf16.Encode([]rune("e\u0301\r\n")), []int{2, 2}, 1},
+		{[]uint16{'a', 0xd83d, 'b'}, []int{1, 1, 1}, 3}, // Unpaired high surrogate.
+		{[]uint16{0xde00, 0x0301}, []int{2}, 1},         // Unpaired low surrogate with a combining mark.
+		{[]uint16{'x', 0xd83d}, []int{1, 1}, 2},         // Truncated surrogate pair.
+	})
+}
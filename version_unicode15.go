@@ -0,0 +1,8 @@
+//go:build uniseg_unicode15
+
+package uniseg
+
+// unicode15 is true if the package implements the rules of Unicode version
+// 15.0.0 instead of the current ones (see the "uniseg_unicode15" build tag in
+// doc.go). Rules introduced after 15.0.0 are skipped when it is true.
+const unicode15 = true
@@ -5,28 +5,235 @@ package uniseg
 // render them with a width of 2.
 var EastAsianAmbiguousWidth = 1
 
+// WidthProfile determines how the widths of the code points of a grapheme
+// cluster are combined into the width of the cluster. Terminal emulators
+// disagree on this, so the profile should match the terminal the text is
+// displayed in.
+type WidthProfile int
+
+// The available width profiles.
+const (
+	// WidthProfileGrapheme treats grapheme clusters as units, as described in
+	// the package documentation. Extended Pictographic clusters are switched
+	// to text or emoji presentation by variation selectors, flags have a width
+	// of 2, and Hangul syllables made of conjoining Jamo count like
+	// precomposed ones. This matches grapheme-aware terminals such as kitty,
+	// WezTerm, foot, or Windows Terminal. It is the default profile.
+	WidthProfileGrapheme WidthProfile = iota
+
+	// WidthProfileWcwidth sums up the widths of all code points of a grapheme
+	// cluster, similar to what wcswidth() does. Variation selectors do not
+	// change the width, each Regional Indicator has a width of 1, emoji
+	// modifiers have a width of 2, and Hangul vowel and trailing consonant
+	// Jamo have a width of 0. This matches terminals such as xterm, GNOME
+	// Terminal, or Alacritty.
+	WidthProfileWcwidth
+)
+
+// WidthConfig holds the parameters used to calculate monospace widths. The
+// package-level functions such as [StringWidth] or [Step] apply a fixed policy
+// which is only adjustable via the global [EastAsianAmbiguousWidth] variable. A
+// WidthConfig allows different policies to be used side by side, for example
+// when rendering to multiple terminals with different font settings.
+//
+// The zero value is a configuration with default values, as is the one
+// returned by [NewWidthConfig]. A WidthConfig must not be modified while it is
+// being used.
+type WidthConfig struct {
+	// EastAsianAmbiguousWidth is the width of East Asian characters classified
+	// as Ambiguous. The default is 1, which is also used if this field is 0.
+	EastAsianAmbiguousWidth int
+
+	// EmojiWidth is the width of grapheme clusters presented as emojis, that
+	// is, Extended Pictographic characters with the Emoji Presentation
+	// property or followed by U+FE0F (VARIATION SELECTOR-16), and Regional
+	// Indicators (flags). The default is 2, which is also used if this field
+	// is 0.
+	EmojiWidth int
+
+	// ControlWidth is the width of control characters, including CR and LF. A
+	// CRLF sequence is counted once. The default is 0.
+	ControlWidth int
+
+	// TabWidth is the distance between tab stops. If positive, a horizontal
+	// tab advances [WidthConfig.StringWidth] to the next tab stop. Functions
+	// which handle individual grapheme clusters such as
+	// [WidthConfig.FirstGraphemeCluster] do not know the current column and
+	// report a tab width of TabWidth. Use [WidthConfig.FirstGraphemeClusterAt]
+	// to track columns. If 0 (the default), tabs are treated like any other
+	// control character.
+	TabWidth int
+
+	// Profile determines how the widths of the code points of a grapheme
+	// cluster are combined. The default is [WidthProfileGrapheme].
+	Profile WidthProfile
+
+	// If EscapeSequences is true, ECMA-48 escape sequences such as ANSI color
+	// codes (SGR) or OSC 8 hyperlinks are treated as opaque units with a
+	// width of 0. They are transparent to segmentation: the boundaries of the
+	// text around them are the same as without them. The segmentation
+	// functions return them as separate clusters which belong to the text that
+	// follows them, i.e. boundaries are reported before them. Escape sequences
+	// within a grapheme cluster (e.g. before a combining mark) become part of
+	// that cluster. By default, escape sequences are treated as regular text.
+	EscapeSequences bool
+}
+
+// NewWidthConfig returns a new width configuration with default values. These
+// match the behaviour of the package-level functions when the global
+// [EastAsianAmbiguousWidth] variable has not been modified.
+func NewWidthConfig() *WidthConfig {
+	return &WidthConfig{
+		EastAsianAmbiguousWidth: 1,
+		EmojiWidth:              2,
+	}
+}
+
+// StringWidth is like the package-level function [StringWidth] but uses this
+// configuration. Tab stops are calculated relative to the beginning of the
+// string. Use [WidthConfig.StringWidthAt] if the string starts at a different
+// column.
+func (c *WidthConfig) StringWidth(s string) (width int) {
+	return c.StringWidthAt(s, 0)
+}
+
+// NewGraphemes is like the package-level function [NewGraphemes] but the
+// returned iterator uses this configuration.
+func (c *WidthConfig) NewGraphemes(str string) *Graphemes {
+	g := NewGraphemes(str)
+	g.config = c
+	return g
+}
+
+// FirstGraphemeCluster is like the package-level function
+// [FirstGraphemeCluster] but uses this configuration to calculate the width.
+func (c *WidthConfig) FirstGraphemeCluster(b []byte, state int) (cluster, rest []byte, width, newState int) {
+	return firstGraphemeCluster(b, state, c)
+}
+
+// FirstGraphemeClusterInString is like the package-level function
+// [FirstGraphemeClusterInString] but uses this configuration to calculate the
+// width.
+func (c *WidthConfig) FirstGraphemeClusterInString(str string, state int) (cluster, rest string, width, newState int) {
+	return firstGraphemeCluster(str, state, c)
+}
+
+// Step is like the package-level function [Step] but uses this configuration
+// to calculate the width.
+func (c *WidthConfig) Step(b []byte, state int) (cluster, rest []byte, boundaries int, newState int) {
+	return step(b, state, c)
+}
+
+// StepString is like the package-level function [StepString] but uses this
+// configuration to calculate the width.
+func (c *WidthConfig) StepString(str string, state int) (cluster, rest string, boundaries int, newState int) {
+	return step(str, state, c)
+}
+
+// stringWidth returns the width of the given string according to the given
+// configuration. If config is nil, [StringWidth] is used.
+func stringWidth(str string, config *WidthConfig) int {
+	if config == nil {
+		return StringWidth(str)
+	}
+	return config.StringWidth(str)
+}
+
+// isKeycapBase returns true if the given rune, the first rune of a grapheme
+// cluster, may be followed by U+FE0F (VARIATION SELECTOR-16) and U+20E3
+// (COMBINING ENCLOSING KEYCAP) to form a keycap emoji sequence, as defined in
+// [UTS #51].
+//
+// [UTS #51]: https://unicode.org/reports/tr51/#Emoji_Keycap_Sequences
+func isKeycapBase(r rune) bool {
+	return r >= '0' && r <= '9' || r == '#' || r == '*'
+}
+
+// clusterWidth returns the width of a grapheme cluster after the rune "r" with
+// the grapheme property "prop" was added to it. "width" is the width of the
+// cluster so far, "first" and "firstProp" are the first rune of the cluster
+// and its grapheme property, and "previous" is the rune added before "r". If
+// config is nil, the package defaults apply.
+func clusterWidth(width int, first rune, firstProp int, previous, r rune, prop int, config *WidthConfig) int {
+	if config != nil && config.Profile == WidthProfileWcwidth {
+		return width + runeWidth(r, prop, config)
+	}
+	if firstProp == prExtendedPictographic {
+		if r == vs15 {
+			return 1
+		} else if r == vs16 || r >= emojiModifierFirst && r <= emojiModifierLast {
+			return emojiWidth(config)
+		}
+		return width
+	}
+	if r == keycap && previous == vs16 && isKeycapBase(first) {
+		return emojiWidth(config)
+	}
+	if r >= emojiModifierFirst && r <= emojiModifierLast {
+		return width // Only emoji modifiers starting a cluster are shown as swatches.
+	}
+	if firstProp != prRegionalIndicator && firstProp != prL && firstProp != prCR {
+		return width + runeWidth(r, prop, config)
+	}
+	return width
+}
+
+// emojiWidth returns the width of emoji clusters for the given configuration.
+// If config is nil or its EmojiWidth field is 0, the default of 2 is returned.
+func emojiWidth(config *WidthConfig) int {
+	if config == nil || config.EmojiWidth == 0 {
+		return 2
+	}
+	return config.EmojiWidth
+}
+
 // runeWidth returns the monospace width for the given rune. The provided
-// grapheme property is a value mapped by the [graphemeCodePoints] table.
+// grapheme property is a value mapped by the [graphemeCodePoints] table. If
+// config is nil, the package defaults are used, including the global
+// [EastAsianAmbiguousWidth] variable.
 //
 // Every rune has a width of 1, except for runes with the following properties
 // (evaluated in this order):
 //
-//   - Control, CR, LF, Extend, ZWJ: Width of 0
+//   - Control, CR, LF: Width of 0 (or ControlWidth / TabWidth, see
+//     [WidthConfig])
+//   - Emoji modifiers (U+1F3FB to U+1F3FF): Width of 2 (when not following
+//     another rune of the same grapheme cluster, see [clusterWidth])
+//   - Extend, ZWJ: Width of 0
+//   - Hangul V and T: Width of 1 (0 in [WidthProfileWcwidth])
 //   - \u2e3a, TWO-EM DASH: Width of 3
 //   - \u2e3b, THREE-EM DASH: Width of 4
 //   - East-Asian width Fullwidth and Wide: Width of 2 (Ambiguous and Neutral
 //     have a width of 1)
-//   - Regional Indicator: Width of 2
+//   - Regional Indicator: Width of 2 (1 in [WidthProfileWcwidth])
 //   - Extended Pictographic: Width of 2, unless Emoji Presentation is "No".
-func runeWidth(r rune, graphemeProperty int) int {
+func runeWidth(r rune, graphemeProperty int, config *WidthConfig) int {
 	switch graphemeProperty {
-	case prControl, prCR, prLF, prExtend, prZWJ:
+	case prControl, prCR, prLF:
+		if config == nil {
+			return 0
+		}
+		if r == '\t' && config.TabWidth > 0 {
+			return config.TabWidth
+		}
+		return config.ControlWidth
+	case prExtend, prZWJ:
+		if r >= emojiModifierFirst && r <= emojiModifierLast {
+			return emojiWidth(config) // Emoji modifiers outside of modifier sequences are shown as swatches.
+		}
 		return 0
 	case prRegionalIndicator:
-		return 2
+		if config != nil && config.Profile == WidthProfileWcwidth {
+			return 1
+		}
+		return emojiWidth(config)
+	case prV, prT:
+		if config != nil && config.Profile == WidthProfileWcwidth {
+			return 0
+		}
 	case prExtendedPictographic:
-		if property(emojiPresentation, r) == prEmojiPresentation {
-			return 2
+		if propertiesOf(r).emojiPresentation == prEmojiPresentation {
+			return emojiWidth(config)
 		}
 		return 1
 	}
@@ -42,14 +249,21 @@ func runeWidth(r rune, graphemeProperty int) int {
 	case prW, prF:
 		return 2
 	case prA:
-		return EastAsianAmbiguousWidth
+		if config == nil {
+			return EastAsianAmbiguousWidth
+		}
+		if config.EastAsianAmbiguousWidth == 0 {
+			return 1
+		}
+		return config.EastAsianAmbiguousWidth
 	}
 
 	return 1
 }
 
 // StringWidth returns the monospace width for the given string, that is, the
-// number of same-size cells to be occupied by the string.
+// number of same-size cells to be occupied by the string. Use
+// [WidthConfig.StringWidth] for a different width policy.
 func StringWidth(s string) (width int) {
 	state := -1
 	for len(s) > 0 {
@@ -342,6 +342,23 @@ var widthTestCases = []struct {
 	{"\u231b", 2},                               // Hourglass
 	{"\u231b\ufe0e", 1},                         // Hourglass (with variation selector 15 = text presentation)
 	{"1\ufe0f", 1},                              // Emoji presentation of digit one.
+	{"\u2764\ufe0f", 2},                         // Heavy black heart (with variation selector 16 = emoji presentation)
+	{"\u2764", 1},                               // Heavy black heart
+	{"\U0001f600\ufe0e", 1},                     // Grinning face (with variation selector 15 = text presentation)
+	{"\U0001f600", 2},                           // Grinning face
+	{"1\ufe0f\u20e3", 2},                        // Keycap digit one
+	{"#\ufe0f\u20e3", 2},                        // Keycap number sign
+	{"*\u20e3", 1},                              // Asterisk with combining enclosing keycap (no emoji)
+	{"1\u20e3", 1},                              // Digit one with combining enclosing keycap (no emoji)
+	{"a\u20e3", 1},                              // Letter a with combining enclosing keycap (no emoji)
+	{"1\ufe0f\u20e3!", 3},                       // Keycap digit one followed by exclamation mark
+	{"\U0001f44d\U0001f3fc", 2},                 // Thumbs up with emoji modifier
+	{"\u261d\U0001f3fb", 2},                     // Index pointing up (text presentation) with emoji modifier
+	{"\u261d", 1},                               // Index pointing up
+	{"\U0001f3fb", 2},                           // Emoji modifier on its own (swatch)
+	{"a\U0001f3fb", 1},                          // Latin letter with emoji modifier
+	{"가\U0001f3fb", 2},                          // Hangul syllable with emoji modifier
+	{"\U0001f469\U0001f3fd\u200d\U0001f4bb", 2}, // Woman technologist with emoji modifier
 }
 
 // String width tests using the StringWidth function.
@@ -494,3 +511,134 @@ func TestRunesWidth(t *testing.T) {
 		}
 	}
 }
+
+// Default width configurations must yield the same results as the package
+// functions.
+func TestWidthConfigDefault(t *testing.T) {
+	config := NewWidthConfig()
+	for index, testCase := range widthTestCases {
+		if actual := config.StringWidth(testCase.original); actual != testCase.expected {
+			t.Errorf("StringWidth(%q) is %d, expected %d (test case %d)", testCase.original, actual, testCase.expected, index)
+		}
+		var actual int
+		state := -1
+		text := testCase.original
+		for len(text) > 0 {
+			var boundaries int
+			_, text, boundaries, state = config.StepString(text, state)
+			actual += boundaries >> ShiftWidth
+		}
+		if actual != testCase.expected {
+			t.Errorf("Step width of %q is %d, expected %d (test case %d)", testCase.original, actual, testCase.expected, index)
+		}
+		actual = 0
+		state = -1
+		b := []byte(testCase.original)
+		for len(b) > 0 {
+			var w int
+			_, b, w, state = config.FirstGraphemeCluster(b, state)
+			actual += w
+		}
+		if actual != testCase.expected {
+			t.Errorf("Grapheme width of %q is %d, expected %d (test case %d)", testCase.original, actual, testCase.expected, index)
+		}
+	}
+}
+
+// Width tests with custom configurations.
+func TestWidthConfigCustom(t *testing.T) {
+	for index, testCase := range []struct {
+		config   WidthConfig
+		original string
+		expected int
+	}{
+		{WidthConfig{EastAsianAmbiguousWidth: 1, EmojiWidth: 2}, "§x", 2},                      // Section sign (Ambiguous).
+		{WidthConfig{EastAsianAmbiguousWidth: 2, EmojiWidth: 2}, "§x", 3},                      // Section sign (Ambiguous).
+		{WidthConfig{EastAsianAmbiguousWidth: 2, EmojiWidth: 2}, "世界", 4},                      // Wide is not affected.
+		{WidthConfig{EastAsianAmbiguousWidth: 1, EmojiWidth: 1}, "\u231b", 1},                  // Hourglass.
+		{WidthConfig{EastAsianAmbiguousWidth: 1, EmojiWidth: 1}, "\u263a\ufe0f", 1},            // White smiling face with VS16.
+		{WidthConfig{EastAsianAmbiguousWidth: 1, EmojiWidth: 1}, "\u263a", 1},                  // White smiling face.
+		{WidthConfig{EastAsianAmbiguousWidth: 1, EmojiWidth: 1}, "🇩🇪🏳️‍🌈", 2},                  // Flag and ZWJ sequence.
+		{WidthConfig{EastAsianAmbiguousWidth: 1, EmojiWidth: 3}, "🇩🇪", 3},                      // Flag.
+		{WidthConfig{EastAsianAmbiguousWidth: 1, EmojiWidth: 2, ControlWidth: 1}, "a\x00b", 3}, // Null.
+		{WidthConfig{EastAsianAmbiguousWidth: 1, EmojiWidth: 2, ControlWidth: 1}, "a\r\nb", 3}, // CRLF is one cluster.
+		{WidthConfig{EastAsianAmbiguousWidth: 1, EmojiWidth: 2, ControlWidth: 1}, "a\tb", 3},   // Tab without tab stops.
+		{WidthConfig{EastAsianAmbiguousWidth: 1, EmojiWidth: 2, TabWidth: 4}, "\t", 4},
+		{WidthConfig{EastAsianAmbiguousWidth: 1, EmojiWidth: 2, TabWidth: 4}, "a\tb", 5},
+		{WidthConfig{EastAsianAmbiguousWidth: 1, EmojiWidth: 2, TabWidth: 4}, "abcd\tb", 9},
+		{WidthConfig{EastAsianAmbiguousWidth: 1, EmojiWidth: 2, TabWidth: 8}, "世界\t\t!", 17},
+		{WidthConfig{}, "😀§世", 5},                      // Zero value uses the defaults.
+		{WidthConfig{EscapeSequences: true}, "😀§世", 5}, // Zero value uses the defaults.
+	} {
+		config := testCase.config
+		if actual := config.StringWidth(testCase.original); actual != testCase.expected {
+			t.Errorf("Test case %d %q failed: StringWidth is %d, expected %d", index, testCase.original, actual, testCase.expected)
+		}
+		if testCase.config.TabWidth > 0 {
+			continue // Cluster functions don't know about columns.
+		}
+		var actual int
+		state := -1
+		text := testCase.original
+		for len(text) > 0 {
+			var boundaries int
+			_, text, boundaries, state = config.StepString(text, state)
+			actual += boundaries >> ShiftWidth
+		}
+		if actual != testCase.expected {
+			t.Errorf("Test case %d %q failed: Step width is %d, expected %d", index, testCase.original, actual, testCase.expected)
+		}
+		actual = 0
+		state = -1
+		text = testCase.original
+		for len(text) > 0 {
+			var w int
+			_, text, w, state = config.FirstGraphemeClusterInString(text, state)
+			actual += w
+		}
+		if actual != testCase.expected {
+			t.Errorf("Test case %d %q failed: grapheme width is %d, expected %d", index, testCase.original, actual, testCase.expected)
+		}
+	}
+}
+
+// Width tests for the different width profiles.
+func TestWidthProfiles(t *testing.T) {
+	for index, testCase := range []struct {
+		original          string
+		grapheme, wcwidth int
+	}{
+		{"Hello", 5, 5},
+		{"世界", 4, 4},
+		{"a\u0308", 1, 1},                          // a with combining diaeresis.
+		{"\u263a\ufe0f", 2, 1},                     // White smiling face with VS16.
+		{"\u231b\ufe0e", 1, 2},                     // Hourglass with VS15.
+		{"\U0001f1e9\U0001f1ea", 2, 2},             // Flag.
+		{"\U0001f1e9", 2, 1},                       // Single Regional Indicator.
+		{"\U0001f3f3\ufe0f\u200d\U0001f308", 2, 3}, // Rainbow flag: 1 + 0 + 0 + 2.
+		{"\U0001f44d\U0001f3fc", 2, 4},             // Emoji modifier sequence.
+		{"\u1100\u1161\u11a8", 2, 2},               // Hangul syllable from conjoining Jamo.
+		{"\u1161", 1, 0},                           // Lone Hangul vowel Jamo.
+		{"\uac01\uac01", 4, 4},                     // Precomposed Hangul syllables.
+	} {
+		config := NewWidthConfig()
+		if actual := config.StringWidth(testCase.original); actual != testCase.grapheme {
+			t.Errorf("Test case %d %q failed: grapheme profile width is %d, expected %d", index, testCase.original, actual, testCase.grapheme)
+		}
+		config.Profile = WidthProfileWcwidth
+		if actual := config.StringWidth(testCase.original); actual != testCase.wcwidth {
+			t.Errorf("Test case %d %q failed: wcwidth profile width is %d, expected %d", index, testCase.original, actual, testCase.wcwidth)
+		}
+		var actual int
+		state := -1
+		b := []byte(testCase.original)
+		for len(b) > 0 {
+			var boundaries int
+			_, b, boundaries, state = config.Step(b, state)
+			actual += boundaries >> ShiftWidth
+		}
+		if actual != testCase.wcwidth {
+			t.Errorf("Test case %d %q failed: wcwidth profile step width is %d, expected %d", index, testCase.original, actual, testCase.wcwidth)
+		}
+	}
+}
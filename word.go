@@ -1,6 +1,159 @@
 package uniseg
 
-import "unicode/utf8"
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// Words implements an iterator over words according to the rules of [Unicode
+// Standard Annex #29, Word Boundaries]. Note that this includes segments which
+// are not considered words in the everyday sense, such as punctuation and
+// whitespace.
+//
+// After constructing the class via [NewWords] for a given string "str" (or
+// [NewWordsBytes] for a byte slice), [Words.Next] is called for every word in a
+// loop until it returns false. Inside the loop, information about the word is
+// available via the various methods (see examples below).
+//
+// This class basically wraps the [FirstWordInString] parser and provides a
+// convenient interface to it. If performance is important, using
+// [FirstWordInString] directly is faster.
+//
+// [Unicode Standard Annex #29, Word Boundaries]: http://unicode.org/reports/tr29/#Word_Boundaries
+type Words struct {
+	// The original string.
+	original string
+
+	// The remaining string to be parsed.
+	remaining string
+
+	// The current word.
+	word string
+
+	// The byte offset of the current word relative to the original string.
+	offset int
+
+	// The rune offset of the current word relative to the original string.
+	runeOffset int
+
+	// The current state of the word parser. -1 before the first call to
+	// [Words.Next], -2 after the iterator has reached the end.
+	state int
+}
+
+// NewWords returns a new word iterator.
+func NewWords(str string) *Words {
+	return &Words{
+		original:  str,
+		remaining: str,
+		state:     -1,
+	}
+}
+
+// NewWordsBytes returns a new word iterator for the given byte slice. The
+// byte slice is copied, i.e. later changes to it will not affect the iterator.
+func NewWordsBytes(b []byte) *Words {
+	return NewWords(string(b))
+}
+
+// String returns a string representation of the current word iterator. It
+// includes the current word, wrapped in curly brackets, and the first 10 bytes
+// of the remaining string.
+func (w *Words) String() string {
+	remaining := w.remaining
+	if len(remaining) > 10 {
+		remaining = remaining[:10] + "..."
+	}
+	return fmt.Sprintf("{%s}%s", w.word, remaining)
+}
+
+// Next advances the iterator by one word and returns false if no words are
+// left. This function must be called before the first word is accessed.
+func (w *Words) Next() bool {
+	if len(w.remaining) == 0 {
+		// We're already past the end.
+		w.state = -2
+		w.word = ""
+		return false
+	}
+	w.offset += len(w.word)
+	w.runeOffset += utf8.RuneCountInString(w.word)
+	w.word, w.remaining, w.state = FirstWordInString(w.remaining, w.state)
+	return true
+}
+
+// Runes returns a slice of runes (code points) which corresponds to the current
+// word. If the iterator is already past the end or [Words.Next] has not yet
+// been called, nil is returned.
+func (w *Words) Runes() []rune {
+	if w.state < 0 {
+		return nil
+	}
+	return []rune(w.word)
+}
+
+// Str returns a substring of the original string which corresponds to the
+// current word. If the iterator is already past the end or [Words.Next] has
+// not yet been called, an empty string is returned.
+func (w *Words) Str() string {
+	return w.word
+}
+
+// Bytes returns a byte slice which corresponds to the current word. If the
+// iterator is already past the end or [Words.Next] has not yet been called,
+// nil is returned.
+func (w *Words) Bytes() []byte {
+	if w.state < 0 {
+		return nil
+	}
+	return []byte(w.word)
+}
+
+// Positions returns the interval of the current word as byte positions into
+// the original string. The first returned value "from" indexes the first byte
+// and the second returned value "to" indexes the first byte that is not
+// included anymore, i.e. str[from:to] is the current word of the original
+// string "str". If [Words.Next] has not yet been called, both values are 0. If
+// the iterator is already past the end, both values are 1.
+func (w *Words) Positions() (int, int) {
+	if w.state == -1 {
+		return 0, 0
+	} else if w.state == -2 {
+		return 1, 1
+	}
+	return w.offset, w.offset + len(w.word)
+}
+
+// RunePositions is like [Words.Positions] but returns the interval of the
+// current word as rune (code point) positions into the original string, i.e.
+// []rune(str)[from:to] is the current word of the original string "str".
+func (w *Words) RunePositions() (int, int) {
+	if w.state == -1 {
+		return 0, 0
+	} else if w.state == -2 {
+		return 1, 1
+	}
+	return w.runeOffset, w.runeOffset + utf8.RuneCountInString(w.word)
+}
+
+// Width returns the monospace width of the current word. See [StringWidth] for
+// details on how the width is calculated.
+func (w *Words) Width() int {
+	if w.state < 0 {
+		return 0
+	}
+	return StringWidth(w.word)
+}
+
+// Reset puts the iterator into its initial state such that the next call to
+// [Words.Next] sets it to the first word again.
+func (w *Words) Reset() {
+	w.state = -1
+	w.offset = 0
+	w.runeOffset = 0
+	w.word = ""
+	w.remaining = w.original
+}
 
 // FirstWord returns the first word found in the given byte slice according to
 // the rules of [Unicode Standard Annex #29, Word Boundaries]. This function can
@@ -20,70 +173,136 @@ import "unicode/utf8"
 //
 // [Unicode Standard Annex #29, Word Boundaries]: http://unicode.org/reports/tr29/#Word_Boundaries
 func FirstWord(b []byte, state int) (word, rest []byte, newState int) {
-	// An empty byte slice returns nothing.
-	if len(b) == 0 {
+	return firstWord(b, state)
+}
+
+// FirstWordInString is like [FirstWord] but its input and outputs are strings.
+func FirstWordInString(str string, state int) (word, rest string, newState int) {
+	return firstWord(str, state)
+}
+
+// firstWord implements [FirstWord] for all supported text representations.
+func firstWord[T text](t T, state int) (word, rest T, newState int) {
+	// An empty text returns nothing.
+	if len(t) == 0 {
 		return
 	}
 
 	// Extract the first rune.
-	r, length := utf8.DecodeRune(b)
-	if len(b) <= length { // If we're already past the end, there is nothing else to parse.
-		return b, nil, wbAny
+	r, remainder := decodeText(t)
+	if len(remainder) == 0 { // If we're already past the end, there is nothing else to parse.
+		return t, rest, wbAny
 	}
 
 	// If we don't know the state, determine it now.
 	if state < 0 {
-		state, _ = transitionWordBreakState(state, r, b[length:], "")
+		state, _ = transitionWordBreakState(state, r, remainder)
 	}
 
 	// Transition until we find a boundary.
 	var boundary bool
 	for {
-		r, l := utf8.DecodeRune(b[length:])
-		state, boundary = transitionWordBreakState(state, r, b[length+l:], "")
+		r, next := decodeText(remainder)
+		state, boundary = transitionWordBreakState(state, r, next)
 
 		if boundary {
-			return b[:length], b[length:], state
+			word, rest = splitText(t, len(t)-len(remainder))
+			return word, rest, state
 		}
 
-		length += l
-		if len(b) <= length {
-			return b, nil, wbAny
+		remainder = next
+		if len(remainder) == 0 {
+			return t, rest, wbAny
 		}
 	}
 }
 
-// FirstWordInString is like [FirstWord] but its input and outputs are strings.
-func FirstWordInString(str string, state int) (word, rest string, newState int) {
+// LastWord returns the last word found in the given byte slice according to
+// the rules of [Unicode Standard Annex #29, Word Boundaries]. This is useful,
+// for example, to implement "move to previous word" cursor movement. This
+// function can be called continuously to extract all words from a byte slice in
+// reverse order.
+//
+// The "rest" slice is the sub-slice of the original byte slice "b" ending before
+// the first byte of the identified word. If the length of the "rest" slice is
+// 0, the entire byte slice "b" has been processed. The "word" byte slice is the
+// sub-slice of the input slice containing the identified word.
+//
+// Given an empty byte slice "b", the function returns nil values.
+//
+// The function only looks back as far as needed to determine the word
+// unambiguously. In most texts, this is the preceding whitespace or line break.
+//
+// [Unicode Standard Annex #29, Word Boundaries]: http://unicode.org/reports/tr29/#Word_Boundaries
+func LastWord(b []byte) (word, rest []byte) {
 	// An empty byte slice returns nothing.
-	if len(str) == 0 {
+	if len(b) == 0 {
 		return
 	}
 
-	// Extract the first rune.
-	r, length := utf8.DecodeRuneInString(str)
-	if len(str) <= length { // If we're already past the end, there is nothing else to parse.
-		return str, "", wbAny
+	// Parse forward from a safe starting point.
+	remainder, state := b[lastWordSafePoint(b):], -1
+	for len(remainder) > 0 {
+		word, remainder, state = FirstWord(remainder, state)
 	}
+	return word, b[:len(b)-len(word)]
+}
 
-	// If we don't know the state, determine it now.
-	if state < 0 {
-		state, _ = transitionWordBreakState(state, r, nil, str[length:])
+// LastWordInString is like [LastWord] but its input and outputs are strings.
+func LastWordInString(str string) (word, rest string) {
+	// An empty string returns nothing.
+	if len(str) == 0 {
+		return
 	}
 
-	// Transition until we find a boundary.
-	var boundary bool
-	for {
-		r, l := utf8.DecodeRuneInString(str[length:])
-		state, boundary = transitionWordBreakState(state, r, nil, str[length+l:])
+	// Parse forward from a safe starting point.
+	remainder, state := str[lastWordSafePoint(str):], -1
+	for len(remainder) > 0 {
+		word, remainder, state = FirstWordInString(remainder, state)
+	}
+	return word, str[:len(str)-len(word)]
+}
 
-		if boundary {
-			return str[:length], str[length:], state
+// lastWordSafePoint returns the position of the last word boundary in the
+// given text which does not depend on any code points before it. Parsing may
+// therefore start at this position with an initial state of -1. The function
+// returns 0 if no such boundary was found.
+func lastWordSafePoint[T text](t T) int {
+	next, rest := decodeLastText(t)
+	nextProp := propertyWords(next)
+	for len(rest) > 0 {
+		pos := len(rest)
+		var r rune
+		r, rest = decodeLastText(rest)
+		prop := propertyWords(r)
+
+		// WB3a and WB3b always break around line breaks.
+		if prop == prLF || prop == prNewline || prop == prCR && nextProp != prLF ||
+			(nextProp == prCR || nextProp == prLF || nextProp == prNewline) && prop != prCR {
+			return pos
 		}
 
-		length += l
-		if len(str) <= length {
-			return str, "", wbAny
+		// Other boundaries are safe if none of the rules which look at more
+		// than two code points are involved.
+		if !wordContextProperty(prop) && !wordContextProperty(nextProp) {
+			state, _ := transitionWordBreakState(-1, r, "")
+			if _, boundary := transitionWordBreakState(state, next, ""); boundary {
+				return pos
+			}
 		}
+
+		next, nextProp = r, prop
+	}
+	return 0
+}
+
+// wordContextProperty returns true if the given word break property is used
+// in rules which involve more than two code points (WB3c, WB4, WB6, WB7,
+// WB7b, WB7c, WB11, WB12, WB15, WB16).
+func wordContextProperty(prop int) bool {
+	switch prop {
+	case prExtend, prFormat, prZWJ, prRegionalIndicator, prMidLetter, prMidNum, prMidNumLet, prSingleQuote, prDoubleQuote:
+		return true
 	}
+	return false
 }
@@ -169,3 +169,155 @@ func BenchmarkWordFunctionString(b *testing.B) {
 		}
 	}
 }
+
+// Test all official Unicode test cases for word boundaries using the Words
+// class.
+func TestWordsClass(t *testing.T) {
+	for testNum, testCase := range wordBreakTestCases {
+		words := NewWords(testCase.original)
+		var index int
+	WordLoop:
+		for index = 0; words.Next(); index++ {
+			if index >= len(testCase.expected) {
+				t.Errorf(`Test case %d %q failed: More words %d returned than expected %d`,
+					testNum,
+					testCase.original,
+					index,
+					len(testCase.expected))
+				break
+			}
+			word := words.Runes()
+			if len(word) != len(testCase.expected[index]) {
+				t.Errorf(`Test case %d %q failed: Word at index %d has %d codepoints %x, %d expected %x`,
+					testNum,
+					testCase.original,
+					index,
+					len(word),
+					word,
+					len(testCase.expected[index]),
+					testCase.expected[index])
+				break
+			}
+			for i, r := range word {
+				if r != testCase.expected[index][i] {
+					t.Errorf(`Test case %d %q failed: Word at index %d is %x, expected %x`,
+						testNum,
+						testCase.original,
+						index,
+						word,
+						testCase.expected[index])
+					break WordLoop
+				}
+			}
+		}
+		if index < len(testCase.expected) {
+			t.Errorf(`Test case %d %q failed: Fewer words returned (%d) than expected (%d)`,
+				testNum,
+				testCase.original,
+				index,
+				len(testCase.expected))
+		}
+	}
+}
+
+// Test the positions and widths returned by the Words class.
+func TestWordsPositions(t *testing.T) {
+	words := NewWordsBytes([]byte("Hä, 世界!"))
+	expected := []struct {
+		word                 string
+		from, to, rFrom, rTo int
+		width                int
+	}{
+		{"Hä", 0, 3, 0, 2, 2},
+		{",", 3, 4, 2, 3, 1},
+		{" ", 4, 5, 3, 4, 1},
+		{"世", 5, 8, 4, 5, 2},
+		{"界", 8, 11, 5, 6, 2},
+		{"!", 11, 12, 6, 7, 1},
+	}
+	for index, e := range expected {
+		if !words.Next() {
+			t.Fatalf(`Expected word %d, got none`, index)
+		}
+		if str := words.Str(); str != e.word {
+			t.Errorf(`Word %d: expected %q, got %q`, index, e.word, str)
+		}
+		if from, to := words.Positions(); from != e.from || to != e.to {
+			t.Errorf(`Word %d: expected from=%d to=%d, got from=%d to=%d`, index, e.from, e.to, from, to)
+		}
+		if from, to := words.RunePositions(); from != e.rFrom || to != e.rTo {
+			t.Errorf(`Word %d: expected rune from=%d to=%d, got from=%d to=%d`, index, e.rFrom, e.rTo, from, to)
+		}
+		if width := words.Width(); width != e.width {
+			t.Errorf(`Word %d: expected width %d, got %d`, index, e.width, width)
+		}
+	}
+	if words.Next() {
+		t.Errorf(`Expected no more words, got %q`, words.Str())
+	}
+	if from, to := words.Positions(); from != 1 || to != 1 {
+		t.Errorf(`Expected from=%d to=%d, got from=%d to=%d`, 1, 1, from, to)
+	}
+	if b := words.Bytes(); b != nil {
+		t.Errorf(`Expected nil byte slice, got %x`, b)
+	}
+	words.Reset()
+	if from, to := words.Positions(); from != 0 || to != 0 {
+		t.Errorf(`Expected from=%d to=%d, got from=%d to=%d`, 0, 0, from, to)
+	}
+	if r := words.Runes(); r != nil {
+		t.Errorf(`Expected nil rune slice, got %x`, r)
+	}
+	words.Next()
+	if str := words.Str(); str != "Hä" {
+		t.Errorf(`Expected "Hä", got %q`, str)
+	}
+}
+
+// Test all official Unicode test cases for word boundaries by iterating
+// backwards.
+func TestLastWord(t *testing.T) {
+	for testNum, testCase := range wordBreakTestCases {
+		b := []byte(testCase.original)
+		str := testCase.original
+		index := len(testCase.expected)
+		for len(b) > 0 || len(str) > 0 {
+			index--
+			if index < 0 {
+				t.Errorf(`Test case %d %q failed: More words returned than expected %d`,
+					testNum,
+					testCase.original,
+					len(testCase.expected))
+				break
+			}
+			var (
+				word    []byte
+				wordStr string
+			)
+			word, b = LastWord(b)
+			wordStr, str = LastWordInString(str)
+			expected := string(testCase.expected[index])
+			if string(word) != expected || wordStr != expected {
+				t.Errorf(`Test case %d %q failed: Word at index %d is %x (bytes) and %x (string), expected %x`,
+					testNum,
+					testCase.original,
+					index,
+					[]rune(string(word)),
+					[]rune(wordStr),
+					testCase.expected[index])
+				break
+			}
+		}
+		if index > 0 {
+			t.Errorf(`Test case %d %q failed: Fewer words returned (%d) than expected (%d)`,
+				testNum,
+				testCase.original,
+				len(testCase.expected)-index,
+				len(testCase.expected))
+		}
+	}
+	word, rest := LastWord([]byte{})
+	if len(word) > 0 || len(rest) > 0 {
+		t.Errorf(`Expected empty results, got %q, %q`, word, rest)
+	}
+}
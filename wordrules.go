@@ -25,7 +25,7 @@ const (
 // wbTransitions implements the word break parser's state transitions. It's
 // anologous to [grTransitions], see comments there for details.
 //
-// Unicode version 15.0.0.
+// Unicode version 17.0.0.
 func wbTransitions(state, prop int) (newState int, wordBreak bool, rule int) {
 	switch uint64(state) | uint64(prop)<<32 {
 	// WB3b.
@@ -142,11 +142,11 @@ func wbTransitions(state, prop int) (newState int, wordBreak bool, rule int) {
 // transitionWordBreakState determines the new state of the word break parser
 // given the current state and the next code point. It also returns whether a
 // word boundary was detected. If more than one code point is needed to
-// determine the new state, the byte slice or the string starting after rune "r"
-// can be used (whichever is not nil or empty) for further lookups.
-func transitionWordBreakState(state int, r rune, b []byte, str string) (newState int, wordBreak bool) {
+// determine the new state, the text starting after rune "r" can be used for
+// further lookups.
+func transitionWordBreakState[T text](state int, r rune, rest T) (newState int, wordBreak bool) {
 	// Determine the property of the next character.
-	nextProperty := property(workBreakCodePoints, r)
+	nextProperty := propertyWords(r)
 
 	// "Replacing Ignore Rules".
 	if nextProperty == prZWJ {
@@ -170,8 +170,9 @@ func transitionWordBreakState(state int, r rune, b []byte, str string) (newState
 			return wbAny, false
 		}
 		return state, false
-	} else if nextProperty == prExtendedPictographic && state >= 0 && state&wbZWJBit != 0 {
-		// WB3c.
+	} else if propertyGraphemes(r) == prExtendedPictographic && state >= 0 && state&wbZWJBit != 0 {
+		// WB3c. Some pictographs are also ALetter, so we don't use the word
+		// break property here.
 		return wbAny, false
 	}
 	if state >= 0 {
@@ -218,21 +219,12 @@ func transitionWordBreakState(state int, r rune, b []byte, str string) (newState
 			nextProperty == prDoubleQuote || // WB7b.
 			nextProperty == prMidNum) { // WB12.
 		for {
-			var (
-				r      rune
-				length int
-			)
-			if b != nil { // Byte slice version.
-				r, length = utf8.DecodeRune(b)
-				b = b[length:]
-			} else { // String version.
-				r, length = utf8.DecodeRuneInString(str)
-				str = str[length:]
-			}
+			var r rune
+			r, rest = decodeText(rest)
 			if r == utf8.RuneError {
 				break
 			}
-			prop := property(workBreakCodePoints, r)
+			prop := propertyWords(r)
 			if prop == prExtend || prop == prFormat || prop == prZWJ {
 				continue
 			}
@@ -0,0 +1,157 @@
+package uniseg
+
+import "unicode/utf8"
+
+// WrapOptions specifies how [WrapString] breaks text into lines. The zero
+// value (or a nil pointer) results in the default behaviour.
+type WrapOptions struct {
+	// If true, whitespace at the end of wrapped lines is kept in the returned
+	// lines. It is never included in the line width, however. By default,
+	// trailing whitespace is removed.
+	KeepTrailingSpace bool
+
+	// If true, line segments which are wider than the line width are not broken
+	// into grapheme clusters but are returned as lines which exceed the line
+	// width.
+	AllowOverflow bool
+
+	// The width configuration used to calculate widths and to segment the
+	// text. If nil, the package defaults apply. Set
+	// [WidthConfig.EscapeSequences] to wrap text containing ANSI escape
+	// sequences.
+	WidthConfig *WidthConfig
+}
+
+// WrapString breaks the given string into lines which are at most "width"
+// cells wide in a monospace font, as calculated by [StringWidth] (or by
+// [WrapOptions.WidthConfig], if set). It returns the lines without any line
+// break characters.
+//
+// Lines are broken where [Step] reports a mandatory line break
+// ([LineMustBreak]). Additionally, the text is broken at the last break
+// opportunity ([LineCanBreak]) which still allows the line to fit into the
+// given width (greedy algorithm). Whitespace at the end of a line is not
+// counted against the line width and removed from the line, in accordance with
+// [UAX #14]. If a single line segment does not fit into the line width, it is
+// broken between grapheme clusters. A single grapheme cluster which is wider
+// than the line width will occupy a line on its own.
+//
+// If the width is 0 or less, lines are only broken at mandatory line breaks.
+// An empty string results in no lines. The options may be nil.
+//
+// [UAX #14]: https://www.unicode.org/reports/tr14/#BreakingRules
+func WrapString(str string, width int, options *WrapOptions) []string {
+	positions := WrapStringPositions(str, width, options)
+	lines := make([]string, 0, len(positions))
+	for _, position := range positions {
+		lines = append(lines, str[position[0]:position[1]])
+	}
+	return lines
+}
+
+// WrapStringPositions is like [WrapString] but returns the lines as byte
+// positions into the original string. Each element contains the index of the
+// first byte of the line and the index of the first byte not included in the
+// line anymore.
+func WrapStringPositions(str string, width int, options *WrapOptions) (lines [][2]int) {
+	if options == nil {
+		options = &WrapOptions{}
+	}
+
+	var (
+		// The current line. Content excludes trailing whitespace.
+		lineStart, lineContentEnd, lineSpaceEnd int
+		lineWidth, lineSpaceWidth               int
+
+		// The current line segment, not yet committed to the line.
+		segmentStart, segmentContentEnd, segmentSpaceEnd int
+		segmentWidth, segmentSpaceWidth                  int
+	)
+
+	// emit adds the line ending at the given position to the result and starts
+	// a new line at the other position.
+	emit := func(contentEnd, spaceEnd, next int) {
+		end := contentEnd
+		if options.KeepTrailingSpace && spaceEnd > end {
+			end = spaceEnd
+		}
+		lines = append(lines, [2]int{lineStart, end})
+		lineStart, lineContentEnd, lineSpaceEnd = next, next, next
+		lineWidth, lineSpaceWidth = 0, 0
+	}
+
+	var (
+		cluster    string
+		boundaries int
+	)
+	state, pos, remaining := -1, 0, str
+	for len(remaining) > 0 {
+		cluster, remaining, boundaries, state = step(remaining, state, options.WidthConfig)
+		end := pos + len(cluster)
+		clusterWidth := boundaries >> ShiftWidth
+
+		r, _ := utf8.DecodeRuneInString(cluster)
+		switch prop, _ := propertyLineBreak(r); prop {
+		case prBK, prCR, prLF, prNL:
+			// Mandatory line breaks are not part of the line.
+		case prSP:
+			segmentSpaceWidth += clusterWidth
+			segmentSpaceEnd = end
+		default:
+			// Whitespace followed by other characters in the same segment is
+			// part of the content.
+			segmentWidth += segmentSpaceWidth
+			segmentSpaceWidth = 0
+
+			// Horizontal tabs depend on the column. If the segment moves to a new
+			// line, its width and that of the cluster must be measured again.
+			stepWidth := clusterWidth
+			clusterWidth = clusterWidthAt(cluster, stepWidth, lineWidth+lineSpaceWidth+segmentWidth, options.WidthConfig)
+			if width > 0 && lineWidth+lineSpaceWidth+segmentWidth+clusterWidth > width {
+				if lineContentEnd > lineStart {
+					// Break the line at the last break opportunity.
+					emit(lineContentEnd, lineSpaceEnd, segmentStart)
+					if options.WidthConfig != nil && options.WidthConfig.TabWidth > 0 {
+						segmentWidth = options.WidthConfig.StringWidth(str[segmentStart:pos])
+					}
+					clusterWidth = clusterWidthAt(cluster, stepWidth, segmentWidth, options.WidthConfig)
+				}
+				if !options.AllowOverflow && segmentContentEnd > segmentStart && lineSpaceWidth+segmentWidth+clusterWidth > width {
+					// The segment doesn't fit. Break it between grapheme clusters.
+					emit(segmentContentEnd, segmentContentEnd, pos)
+					segmentStart, segmentWidth = pos, 0
+					clusterWidth = clusterWidthAt(cluster, stepWidth, 0, options.WidthConfig)
+				}
+			}
+
+			segmentWidth += clusterWidth
+			segmentContentEnd, segmentSpaceEnd = end, end
+		}
+
+		// Commit the segment to the line at break opportunities.
+		lineBreak := boundaries & MaskLine
+		if lineBreak == LineDontBreak {
+			pos = end
+			continue
+		}
+		if segmentContentEnd > segmentStart {
+			lineWidth += lineSpaceWidth + segmentWidth
+			lineSpaceWidth = segmentSpaceWidth
+			lineContentEnd = segmentContentEnd
+		} else {
+			lineSpaceWidth += segmentSpaceWidth
+		}
+		if segmentSpaceEnd > segmentStart {
+			lineSpaceEnd = segmentSpaceEnd
+		}
+		segmentStart, segmentContentEnd, segmentSpaceEnd = end, end, end
+		segmentWidth, segmentSpaceWidth = 0, 0
+
+		if lineBreak == LineMustBreak {
+			emit(lineContentEnd, lineSpaceEnd, end)
+		}
+		pos = end
+	}
+
+	return
+}
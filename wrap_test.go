@@ -0,0 +1,74 @@
+package uniseg
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// The test cases for the WrapString function.
+var wrapTestCases = []struct {
+	original string
+	width    int
+	options  *WrapOptions
+	expected []string
+}{
+	{"", 10, nil, []string{}},
+	{"\n", 10, nil, []string{""}},
+	{"a", 10, nil, []string{"a"}},
+	{"Hello, world! This is a test.", 6, nil, []string{"Hello,", "world!", "This", "is a", "test."}},
+	{"Hello, world! This is a test.", 6, &WrapOptions{KeepTrailingSpace: true}, []string{"Hello, ", "world! ", "This ", "is a ", "test."}},
+	{"Hello, world! This is a test.", 0, nil, []string{"Hello, world! This is a test."}},
+	{"a\n\nb\n", 10, nil, []string{"a", "", "b"}},
+	{"ab  \r\ncd", 3, nil, []string{"ab", "cd"}},
+	{"ab  \r\ncd", 3, &WrapOptions{KeepTrailingSpace: true}, []string{"ab  ", "cd"}},
+	{"a    b", 6, nil, []string{"a    b"}},
+	{"  indented text here", 6, nil, []string{"  inde", "nted", "text", "here"}},
+	{"Supercalifragilistic word", 6, nil, []string{"Superc", "alifra", "gilist", "ic", "word"}},
+	{"Supercalifragilistic word", 6, &WrapOptions{AllowOverflow: true}, []string{"Supercalifragilistic", "word"}},
+	{"世界世界世界 abc", 5, nil, []string{"世界", "世界", "世界", "abc"}},
+	{"x 世界", 3, nil, []string{"x", "世", "界"}},
+	{"🇩🇪🇩🇪🇩🇪", 5, nil, []string{"🇩🇪🇩🇪", "🇩🇪"}},
+	{"🏳️‍🌈🏳️‍🌈", 1, nil, []string{"🏳️‍🌈", "🏳️‍🌈"}},
+	{"Käse-Brot", 5, nil, []string{"Käse-", "Brot"}},
+	{"abc de\tf", 6, &WrapOptions{WidthConfig: &WidthConfig{TabWidth: 4}}, []string{"abc", "de\tf"}},
+	{"a\tb\tc", 8, &WrapOptions{WidthConfig: &WidthConfig{TabWidth: 4}}, []string{"a\tb\t", "c"}},
+	{"abcdefg\th", 6, &WrapOptions{WidthConfig: &WidthConfig{TabWidth: 4}}, []string{"abcdef", "g\th"}},
+}
+
+// Test the WrapString function.
+func TestWrapString(t *testing.T) {
+	for index, testCase := range wrapTestCases {
+		lines := WrapString(testCase.original, testCase.width, testCase.options)
+		if fmt.Sprintf("%q", lines) != fmt.Sprintf("%q", testCase.expected) {
+			t.Errorf(`Test case %d %q (width %d) failed: Expected %q, got %q`, index, testCase.original, testCase.width, testCase.expected, lines)
+		}
+		if testCase.width <= 0 || testCase.options != nil && testCase.options.AllowOverflow {
+			continue
+		}
+		for _, line := range lines {
+			line = strings.TrimRight(line, " ") // Trailing whitespace doesn't count.
+			if width := StringWidth(line); width > testCase.width && GraphemeClusterCount(line) > 1 {
+				t.Errorf(`Test case %d %q failed: Line %q is wider than %d`, index, testCase.original, line, testCase.width)
+			}
+		}
+	}
+}
+
+// Test that the positions returned by WrapStringPositions cover the original
+// string.
+func TestWrapStringPositions(t *testing.T) {
+	str := "Hello, world!\nThis is a test."
+	positions := WrapStringPositions(str, 8, nil)
+	expected := [][2]int{{0, 6}, {7, 13}, {14, 21}, {22, 29}}
+	if fmt.Sprint(positions) != fmt.Sprint(expected) {
+		t.Errorf(`Expected %v, got %v`, expected, positions)
+	}
+}
+
+// Benchmark the WrapString function.
+func BenchmarkWrapString(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		WrapString(benchmarkStr, 20, nil)
+	}
+}